@@ -1,26 +1,61 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"time"
 
+	"github.com/Tatsuemon/anony/infrastructure/linkchecker"
 	"github.com/Tatsuemon/anony/infrastructure/middleware"
+	"github.com/Tatsuemon/anony/infrastructure/preview"
+	"github.com/Tatsuemon/anony/infrastructure/ratelimit"
+	"github.com/Tatsuemon/anony/infrastructure/screener"
+	"github.com/Tatsuemon/anony/infrastructure/webhook"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
 
+	"github.com/Tatsuemon/anony/domain/model"
 	"github.com/Tatsuemon/anony/domain/service"
 	"github.com/Tatsuemon/anony/infrastructure/web/handler"
 	"github.com/Tatsuemon/anony/rpc"
 	"github.com/Tatsuemon/anony/usecase"
 
 	"github.com/Tatsuemon/anony/config"
+	"github.com/Tatsuemon/anony/infrastructure/cache"
 	"github.com/Tatsuemon/anony/infrastructure/datastore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
+// scheduleCheckInterval is the interval of checking activation windows of AnonyURLs
+const scheduleCheckInterval = time.Minute
+
+// screenerReloadInterval is the interval of checking updates of the screening lists
+const screenerReloadInterval = time.Minute
+
+// linkCheckInterval is the interval of checking destinations of AnonyURLs
+const linkCheckInterval = 6 * time.Hour
+
+// linkCheckConcurrency is the max number of destinations checked at once
+const linkCheckConcurrency = 16
+
+// webhookDispatchInterval is the interval of sending events in the webhook outbox
+const webhookDispatchInterval = 5 * time.Second
+
+// webhookDispatchConcurrency is the max number of webhooks sent to at once
+const webhookDispatchConcurrency = 8
+
+// verifiedDomainsRefreshInterval is the interval of reloading the verified domains
+// 確認したドメインは最大でこの期間, リダイレクトのループの検出で外部のホストとして扱われる
+const verifiedDomainsRefreshInterval = 30 * time.Second
+
+// eventQueueSize is the max number of domain events waiting for the asynchronous subscribers
+const eventQueueSize = 1024
+
 func main() {
 	port := os.Getenv("API_PORT")
 
@@ -37,32 +72,160 @@ func main() {
 
 	transaction := datastore.NewTransaction(db.DB)
 
+	// ドメインイベント. 購読者はusecaseを作成した後にまとめて登録する
+	eventBus := usecase.NewEventBus(eventQueueSize)
+
+	// 監査ログ. 変更と同じトランザクションで追記する
+	auditEventRepository := datastore.NewAuditEventRepository(db.DB)
+	auditService := service.NewAuditService(auditEventRepository)
+	auditUseCase := usecase.NewAuditUseCase(auditEventRepository, config.AdminUserIDs())
+	auditHandler := handler.NewAuditHandler(auditUseCase)
+
 	// User
 	userRepository := datastore.NewUserRepository(db.DB)
 	userService := service.NewUserService(userRepository)
 
-	userUseCase := usecase.NewUserUseCase(userRepository, transaction, userService)
+	userUseCase := usecase.NewUserUseCase(userRepository, transaction, userService, eventBus, auditService)
 	userHandler := handler.NewUserHandler(userUseCase)
 
+	// リダイレクト先のブロックリスト
+	destinationScreener, screenerReloaders := newDestinationScreener()
+
+	// Domain
+	domainRepository := datastore.NewDomainRepository(db.DB)
+	domainService := service.NewDomainService(domainRepository, net.DefaultResolver)
+	domainUseCase := usecase.NewDomainUseCase(domainRepository, transaction, domainService, auditService)
+	domainHandler := handler.NewDomainHandler(domainUseCase)
+
 	// AnonyURL
 	anonyURLRepository := datastore.NewAnonyURLRepository(db.DB)
 	anonyURLService := service.NewAnonyURLService(anonyURLRepository)
+	// 短縮URL自身を短縮したリダイレクトのループを防ぐ
+	// 確認済みのドメインはメモリに持ち, 外部のURLのたびにデータベースを引かない
+	verifiedDomains, err := cache.NewVerifiedDomains(domainRepository)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go verifiedDomains.Watch(context.Background(), verifiedDomainsRefreshInterval)
+	redirectChainService := service.NewRedirectChainService(anonyURLRepository, verifiedDomains, config.ServerHosts())
 
 	userAnonyURLAccessor := datastore.NewUserAnonyURLAccessor(db.DB)
 
-	anonyURLUseCase := usecase.NewAnonyURLUseCase(anonyURLRepository, transaction, anonyURLService)
+	// Webhook. イベントは変更と同じトランザクションでoutboxに書き込む
+	webhookRepository := datastore.NewWebhookRepository(db.DB)
+	webhookDeliveryRepository := datastore.NewWebhookDeliveryRepository(db.DB)
+	webhookService := service.NewWebhookService(webhookRepository, webhookDeliveryRepository)
+	webhookSender := webhook.NewSender(webhook.DefaultConfig())
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepository, webhookDeliveryRepository, anonyURLRepository, webhookService, webhookSender, transaction, auditService)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase)
+
+	variantRepository := datastore.NewVariantRepository(db.DB)
+	// ユーザーごとのクォータ. user_quotasにないユーザーは環境変数のデフォルト
+	quotaService := service.NewQuotaService(datastore.NewQuotaRepository(db.DB), config.DefaultQuota())
+	anonyURLUseCase := usecase.NewAnonyURLUseCase(anonyURLRepository, variantRepository, transaction, anonyURLService, destinationScreener, redirectChainService, webhookService, eventBus, auditService, quotaService)
 
 	anonyWithUserUseCase := usecase.NewAnonyURLWithUserUseCase(userAnonyURLAccessor, transaction)
 
-	anonayURLHandler := handler.NewAnonyURLHandler(anonyURLUseCase, anonyWithUserUseCase)
+	// RedirectRule
+	redirectRuleRepository := datastore.NewRedirectRuleRepository(db.DB)
+	redirectRuleUseCase := usecase.NewRedirectRuleUseCase(redirectRuleRepository, anonyURLRepository, transaction, destinationScreener, redirectChainService, auditService)
+	redirectRuleHandler := handler.NewRedirectRuleHandler(redirectRuleUseCase)
+
+	// リダイレクト先のページのプレビュー
+	previewUseCase := usecase.NewPreviewUseCase(anonyURLRepository, preview.NewFetcher(preview.DefaultConfig()))
+
+	// Tag
+	tagRepository := datastore.NewTagRepository(db.DB)
+	taggedAnonyURLAccessor := datastore.NewTaggedAnonyURLAccessor(db.DB)
+	tagUseCase := usecase.NewTagUseCase(tagRepository, anonyURLRepository, taggedAnonyURLAccessor, transaction, auditService)
+	tagHandler := handler.NewTagHandler(tagUseCase)
+
+	// 全文検索
+	searchUseCase := usecase.NewSearchUseCase(datastore.NewAnonyURLSearcher(db.DB))
+
+	anonayURLHandler := handler.NewAnonyURLHandler(anonyURLUseCase, anonyWithUserUseCase, domainUseCase, previewUseCase, tagUseCase, searchUseCase)
+
+	// ドメインイベントの購読
+	// Webhookのイベントは変更と同じトランザクションで書き込むため, 購読しない(有効期間の境界を除く)
+	eventBus.Subscribe(model.EventAnonyURLScheduleReached, func(ctx context.Context, e model.Event) error {
+		return webhookUseCase.NotifyStatusChange(ctx, e.(model.StatusChangeEvent))
+	})
+	// 作成したリンクと有効にしたリンクは, 定期的な死活確認を待たずに確認する
+	healthCheckUseCase := usecase.NewHealthCheckUseCase(anonyURLRepository, linkchecker.NewChecker(linkchecker.DefaultConfig()), linkCheckConcurrency)
+	eventBus.SubscribeAsync(model.EventAnonyURLCreated, healthCheckUseCase.HandleEvent)
+	eventBus.SubscribeAsync(model.EventAnonyURLStatusChanged, healthCheckUseCase.HandleEvent)
+	go eventBus.Run(context.Background())
+
+	// 有効期間の境界でのステータス変更を通知する
+	scheduler := usecase.NewScheduler(anonyURLRepository, scheduleCheckInterval, eventBus)
+	go scheduler.Run(context.Background())
+
+	// outboxのイベントをWebhookに送る
+	webhookDispatcher := usecase.NewWebhookDispatcher(webhookRepository, webhookDeliveryRepository, transaction, webhookSender, webhookDispatchInterval, webhookDispatchConcurrency)
+	go webhookDispatcher.Run(context.Background())
+
+	// 登録済みのリンクのうち, ブロックリストに載ったものにフラグを付ける
+	screeningUseCase := usecase.NewScreeningUseCase(anonyURLRepository, destinationScreener)
+	flagBlocked := func() {
+		n, err := screeningUseCase.FlagBlockedAnonyURLs(context.Background())
+		if err != nil {
+			log.Printf("failed to flag blocked anonyURLs: %s", err)
+			return
+		}
+		log.Printf("%d anonyURLs are flagged or unflagged", n)
+	}
+	go func() {
+		flagBlocked()
+		screener.Watch(context.Background(), screenerReloadInterval, flagBlocked, screenerReloaders...)
+	}()
+
+	// リダイレクト先の死活確認. 結果はListBrokenAnonyURLsで確認できる
+	go func() {
+		ticker := time.NewTicker(linkCheckInterval)
+		defer ticker.Stop()
+		for {
+			n, err := healthCheckUseCase.CheckAnonyURLs(context.Background())
+			if err != nil {
+				log.Printf("failed to check anonyURLs: %s", err)
+			} else {
+				log.Printf("%d anonyURLs are broken", n)
+			}
+			<-ticker.C
+		}
+	}()
+
+	// ユーザーごと(未認証の場合はIPアドレスごと)のレート制限
+	// 複数のAPIサーバーで制限を共有する場合は, ratelimit.NewSharedStoreに置き換える
+	rateLimits, err := ratelimit.ParseLimits(config.GRPCRateLimits())
+	if err != nil {
+		log.Fatal(err)
+	}
+	rateLimiter := middleware.NewRateLimiter(ratelimit.NewMemoryStore(), rateLimits, middleware.RateLimitKey)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
 	server := grpc.NewServer(
-		grpc_middleware.WithUnaryServerChain(middleware.UnaryServerInterceptor(middleware.JWTAuth(userService))),
+		grpc_middleware.WithUnaryServerChain(
+			// 監査ログに記録するリクエストIDとクライアントのIPアドレス
+			middleware.RequestInfoInterceptor(config.TrustedProxyHops()),
+			middleware.UnaryServerInterceptor(middleware.JWTAuth(userService)),
+			rateLimiter.UnaryServerInterceptor(),
+			// proto/anony.protoのvalidator.fieldの検証
+			grpc_validator.UnaryServerInterceptor(),
+		),
+		// ストリームは認証しないため, IPアドレスごとに制限する
+		grpc_middleware.WithStreamServerChain(
+			middleware.RequestInfoStreamInterceptor(config.TrustedProxyHops()),
+			rateLimiter.StreamServerInterceptor(),
+		),
 	) // ここでInterceptorとか入れる
 
 	rpc.RegisterUserServiceServer(server, userHandler)
 	rpc.RegisterAnonyServiceServer(server, anonayURLHandler)
+	rpc.RegisterDomainServiceServer(server, domainHandler)
+	rpc.RegisterRedirectRuleServiceServer(server, redirectRuleHandler)
+	rpc.RegisterTagServiceServer(server, tagHandler)
+	rpc.RegisterWebhookServiceServer(server, webhookHandler)
+	rpc.RegisterAuditServiceServer(server, auditHandler)
 
 	reflection.Register(server)
 
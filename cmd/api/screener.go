@@ -0,0 +1,43 @@
+package main
+
+import (
+	"log"
+
+	"github.com/Tatsuemon/anony/config"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/screener"
+)
+
+// newDestinationScreener creates a screener of the lists configured, and the reloaders of them
+func newDestinationScreener() (service.DestinationScreener, []screener.Reloader) {
+	ss := []service.DestinationScreener{}
+	rs := []screener.Reloader{}
+	if path := config.DomainBlocklistPath(); path != "" {
+		l, err := screener.NewDomainBlocklist(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ss = append(ss, l)
+		rs = append(rs, l)
+	}
+	if path := config.RegexDenylistPath(); path != "" {
+		l, err := screener.NewRegexDenylist(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ss = append(ss, l)
+		rs = append(rs, l)
+	}
+	if path := config.HashPrefixListPath(); path != "" {
+		l, err := screener.NewHashPrefixList(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ss = append(ss, l)
+		rs = append(rs, l)
+	}
+	if len(ss) == 0 {
+		return screener.NewNopScreener(), rs
+	}
+	return screener.NewMultiScreener(ss...), rs
+}
@@ -1,19 +1,36 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/Tatsuemon/anony/cmd/http/page"
 	"github.com/Tatsuemon/anony/config"
+	"github.com/Tatsuemon/anony/domain/model"
 	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/cache"
 	"github.com/Tatsuemon/anony/infrastructure/datastore"
+	"github.com/Tatsuemon/anony/infrastructure/geoip"
+	"github.com/Tatsuemon/anony/infrastructure/ratelimit"
+	"github.com/Tatsuemon/anony/infrastructure/screener"
 	"github.com/Tatsuemon/anony/infrastructure/web/handler"
 	"github.com/Tatsuemon/anony/usecase"
 	"github.com/gorilla/mux"
 )
 
+// anonyURLCacheWatchInterval is the interval of invalidating short URLs updated by the API server
+const anonyURLCacheWatchInterval = time.Second
+
+// verifiedDomainsRefreshInterval is the interval of reloading the verified domains
+const verifiedDomainsRefreshInterval = 30 * time.Second
+
+// banListReloadInterval is the interval of checking updates of the ban list
+const banListReloadInterval = time.Minute
+
 func main() {
 	port := os.Getenv("HTTP_PORT")
 	db, err := datastore.NewMysqlDB(config.DSN())
@@ -28,12 +45,83 @@ func main() {
 	}()
 
 	transaction := datastore.NewTransaction(db.DB)
-	anonyURLRepository := datastore.NewAnonyURLRepository(db.DB)
+	// リダイレクトのたびにMySQLを引かないように, 短縮コードの取得をキャッシュする
+	anonyURLRepository := cache.NewAnonyURLRepository(datastore.NewAnonyURLRepository(db.DB), cache.Config{
+		Size:        config.AnonyURLCacheSize(),
+		TTL:         config.AnonyURLCacheTTL(),
+		NegativeTTL: config.AnonyURLCacheNegativeTTL(),
+	})
 	anonyURLService := service.NewAnonyURLService(anonyURLRepository)
-	anonyURLUseCase := usecase.NewAnonyURLUseCase(anonyURLRepository, transaction, anonyURLService)
+	// A/Bテストの振り分け先とルールも, 短縮コードと同じ件数と有効期限でキャッシュする
+	variantRepository := cache.NewVariantRepository(datastore.NewVariantRepository(db.DB), config.AnonyURLCacheSize(), config.AnonyURLCacheTTL())
+	domainRepository := datastore.NewDomainRepository(db.DB)
+	// リクエスト時にリダイレクトのループを検出する
+	// 確認済みのドメインはメモリに持ち, 外部のURLへのリダイレクトのたびにデータベースを引かない
+	verifiedDomains, err := cache.NewVerifiedDomains(domainRepository)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go verifiedDomains.Watch(context.Background(), verifiedDomainsRefreshInterval)
+	redirectChainService := service.NewRedirectChainService(anonyURLRepository, verifiedDomains, config.ServerHosts())
+	// リダイレクト先の登録はAPIサーバーで確認し, ブロックされたリンクはフラグでリダイレクトしない
+	// APIサーバーとは別のプロセスなので, APIサーバーの購読者には届かない
+	eventBus := usecase.NewEventBus(0)
+	// このプロセスでの変更はイベントで無効化する
+	eventBus.Subscribe(model.EventAnonyURLCreated, anonyURLRepository.HandleEvent)
+	eventBus.Subscribe(model.EventAnonyURLStatusChanged, anonyURLRepository.HandleEvent)
+	eventBus.Subscribe(model.EventAnonyURLUpdated, anonyURLRepository.HandleEvent)
+	eventBus.Subscribe(model.EventAnonyURLUpdated, variantRepository.HandleEvent)
+	// APIサーバーでの変更は, updated_atが更新されたリンクを定期的に無効化して反映する
+	// Variantとルールの変更はupdated_atを更新しないため, キャッシュの有効期限まで反映されない
+	go anonyURLRepository.Watch(context.Background(), anonyURLCacheWatchInterval)
+	// クリックのイベントはoutboxに書き込み, 送信はAPIサーバーで行う
+	// リダイレクトのたびにWebhookを探さないように, ユーザーごとのWebhookをキャッシュする
+	webhookRepository := cache.NewWebhookRepository(datastore.NewWebhookRepository(db.DB), config.WebhookCacheSize(), config.WebhookCacheTTL())
+	webhookService := service.NewWebhookService(webhookRepository, datastore.NewWebhookDeliveryRepository(db.DB))
+	// リダイレクトでは変更しないため, 監査ログは書き込まれない
+	auditService := service.NewAuditService(datastore.NewAuditEventRepository(db.DB))
+	// リダイレクトではAnonyURLを作成しないため, クォータは確認されない
+	quotaService := service.NewQuotaService(datastore.NewQuotaRepository(db.DB), config.DefaultQuota())
+	// クリック数の記録はリダイレクトのたびにトランザクションで書き込むため, キャッシュしない
+	anonyURLUseCase := usecase.NewAnonyURLUseCase(anonyURLRepository, variantRepository, transaction, anonyURLService, screener.NewNopScreener(), redirectChainService, webhookService, eventBus, auditService, quotaService)
+
+	redirectRuleRepository := cache.NewRedirectRuleRepository(datastore.NewRedirectRuleRepository(db.DB), config.AnonyURLCacheSize(), config.AnonyURLCacheTTL())
+	redirectRuleUseCase := usecase.NewRedirectRuleUseCase(redirectRuleRepository, anonyURLRepository, transaction, screener.NewNopScreener(), redirectChainService, auditService)
+
+	geoIPReader := geoip.NewNopReader()
+	if path := config.GeoIPDatabasePath(); path != "" {
+		geoIPReader, err = geoip.NewCSVReader(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// プレビューページやエラーページのテンプレート
+	pages, err := page.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// 短縮URLを総当たりするクライアントからデータベースを守る
+	// 複数のリダイレクトサーバーで制限を共有する場合は, ratelimit.NewSharedStoreに置き換える
+	rateLimits, err := ratelimit.ParseLimits(config.HTTPRateLimits())
+	if err != nil {
+		log.Fatal(err)
+	}
+	var banList handler.IPBanList
+	if path := config.HTTPBanListPath(); path != "" {
+		l, err := ratelimit.NewBanList(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		banList = l
+		go l.Watch(context.Background(), banListReloadInterval)
+	}
+	guard := handler.NewRequestGuard(pages, ratelimit.NewMemoryStore(), rateLimits, banList, config.HTTPNotFoundCacheTTL(), config.TrustedProxyHops())
 
 	mux := mux.NewRouter()
-	catchAllHandler := handler.NewHttpHandler(anonyURLUseCase)
+	mux.Use(guard.Middleware)
+	catchAllHandler := handler.NewHttpHandler(anonyURLUseCase, verifiedDomains, redirectRuleUseCase, geoIPReader, pages, config.ServerHosts())
 	mux.PathPrefix("/").Handler(catchAllHandler)
 	fmt.Printf("Server running at http://loacalhost:%s\n", port)
 	if err := http.ListenAndServe(fmt.Sprintf(":%s", port), mux); err != nil {
@@ -0,0 +1,64 @@
+// Package page renders HTML pages of the redirect server.
+// 全てのページはlayoutを共有し, ページごとのテンプレートでtitleとcontentを定義する
+package page
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Renderer renders the pages with html/template, which escapes the data by the context
+type Renderer struct {
+	pages map[string]*template.Template
+}
+
+var funcs = template.FuncMap{
+	"date": func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format("2006-01-02")
+	},
+	"statusText": func(status int) string {
+		return strconv.Itoa(status) + " " + http.StatusText(status)
+	},
+}
+
+// New parses the layout and the pages
+func New() (*Renderer, error) {
+	r := &Renderer{pages: map[string]*template.Template{}}
+	for name, body := range pages {
+		t, err := template.New("layout").Funcs(funcs).Parse(layout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the layout: %w", err)
+		}
+		if _, err := t.Parse(body); err != nil {
+			return nil, fmt.Errorf("failed to parse the page %s: %w", name, err)
+		}
+		r.pages[name] = t
+	}
+	return r, nil
+}
+
+// Render writes the page with the status
+// 描画に失敗した場合に途中までのHTMLを返さないように, バッファに描画してから書き込む
+func (r *Renderer) Render(w http.ResponseWriter, status int, name string, data interface{}) error {
+	t, ok := r.pages[name]
+	if !ok {
+		return fmt.Errorf("page %s is not found", name)
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.WriteHeader(status)
+	_, err := buf.WriteTo(w)
+	return err
+}
@@ -0,0 +1,150 @@
+package page
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/infrastructure/web/handler"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	r, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	tests := []struct {
+		name        string
+		status      int
+		page        string
+		data        interface{}
+		wantContain []string
+		wantExclude []string
+	}{
+		{
+			name:   "NORMAL: プレビューページ",
+			status: http.StatusOK,
+			page:   handler.PagePreview,
+			data: handler.PreviewPageData{
+				ShortURL:    "https://anony.example/abc",
+				Destination: "https://example.com/path?q=1&r=2",
+				Host:        "example.com",
+				Title:       "Example Domain",
+				CreatedAt:   time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			wantContain: []string{
+				"<title>Example Domain - anony</title>",
+				`<p class="destination">https://example.com/path?q=1&amp;r=2</p>`,
+				`href="https://anony.example/abc"`,
+				"created on 2021-01-02",
+			},
+		},
+		{
+			name:   "NORMAL: タイトルが無い場合はホストを表示する",
+			status: http.StatusOK,
+			page:   handler.PagePreview,
+			data:   handler.PreviewPageData{ShortURL: "https://anony.example/abc", Destination: "https://example.com/", Host: "example.com"},
+			wantContain: []string{
+				"<title>example.com - anony</title>",
+				"<h1>example.com</h1>",
+			},
+			wantExclude: []string{"created on", "<img"},
+		},
+		{
+			name:   "NORMAL: タイトルと説明のHTMLをエスケープする",
+			status: http.StatusOK,
+			page:   handler.PagePreview,
+			data: handler.PreviewPageData{
+				ShortURL:    "https://anony.example/abc",
+				Destination: `https://example.com/"><script>alert(1)</script>`,
+				Host:        "example.com",
+				Title:       "<script>alert('title')</script>",
+				Description: `<img src=x onerror="alert(1)">`,
+			},
+			wantContain: []string{
+				"<h1>&lt;script&gt;alert(&#39;title&#39;)&lt;/script&gt;</h1>",
+				"&lt;img src=x onerror=&#34;alert(1)&#34;&gt;",
+				"https://example.com/&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;",
+			},
+			wantExclude: []string{"<script>", "onerror=\""},
+		},
+		{
+			name:   "NORMAL: URLの属性ではjavascript:を無効にする",
+			status: http.StatusOK,
+			page:   handler.PagePreview,
+			data: handler.PreviewPageData{
+				ShortURL:    `javascript:alert(1)`,
+				Destination: "https://example.com/",
+				Host:        "example.com",
+				Image:       `javascript:alert(2)`,
+			},
+			wantContain: []string{`href="#ZgotmplZ"`, `src="#ZgotmplZ"`},
+			wantExclude: []string{"javascript:alert(2)\""},
+		},
+		{
+			name:   "NORMAL: エラーページ",
+			status: http.StatusNotFound,
+			page:   handler.PageError,
+			data:   handler.ErrorPageData{Status: http.StatusNotFound, Title: "Not Found", Message: "<b>gone</b>"},
+			wantContain: []string{
+				"<title>Not Found - anony</title>",
+				"<h1>404 Not Found</h1>",
+				"<p>&lt;b&gt;gone&lt;/b&gt;</p>",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			if err := r.Render(w, tt.status, tt.page, tt.data); err != nil {
+				t.Fatalf("Renderer.Render() error = %v", err)
+			}
+			if w.Code != tt.status {
+				t.Errorf("Renderer.Render() code = %v, want %v", w.Code, tt.status)
+			}
+			if got := w.Header().Get("Content-Type"); got != "text/html; charset=utf-8" {
+				t.Errorf("Renderer.Render() Content-Type = %v", got)
+			}
+			body := w.Body.String()
+			for _, v := range tt.wantContain {
+				if !strings.Contains(body, v) {
+					t.Errorf("Renderer.Render() body does not contain %q\n%s", v, body)
+				}
+			}
+			for _, v := range tt.wantExclude {
+				if strings.Contains(body, v) {
+					t.Errorf("Renderer.Render() body contains %q\n%s", v, body)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderer_Render_Error(t *testing.T) {
+	r, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	tests := []struct {
+		name string
+		page string
+		data interface{}
+	}{
+		{name: "ERROR: 存在しないページ", page: "unknown", data: nil},
+		{name: "ERROR: データの型が異なる", page: handler.PagePreview, data: handler.ErrorPageData{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			if err := r.Render(w, http.StatusOK, tt.page, tt.data); err == nil {
+				t.Errorf("Renderer.Render() error = nil, want error")
+			}
+			// 失敗した場合は何も書き込まない
+			if w.Body.Len() != 0 || len(w.Header()) != 0 {
+				t.Errorf("Renderer.Render() wrote %q", w.Body.String())
+			}
+		})
+	}
+}
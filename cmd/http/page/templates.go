@@ -0,0 +1,49 @@
+package page
+
+import "github.com/Tatsuemon/anony/infrastructure/web/handler"
+
+const layout = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="referrer" content="no-referrer">
+<meta name="robots" content="noindex">
+<title>{{template "title" .}} - anony</title>
+<style>
+body { font-family: sans-serif; max-width: 40rem; margin: 3rem auto; padding: 0 1rem; color: #222; }
+.destination { word-break: break-all; padding: .75rem; background: #f4f4f4; border-radius: 4px; }
+.button { display: inline-block; padding: .5rem 1.5rem; background: #1a73e8; color: #fff; text-decoration: none; border-radius: 4px; }
+.muted { color: #666; font-size: .9rem; }
+img.preview { max-width: 100%; }
+</style>
+</head>
+<body>
+{{template "content" .}}
+</body>
+</html>
+`
+
+// pages are the templates of handler.PageRenderer by the page name
+var pages = map[string]string{
+	// data: handler.PreviewPageData
+	handler.PagePreview: `
+{{define "title"}}{{if .Title}}{{.Title}}{{else}}{{.Host}}{{end}}{{end}}
+{{define "content"}}
+<h1>{{if .Title}}{{.Title}}{{else}}{{.Host}}{{end}}</h1>
+<p>This short URL redirects to <strong>{{.Host}}</strong>:</p>
+<p class="destination">{{.Destination}}</p>
+{{if .Description}}<p>{{.Description}}</p>{{end}}
+{{if .Image}}<p><img class="preview" src="{{.Image}}" alt=""></p>{{end}}
+<p><a class="button" href="{{.ShortURL}}" rel="noreferrer nofollow">Continue</a></p>
+<p class="muted">{{.ShortURL}}{{with date .CreatedAt}} &middot; created on {{.}}{{end}}</p>
+{{end}}`,
+
+	// data: handler.ErrorPageData
+	handler.PageError: `
+{{define "title"}}{{.Title}}{{end}}
+{{define "content"}}
+<h1>{{statusText .Status}}</h1>
+{{if .Message}}<p>{{.Message}}</p>{{end}}
+{{end}}`,
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// AdminUserIDs returns the IDs of users who can read the audit events of all users
+// ADMIN_USER_IDS (comma separated)
+func AdminUserIDs() []string {
+	ids := []string{}
+	for _, v := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		ids = append(ids, v)
+	}
+	return ids
+}
+
+// TrustForwardedFor reports whether the API server is behind a proxy setting X-Forwarded-For
+// trueの場合, X-Forwarded-Forの末尾をクライアントのIPアドレスとする
+func TrustForwardedFor() bool {
+	return os.Getenv("TRUST_X_FORWARDED_FOR") == "true"
+}
+
+// TrustedProxyHops is the number of trusted proxies in front of the API server appending to X-Forwarded-For
+// TRUSTED_PROXY_HOPS. 未設定や不正な値の場合は, TrustForwardedForがtrueなら1, それ以外は0でX-Forwarded-Forを使わない
+func TrustedProxyHops() int {
+	v, err := strconv.Atoi(os.Getenv("TRUSTED_PROXY_HOPS"))
+	if err != nil || v < 0 {
+		if TrustForwardedFor() {
+			return 1
+		}
+		return 0
+	}
+	return v
+}
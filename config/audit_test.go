@@ -0,0 +1,53 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestAdminUserIDs(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "NORMAL: 未設定の場合は管理者なし", value: "", want: []string{}},
+		{name: "NORMAL: カンマ区切りで複数指定できる", value: "admin1, admin2,,", want: []string{"admin1", "admin2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer os.Setenv("ADMIN_USER_IDS", os.Getenv("ADMIN_USER_IDS"))
+			os.Setenv("ADMIN_USER_IDS", tt.value)
+			if got := AdminUserIDs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AdminUserIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrustedProxyHops(t *testing.T) {
+	tests := []struct {
+		name  string
+		hops  string
+		trust string
+		want  int
+	}{
+		{name: "NORMAL: 未設定の場合はX-Forwarded-Forを使わない", want: 0},
+		{name: "NORMAL: 信頼できるプロキシの数を指定する", hops: "2", want: 2},
+		{name: "NORMAL: TRUST_X_FORWARDED_FORのみの場合はプロキシが1つ", trust: "true", want: 1},
+		{name: "NORMAL: TRUSTED_PROXY_HOPSを優先する", hops: "0", trust: "true", want: 0},
+		{name: "ERROR: 不正な値", hops: "-1", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer os.Setenv("TRUSTED_PROXY_HOPS", os.Getenv("TRUSTED_PROXY_HOPS"))
+			defer os.Setenv("TRUST_X_FORWARDED_FOR", os.Getenv("TRUST_X_FORWARDED_FOR"))
+			os.Setenv("TRUSTED_PROXY_HOPS", tt.hops)
+			os.Setenv("TRUST_X_FORWARDED_FOR", tt.trust)
+			if got := TrustedProxyHops(); got != tt.want {
+				t.Errorf("TrustedProxyHops() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// AnonyURLCacheSize is the max number of short URLs cached by the redirect server
+// Variantとルールも同じ件数までキャッシュする. 0の場合はキャッシュしない. 未設定や不正な値の場合は10000
+func AnonyURLCacheSize() int {
+	v, err := strconv.Atoi(os.Getenv("ANONY_URL_CACHE_SIZE"))
+	if err != nil || v < 0 {
+		return 10000
+	}
+	return v
+}
+
+// AnonyURLCacheTTL is how long the redirect server caches a short URL
+// 変更は定期的に無効化するが, 無効化を逃した場合も最大でこの期間で反映される. 未設定や不正な値の場合は10秒
+func AnonyURLCacheTTL() time.Duration {
+	return cacheTTL("ANONY_URL_CACHE_TTL", 10*time.Second)
+}
+
+// AnonyURLCacheNegativeTTL is how long the redirect server caches an unknown short code
+// 作成したリンクは, 無効化を逃した場合に最大でこの期間404になる. 未設定や不正な値の場合は5秒
+func AnonyURLCacheNegativeTTL() time.Duration {
+	return cacheTTL("ANONY_URL_CACHE_NEGATIVE_TTL", 5*time.Second)
+}
+
+// WebhookCacheSize is the max number of users whose webhooks are cached by the redirect server
+// 未設定や不正な値の場合は10000
+func WebhookCacheSize() int {
+	v, err := strconv.Atoi(os.Getenv("WEBHOOK_CACHE_SIZE"))
+	if err != nil || v < 0 {
+		return 10000
+	}
+	return v
+}
+
+// WebhookCacheTTL is how long the redirect server caches the webhooks of a user
+// 登録したWebhookには最大でこの期間クリックが送信されない. 未設定や不正な値の場合は10秒
+func WebhookCacheTTL() time.Duration {
+	return cacheTTL("WEBHOOK_CACHE_TTL", 10*time.Second)
+}
+
+func cacheTTL(key string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || d < 0 {
+		return def
+	}
+	return d
+}
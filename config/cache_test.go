@@ -0,0 +1,84 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestAnonyURLCache(t *testing.T) {
+	tests := []struct {
+		name            string
+		values          map[string]string
+		wantSize        int
+		wantTTL         time.Duration
+		wantNegativeTTL time.Duration
+	}{
+		{name: "NORMAL: 未設定", values: map[string]string{}, wantSize: 10000, wantTTL: 10 * time.Second, wantNegativeTTL: 5 * time.Second},
+		{
+			name:            "NORMAL: 設定した大きさと期間",
+			values:          map[string]string{"ANONY_URL_CACHE_SIZE": "1000", "ANONY_URL_CACHE_TTL": "1m", "ANONY_URL_CACHE_NEGATIVE_TTL": "0s"},
+			wantSize:        1000,
+			wantTTL:         time.Minute,
+			wantNegativeTTL: 0,
+		},
+		{
+			name:            "NORMAL: 0の場合はキャッシュしない",
+			values:          map[string]string{"ANONY_URL_CACHE_SIZE": "0"},
+			wantSize:        0,
+			wantTTL:         10 * time.Second,
+			wantNegativeTTL: 5 * time.Second,
+		},
+		{
+			name:            "NORMAL: 不正な値",
+			values:          map[string]string{"ANONY_URL_CACHE_SIZE": "-1", "ANONY_URL_CACHE_TTL": "10", "ANONY_URL_CACHE_NEGATIVE_TTL": "-1s"},
+			wantSize:        10000,
+			wantTTL:         10 * time.Second,
+			wantNegativeTTL: 5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"ANONY_URL_CACHE_SIZE", "ANONY_URL_CACHE_TTL", "ANONY_URL_CACHE_NEGATIVE_TTL"} {
+				defer os.Setenv(k, os.Getenv(k))
+				os.Setenv(k, tt.values[k])
+			}
+			if got := AnonyURLCacheSize(); got != tt.wantSize {
+				t.Errorf("AnonyURLCacheSize() = %v, want %v", got, tt.wantSize)
+			}
+			if got := AnonyURLCacheTTL(); got != tt.wantTTL {
+				t.Errorf("AnonyURLCacheTTL() = %v, want %v", got, tt.wantTTL)
+			}
+			if got := AnonyURLCacheNegativeTTL(); got != tt.wantNegativeTTL {
+				t.Errorf("AnonyURLCacheNegativeTTL() = %v, want %v", got, tt.wantNegativeTTL)
+			}
+		})
+	}
+}
+
+func TestWebhookCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   map[string]string
+		wantSize int
+		wantTTL  time.Duration
+	}{
+		{name: "NORMAL: 未設定", values: map[string]string{}, wantSize: 10000, wantTTL: 10 * time.Second},
+		{name: "NORMAL: 設定した大きさと期間", values: map[string]string{"WEBHOOK_CACHE_SIZE": "0", "WEBHOOK_CACHE_TTL": "1m"}, wantSize: 0, wantTTL: time.Minute},
+		{name: "NORMAL: 不正な値", values: map[string]string{"WEBHOOK_CACHE_SIZE": "-1", "WEBHOOK_CACHE_TTL": "10"}, wantSize: 10000, wantTTL: 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"WEBHOOK_CACHE_SIZE", "WEBHOOK_CACHE_TTL"} {
+				defer os.Setenv(k, os.Getenv(k))
+				os.Setenv(k, tt.values[k])
+			}
+			if got := WebhookCacheSize(); got != tt.wantSize {
+				t.Errorf("WebhookCacheSize() = %v, want %v", got, tt.wantSize)
+			}
+			if got := WebhookCacheTTL(); got != tt.wantTTL {
+				t.Errorf("WebhookCacheTTL() = %v, want %v", got, tt.wantTTL)
+			}
+		})
+	}
+}
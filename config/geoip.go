@@ -0,0 +1,9 @@
+package config
+
+import "os"
+
+// GeoIPDatabasePath is the path of the local GeoIP database used by redirect rules
+// 空文字の場合は国の判定を行わない
+func GeoIPDatabasePath() string {
+	return os.Getenv("GEOIP_DATABASE_PATH")
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// ServerHost is the primary host used to compose short URLs
+func ServerHost() string {
+	return strings.TrimSuffix(os.Getenv("SERVER_HOST"), "/")
+}
+
+// ServerHosts returns all hosts serving short URLs.
+// SERVER_HOST is the primary one, and SERVER_ALIAS_HOSTS (comma separated) are additional ones.
+func ServerHosts() []string {
+	hosts := []string{}
+	if h := ServerHost(); h != "" {
+		hosts = append(hosts, h)
+	}
+	for _, v := range strings.Split(os.Getenv("SERVER_ALIAS_HOSTS"), ",") {
+		v = strings.TrimSuffix(strings.TrimSpace(v), "/")
+		if v == "" {
+			continue
+		}
+		hosts = append(hosts, v)
+	}
+	return hosts
+}
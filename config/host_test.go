@@ -0,0 +1,54 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestServerHost(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "NORMAL: 正常な値",
+			want: "http://localhost-test",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ServerHost(); got != tt.want {
+				t.Errorf("ServerHost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerHosts(t *testing.T) {
+	tests := []struct {
+		name  string
+		alias string
+		want  []string
+	}{
+		{
+			name:  "NORMAL: SERVER_ALIAS_HOSTSがない場合はSERVER_HOSTのみ",
+			alias: "",
+			want:  []string{"http://localhost-test"},
+		},
+		{
+			name:  "NORMAL: SERVER_ALIAS_HOSTSをカンマ区切りで追加できる",
+			alias: "https://a.example.com, https://b.example.com/,",
+			want:  []string{"http://localhost-test", "https://a.example.com", "https://b.example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("SERVER_ALIAS_HOSTS", tt.alias)
+			defer os.Unsetenv("SERVER_ALIAS_HOSTS")
+			if got := ServerHosts(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ServerHosts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// DefaultQuota is the quota of users without their own quota in user_quotas
+// QUOTA_MAX_ANONY_URLS, QUOTA_MAX_ACTIVE_ANONY_URLS, QUOTA_MAX_CREATIONS_PER_DAY. 未設定や不正な値は無制限
+func DefaultQuota() model.Quota {
+	return model.Quota{
+		MaxAnonyURLs:       quotaLimit("QUOTA_MAX_ANONY_URLS"),
+		MaxActiveAnonyURLs: quotaLimit("QUOTA_MAX_ACTIVE_ANONY_URLS"),
+		MaxCreationsPerDay: quotaLimit("QUOTA_MAX_CREATIONS_PER_DAY"),
+	}
+}
+
+func quotaLimit(key string) int64 {
+	v, err := strconv.ParseInt(os.Getenv(key), 10, 64)
+	if err != nil || v < 0 {
+		return 0
+	}
+	return v
+}
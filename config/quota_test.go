@@ -0,0 +1,35 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+func TestDefaultQuota(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]string
+		want   model.Quota
+	}{
+		{name: "NORMAL: 未設定の場合は無制限", values: map[string]string{}, want: model.Quota{}},
+		{
+			name:   "NORMAL: 設定した上限",
+			values: map[string]string{"QUOTA_MAX_ANONY_URLS": "1000", "QUOTA_MAX_ACTIVE_ANONY_URLS": "500", "QUOTA_MAX_CREATIONS_PER_DAY": "100"},
+			want:   model.Quota{MaxAnonyURLs: 1000, MaxActiveAnonyURLs: 500, MaxCreationsPerDay: 100},
+		},
+		{name: "NORMAL: 不正な値は無制限", values: map[string]string{"QUOTA_MAX_ANONY_URLS": "many", "QUOTA_MAX_CREATIONS_PER_DAY": "-1"}, want: model.Quota{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"QUOTA_MAX_ANONY_URLS", "QUOTA_MAX_ACTIVE_ANONY_URLS", "QUOTA_MAX_CREATIONS_PER_DAY"} {
+				defer os.Setenv(k, os.Getenv(k))
+				os.Setenv(k, tt.values[k])
+			}
+			if got := DefaultQuota(); got != tt.want {
+				t.Errorf("DefaultQuota() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
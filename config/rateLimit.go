@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+// GRPCRateLimits is the rate limits of the API server in the form of "<method>=<rate per second>:<burst>,..."
+// "*"は個別の制限がないメソッドの制限. 空文字の場合は制限しない
+func GRPCRateLimits() string {
+	return os.Getenv("GRPC_RATE_LIMITS")
+}
+
+// HTTPRateLimits is the rate limits per client IP of the redirect server in the form of "*=<rate>:<burst>,not-found=<rate>:<burst>"
+// "*"は全てのリクエスト, "not-found"は404になったリクエストの制限. 空文字の場合は制限しない
+func HTTPRateLimits() string {
+	return os.Getenv("HTTP_RATE_LIMITS")
+}
+
+// HTTPNotFoundCacheTTL is how long the redirect server answers 404 to unknown short URLs without the database
+// 有効にしたリンクも最大でこの期間は404になる. 未設定や不正な値の場合はキャッシュしない
+func HTTPNotFoundCacheTTL() time.Duration {
+	d, err := time.ParseDuration(os.Getenv("HTTP_NOT_FOUND_CACHE_TTL"))
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
+// HTTPBanListPath is the path of the local file listing IP addresses and CIDRs banned from the redirect server
+// 空文字の場合はバンリストを使用しない
+func HTTPBanListPath() string {
+	return os.Getenv("HTTP_BAN_LIST_PATH")
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestHTTPNotFoundCacheTTL(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "NORMAL: 未設定の場合はキャッシュしない", value: "", want: 0},
+		{name: "NORMAL: 設定した期間", value: "30s", want: 30 * time.Second},
+		{name: "NORMAL: 不正な値はキャッシュしない", value: "30", want: 0},
+		{name: "NORMAL: 負の値はキャッシュしない", value: "-1m", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer os.Setenv("HTTP_NOT_FOUND_CACHE_TTL", os.Getenv("HTTP_NOT_FOUND_CACHE_TTL"))
+			os.Setenv("HTTP_NOT_FOUND_CACHE_TTL", tt.value)
+			if got := HTTPNotFoundCacheTTL(); got != tt.want {
+				t.Errorf("HTTPNotFoundCacheTTL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
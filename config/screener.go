@@ -0,0 +1,21 @@
+package config
+
+import "os"
+
+// DomainBlocklistPath is the path of the local file listing blocked domains
+// 空文字の場合はドメインのブロックリストを使用しない
+func DomainBlocklistPath() string {
+	return os.Getenv("DOMAIN_BLOCKLIST_PATH")
+}
+
+// RegexDenylistPath is the path of the local file listing regular expressions of blocked URLs
+// 空文字の場合は正規表現のブロックリストを使用しない
+func RegexDenylistPath() string {
+	return os.Getenv("REGEX_DENYLIST_PATH")
+}
+
+// HashPrefixListPath is the path of the local file listing SHA-256 hash prefixes of blocked URLs
+// 空文字の場合はハッシュプレフィックスのリストを使用しない
+func HashPrefixListPath() string {
+	return os.Getenv("HASH_PREFIX_LIST_PATH")
+}
@@ -0,0 +1,13 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- shortには "SERVER_HOST/コード" が保存されているため, ホスト部分を取り除いてコードのみにする
+UPDATE `urls` SET `short` = SUBSTRING(`short`, LOCATE('/', `short`, LOCATE('://', `short`) + 3) + 1) WHERE `short` LIKE '%://%/%';
+ALTER TABLE `urls` MODIFY `short` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '短縮コード';
+ALTER TABLE `urls` DROP INDEX short_index, ADD UNIQUE short_index(`short`);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+-- 元のホストは復元できないため, コードはそのまま残す
+ALTER TABLE `urls` DROP INDEX short_index, ADD INDEX short_index(`short`);
+ALTER TABLE `urls` MODIFY `short` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '省略URL';
@@ -0,0 +1,25 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE `domains` (
+    `id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'ドメインID',
+    `name` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'ドメイン名',
+    `user_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'ユーザーID',
+    `verify_token` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '所有確認用トークン',
+    `verified` tinyint(1) NOT NULL DEFAULT 0 COMMENT '所有確認済みかどうか',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    FOREIGN KEY fk_user_id (`user_id`) REFERENCES users (`id`),
+    UNIQUE name_index (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- 空文字はデフォルトのホスト(SERVER_HOST)を表す
+ALTER TABLE `urls` ADD `domain_id` varchar(255) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'ドメインID' AFTER `short`;
+ALTER TABLE `urls` DROP INDEX short_index, ADD UNIQUE domain_id_short_index(`domain_id`, `short`);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP INDEX domain_id_short_index, ADD UNIQUE short_index(`short`);
+ALTER TABLE `urls` DROP COLUMN `domain_id`;
+DROP TABLE `domains`;
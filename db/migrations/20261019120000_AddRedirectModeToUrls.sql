@@ -0,0 +1,9 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- 301はブラウザにキャッシュされ続けるため, 既存のURLも含めてデフォルトは302にする
+ALTER TABLE `urls` ADD `redirect_mode` int NOT NULL DEFAULT 302 COMMENT 'リダイレクト方法(301, 302, 307, 308, 200: 中間ページ)' AFTER `status`;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP COLUMN `redirect_mode`;
@@ -0,0 +1,10 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `query_mode` int NOT NULL DEFAULT 0 COMMENT 'クエリの引き継ぎ方法(0: 無視, 1: 追加, 2: 上書き)' AFTER `redirect_mode`;
+ALTER TABLE `urls` ADD `forward_path` tinyint(1) NOT NULL DEFAULT 0 COMMENT 'コード以降のパスを引き継ぐかどうか' AFTER `query_mode`;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP COLUMN `forward_path`;
+ALTER TABLE `urls` DROP COLUMN `query_mode`;
@@ -0,0 +1,23 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `utm_source` varchar(255) NOT NULL DEFAULT '' COMMENT 'リダイレクト時に付与するutm_source' AFTER `forward_path`;
+ALTER TABLE `urls` ADD `utm_medium` varchar(255) NOT NULL DEFAULT '' COMMENT 'リダイレクト時に付与するutm_medium' AFTER `utm_source`;
+ALTER TABLE `urls` ADD `utm_campaign` varchar(255) NOT NULL DEFAULT '' COMMENT 'リダイレクト時に付与するutm_campaign' AFTER `utm_medium`;
+ALTER TABLE `urls` ADD `utm_term` varchar(255) NOT NULL DEFAULT '' COMMENT 'リダイレクト時に付与するutm_term' AFTER `utm_campaign`;
+ALTER TABLE `urls` ADD `utm_content` varchar(255) NOT NULL DEFAULT '' COMMENT 'リダイレクト時に付与するutm_content' AFTER `utm_term`;
+-- 同じoriginalでもUTMが異なれば別のリンクとする. インデックス長を抑えるためUTMはハッシュにする
+ALTER TABLE `urls` ADD `utm_hash` char(32) AS (MD5(CONCAT_WS(CHAR(0), `utm_source`, `utm_medium`, `utm_campaign`, `utm_term`, `utm_content`))) STORED COMMENT 'UTMのハッシュ' AFTER `utm_content`;
+ALTER TABLE `urls` ADD UNIQUE user_id_original_utm_index(`user_id`, `original`, `utm_hash`);
+ALTER TABLE `urls` DROP INDEX user_id_original_index;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` ADD UNIQUE user_id_original_index(`user_id`, `original`);
+ALTER TABLE `urls` DROP INDEX user_id_original_utm_index;
+ALTER TABLE `urls` DROP COLUMN `utm_hash`;
+ALTER TABLE `urls` DROP COLUMN `utm_content`;
+ALTER TABLE `urls` DROP COLUMN `utm_term`;
+ALTER TABLE `urls` DROP COLUMN `utm_campaign`;
+ALTER TABLE `urls` DROP COLUMN `utm_medium`;
+ALTER TABLE `urls` DROP COLUMN `utm_source`;
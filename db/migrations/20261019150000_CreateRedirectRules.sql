@@ -0,0 +1,23 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE `redirect_rules` (
+    `id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'ルールID',
+    `url_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'URL_ID',
+    `priority` int NOT NULL COMMENT '評価順(小さい順)',
+    `platform` varchar(16) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'プラットフォーム(空文字: 全て)',
+    `language` varchar(35) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT '言語タグ(空文字: 全て)',
+    `country` char(2) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT '国コード(空文字: 全て)',
+    `active_from` DATETIME NULL COMMENT '有効期間の開始',
+    `active_until` DATETIME NULL COMMENT '有効期間の終了',
+    `destination` varchar(2048) COLLATE utf8mb4_bin NOT NULL COMMENT 'リダイレクト先',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    FOREIGN KEY fk_url_id (`url_id`) REFERENCES urls (`id`) ON DELETE CASCADE,
+    INDEX url_id_priority_index(`url_id`, `priority`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE `redirect_rules`;
@@ -0,0 +1,22 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `clicks` bigint NOT NULL DEFAULT 0 COMMENT 'クリック数' AFTER `utm_hash`;
+CREATE TABLE `variants` (
+    `id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'VARIANT_ID',
+    `url_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'URL_ID',
+    `position` int NOT NULL COMMENT '表示順',
+    `destination` varchar(2048) COLLATE utf8mb4_bin NOT NULL COMMENT 'リダイレクト先',
+    `weight` int NOT NULL COMMENT '振り分けの重み',
+    `clicks` bigint NOT NULL DEFAULT 0 COMMENT 'クリック数',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    FOREIGN KEY fk_url_id (`url_id`) REFERENCES urls (`id`) ON DELETE CASCADE,
+    INDEX url_id_position_index(`url_id`, `position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE `variants`;
+ALTER TABLE `urls` DROP COLUMN `clicks`;
@@ -0,0 +1,16 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `active_from` DATETIME NULL COMMENT '有効期間の開始(NULL: 制限なし)' AFTER `clicks`;
+ALTER TABLE `urls` ADD `active_until` DATETIME NULL COMMENT '有効期間の終了(NULL: 制限なし)' AFTER `active_from`;
+ALTER TABLE `urls` ADD `fallback` varchar(2048) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT '有効期間外のリダイレクト先' AFTER `active_until`;
+ALTER TABLE `urls` ADD INDEX active_from_index(`active_from`);
+ALTER TABLE `urls` ADD INDEX active_until_index(`active_until`);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP INDEX active_until_index;
+ALTER TABLE `urls` DROP INDEX active_from_index;
+ALTER TABLE `urls` DROP COLUMN `fallback`;
+ALTER TABLE `urls` DROP COLUMN `active_until`;
+ALTER TABLE `urls` DROP COLUMN `active_from`;
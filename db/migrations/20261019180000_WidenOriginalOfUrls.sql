@@ -0,0 +1,19 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- 2048文字のoriginalはインデックス長の上限を超えるため, 一意性はハッシュで保証する
+ALTER TABLE `urls` ADD `original_hash` char(32) AS (MD5(`original`)) STORED COMMENT 'originalのハッシュ' AFTER `original`;
+ALTER TABLE `urls` ADD UNIQUE user_id_original_hash_utm_index(`user_id`, `original_hash`, `utm_hash`);
+ALTER TABLE `urls` ADD INDEX original_hash_index(`original_hash`);
+ALTER TABLE `urls` DROP INDEX user_id_original_utm_index;
+ALTER TABLE `urls` DROP INDEX original_index;
+ALTER TABLE `urls` MODIFY `original` varchar(2048) COLLATE utf8mb4_bin NOT NULL COMMENT 'オリジナルURL(正規化済み)';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` MODIFY `original` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'オリジナルURL';
+ALTER TABLE `urls` ADD INDEX original_index(`original`);
+ALTER TABLE `urls` ADD UNIQUE user_id_original_utm_index(`user_id`, `original`, `utm_hash`);
+ALTER TABLE `urls` DROP INDEX original_hash_index;
+ALTER TABLE `urls` DROP INDEX user_id_original_hash_utm_index;
+ALTER TABLE `urls` DROP COLUMN `original_hash`;
@@ -0,0 +1,8 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `blocked_reason` varchar(255) NOT NULL DEFAULT '' COMMENT 'リダイレクト先がブロックされた理由(空文字: ブロックされていない)' AFTER `fallback`;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP COLUMN `blocked_reason`;
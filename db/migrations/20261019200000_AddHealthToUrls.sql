@@ -0,0 +1,14 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `last_status_code` int NOT NULL DEFAULT 0 COMMENT '最後の死活確認のステータスコード(0: 接続できなかった)' AFTER `blocked_reason`;
+ALTER TABLE `urls` ADD `last_latency_ms` int NOT NULL DEFAULT 0 COMMENT '最後の死活確認のレイテンシ(ミリ秒)' AFTER `last_status_code`;
+ALTER TABLE `urls` ADD `last_checked_at` DATETIME NULL COMMENT '最後の死活確認の日時(NULL: 未確認)' AFTER `last_latency_ms`;
+ALTER TABLE `urls` ADD INDEX user_id_last_status_code_index(`user_id`, `last_status_code`);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP INDEX user_id_last_status_code_index;
+ALTER TABLE `urls` DROP COLUMN `last_checked_at`;
+ALTER TABLE `urls` DROP COLUMN `last_latency_ms`;
+ALTER TABLE `urls` DROP COLUMN `last_status_code`;
@@ -0,0 +1,16 @@
+
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `preview_title` varchar(255) NOT NULL DEFAULT '' COMMENT 'リダイレクト先のページのタイトル' AFTER `last_checked_at`;
+ALTER TABLE `urls` ADD `preview_description` varchar(1024) NOT NULL DEFAULT '' COMMENT 'リダイレクト先のページの説明' AFTER `preview_title`;
+ALTER TABLE `urls` ADD `preview_image` varchar(2048) NOT NULL DEFAULT '' COMMENT 'リダイレクト先のページのog:image' AFTER `preview_description`;
+ALTER TABLE `urls` ADD `preview_favicon` varchar(2048) NOT NULL DEFAULT '' COMMENT 'リダイレクト先のページのfavicon' AFTER `preview_image`;
+ALTER TABLE `urls` ADD `preview_fetched_at` DATETIME NULL COMMENT 'プレビューを取得した日時(NULL: 未取得)' AFTER `preview_favicon`;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP COLUMN `preview_fetched_at`;
+ALTER TABLE `urls` DROP COLUMN `preview_favicon`;
+ALTER TABLE `urls` DROP COLUMN `preview_image`;
+ALTER TABLE `urls` DROP COLUMN `preview_description`;
+ALTER TABLE `urls` DROP COLUMN `preview_title`;
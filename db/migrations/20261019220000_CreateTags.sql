@@ -0,0 +1,31 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE `tags` (
+    `id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'タグID',
+    `user_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'ユーザーID',
+    `name` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'タグ名',
+    `parent_id` varchar(255) COLLATE utf8mb4_bin DEFAULT NULL COMMENT '親タグID. NULLの場合は最上位',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    FOREIGN KEY fk_user_id (`user_id`) REFERENCES users (`id`),
+    -- 親タグを削除すると子孫のタグも削除する
+    FOREIGN KEY fk_parent_id (`parent_id`) REFERENCES tags (`id`) ON DELETE CASCADE,
+    INDEX user_id_index(`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+CREATE TABLE `url_tags` (
+    `url_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'URL_ID',
+    `tag_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'タグID',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`url_id`, `tag_id`),
+    FOREIGN KEY fk_url_id (`url_id`) REFERENCES urls (`id`) ON DELETE CASCADE,
+    FOREIGN KEY fk_tag_id (`tag_id`) REFERENCES tags (`id`) ON DELETE CASCADE,
+    -- タグでの絞り込み用
+    INDEX tag_id_url_id_index(`tag_id`, `url_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE `url_tags`;
+DROP TABLE `tags`;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- 日本語のタイトルやURLの一部でも検索できるように, 空白で区切らないngramパーサーを使う
+ALTER TABLE `urls` ADD FULLTEXT INDEX ft_original_index(`original`) WITH PARSER ngram;
+ALTER TABLE `urls` ADD FULLTEXT INDEX ft_short_index(`short`) WITH PARSER ngram;
+ALTER TABLE `urls` ADD FULLTEXT INDEX ft_preview_title_index(`preview_title`) WITH PARSER ngram;
+ALTER TABLE `tags` ADD FULLTEXT INDEX ft_name_index(`name`) WITH PARSER ngram;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `tags` DROP INDEX ft_name_index;
+ALTER TABLE `urls` DROP INDEX ft_preview_title_index;
+ALTER TABLE `urls` DROP INDEX ft_short_index;
+ALTER TABLE `urls` DROP INDEX ft_original_index;
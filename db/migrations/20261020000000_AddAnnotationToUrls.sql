@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE `urls` ADD `title` varchar(255) NOT NULL DEFAULT '' COMMENT '所有者が付けたタイトル' AFTER `preview_fetched_at`;
+ALTER TABLE `urls` ADD `notes` text NULL COMMENT '所有者のメモ' AFTER `title`;
+ALTER TABLE `urls` ADD `metadata` json NULL COMMENT '所有者が付けた任意のキーと値(NULL: 空)' AFTER `notes`;
+-- タイトルとメモも検索できるようにする
+ALTER TABLE `urls` ADD FULLTEXT INDEX ft_title_index(`title`) WITH PARSER ngram;
+ALTER TABLE `urls` ADD FULLTEXT INDEX ft_notes_index(`notes`) WITH PARSER ngram;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP INDEX ft_notes_index;
+ALTER TABLE `urls` DROP INDEX ft_title_index;
+ALTER TABLE `urls` DROP COLUMN `metadata`;
+ALTER TABLE `urls` DROP COLUMN `notes`;
+ALTER TABLE `urls` DROP COLUMN `title`;
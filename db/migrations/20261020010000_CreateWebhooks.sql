@@ -0,0 +1,41 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE `webhooks` (
+    `id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'WebhookID',
+    `user_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'ユーザーID',
+    `url` varchar(2048) COLLATE utf8mb4_bin NOT NULL COMMENT '送信先',
+    `secret` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '署名の鍵',
+    `event_types` varchar(1024) COLLATE utf8mb4_bin NOT NULL COMMENT '通知するイベントの種類(カンマ区切り)',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    FOREIGN KEY fk_user_id (`user_id`) REFERENCES users (`id`),
+    INDEX user_id_index(`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- 送信待ちのイベント(outbox). リンクの変更と同じトランザクションで書き込む
+CREATE TABLE `webhook_deliveries` (
+    `id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '送信ID',
+    `webhook_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'WebhookID',
+    `event_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'イベントID. 同じイベントの送信で共通',
+    `event_type` varchar(64) COLLATE utf8mb4_bin NOT NULL COMMENT 'イベントの種類',
+    `payload` mediumblob NOT NULL COMMENT '送信するJSON',
+    `status` tinyint NOT NULL DEFAULT 0 COMMENT '0: 送信待ち, 1: 送信済み, 2: 再試行の上限に達した',
+    `attempts` int NOT NULL DEFAULT 0 COMMENT '送信を試みた回数',
+    `next_attempt_at` DATETIME NOT NULL COMMENT '次に送信する日時',
+    `last_error` varchar(1024) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT '最後の失敗の理由',
+    `delivered_at` DATETIME NULL COMMENT '送信に成功した日時',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    -- Webhookを削除すると送信待ちのイベントも削除する
+    FOREIGN KEY fk_webhook_id (`webhook_id`) REFERENCES webhooks (`id`) ON DELETE CASCADE,
+    -- 送信する順に取り出す
+    INDEX status_next_attempt_at_index(`status`, `next_attempt_at`),
+    INDEX webhook_id_status_index(`webhook_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE `webhook_deliveries`;
+DROP TABLE `webhooks`;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- 監査ログ. 変更と同じトランザクションで追記する
+-- ユーザーやリンクを削除しても残すため, 外部キーは張らない
+CREATE TABLE `audit_events` (
+    `id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '監査ログID',
+    `actor_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '操作したユーザーID',
+    `action` varchar(64) COLLATE utf8mb4_bin NOT NULL COMMENT '操作',
+    `target_type` varchar(32) COLLATE utf8mb4_bin NOT NULL COMMENT '対象の種類',
+    `target_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT '対象のID',
+    `before_value` json NULL COMMENT '変更前の値',
+    `after_value` json NULL COMMENT '変更後の値',
+    `request_id` varchar(255) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'リクエストID',
+    `client_ip` varchar(45) COLLATE utf8mb4_bin NOT NULL DEFAULT '' COMMENT 'クライアントのIPアドレス',
+    `created_at` DATETIME(6) NOT NULL COMMENT '操作した日時',
+    PRIMARY KEY (`id`),
+    INDEX actor_id_created_at_index(`actor_id`, `created_at`),
+    INDEX target_created_at_index(`target_type`, `target_id`, `created_at`),
+    INDEX created_at_index(`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- 追記のみ. 更新と削除はエラーにする
+-- +goose StatementBegin
+CREATE TRIGGER `audit_events_no_update` BEFORE UPDATE ON `audit_events`
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_events is append-only';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER `audit_events_no_delete` BEFORE DELETE ON `audit_events`
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_events is append-only';
+-- +goose StatementEnd
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TRIGGER `audit_events_no_delete`;
+DROP TRIGGER `audit_events_no_update`;
+DROP TABLE `audit_events`;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- ユーザーごとのクォータ. NULLの項目は環境変数のデフォルト, 0は無制限
+CREATE TABLE `user_quotas` (
+    `user_id` varchar(255) COLLATE utf8mb4_bin NOT NULL COMMENT 'ユーザーID',
+    `max_anony_urls` int NULL COMMENT 'AnonyURLの数の上限',
+    `max_active_anony_urls` int NULL COMMENT '有効なAnonyURLの数の上限',
+    `max_creations_per_day` int NULL COMMENT '直近24時間に作成できるAnonyURLの数の上限',
+    `created_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` DATETIME COLLATE utf8mb4_bin NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (`user_id`),
+    FOREIGN KEY fk_user_id (`user_id`) REFERENCES users (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;
+
+-- 直近24時間に作成した数を数える
+ALTER TABLE `urls` ADD INDEX user_id_created_at_index(`user_id`, `created_at`);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP INDEX user_id_created_at_index;
+DROP TABLE `user_quotas`;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- 中間ページはステータスコードではないので, redirect_modeから分ける
+ALTER TABLE `urls` ADD `interstitial` tinyint(1) NOT NULL DEFAULT 0 COMMENT '中間ページを表示してリダイレクトする' AFTER `redirect_mode`;
+UPDATE `urls` SET `interstitial` = 1, `redirect_mode` = 302 WHERE `redirect_mode` = 200;
+ALTER TABLE `urls` MODIFY `redirect_mode` int NOT NULL DEFAULT 302 COMMENT 'リダイレクトのステータスコード(301, 302, 307, 308)';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` MODIFY `redirect_mode` int NOT NULL DEFAULT 302 COMMENT 'リダイレクト方法(301, 302, 307, 308, 200: 中間ページ)';
+UPDATE `urls` SET `redirect_mode` = 200 WHERE `interstitial` = 1;
+ALTER TABLE `urls` DROP COLUMN `interstitial`;
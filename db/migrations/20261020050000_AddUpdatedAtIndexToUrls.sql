@@ -0,0 +1,8 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- リダイレクトサーバーがキャッシュを無効化するため, 直近に更新されたリンクを取得する
+ALTER TABLE `urls` ADD INDEX updated_at_index(`updated_at`);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `urls` DROP INDEX updated_at_index;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- 複数のAPIサーバーが同じWebhookへ同時に送らないように, 送信中のWebhookを期限付きで確保する
+-- 送信中は確保の期限, 送信後は送信を終えた日時. 古いものから順に送る
+ALTER TABLE `webhooks` ADD COLUMN `dispatch_locked_until` DATETIME NULL COMMENT '送信の確保の期限';
+-- 同じWebhookへは書き込んだ順に送る. idはUUIDのため, 順序には使えない
+ALTER TABLE `webhook_deliveries` ADD COLUMN `seq` BIGINT NOT NULL AUTO_INCREMENT COMMENT '書き込んだ順序', ADD UNIQUE INDEX seq_index(`seq`);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE `webhook_deliveries` DROP INDEX seq_index, DROP COLUMN `seq`;
+ALTER TABLE `webhooks` DROP COLUMN `dispatch_locked_until`;
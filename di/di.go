@@ -19,8 +19,9 @@ type UserController struct {
 
 func SetUserController(db *sqlx.DB, t datastore.Transaction) UserController {
 	repository := datastore.NewUserRepository(db)
+	audit := service.NewAuditService(datastore.NewAuditEventRepository(db))
 	service := service.NewUserService(repository)
-	usecase := usecase.NewUserUseCase(repository, t, service)
+	usecase := usecase.NewUserUseCase(repository, t, service, usecase.NewEventBus(0), audit)
 	handler := handler.NewUserHandler(usecase)
 
 	return UserController{
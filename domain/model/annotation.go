@@ -0,0 +1,67 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ユーザーが付けるタイトル, メモ, メタデータの上限. 長さは文字数
+const (
+	MaxTitleLength         = 255
+	MaxNotesLength         = 4096
+	MaxMetadataEntries     = 32
+	MaxMetadataKeyLength   = 64
+	MaxMetadataValueLength = 1024
+)
+
+// SetAnnotation sets the title, the notes and the metadata given by the owner
+// タイトルの前後の空白は取り除く. metadataがnilの場合は空にする
+func (a *AnonyURL) SetAnnotation(title, notes string, metadata map[string]string) {
+	a.Title = strings.TrimSpace(title)
+	a.Notes = notes
+	a.Metadata = make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		a.Metadata[k] = v
+	}
+}
+
+// ValidateAnnotation validates the title, the notes and the metadata
+func (a AnonyURL) ValidateAnnotation() error {
+	if utf8.RuneCountInString(a.Title) > MaxTitleLength {
+		return fmt.Errorf("title must be at most %d characters", MaxTitleLength)
+	}
+	if utf8.RuneCountInString(a.Notes) > MaxNotesLength {
+		return fmt.Errorf("notes must be at most %d characters", MaxNotesLength)
+	}
+	if len(a.Metadata) > MaxMetadataEntries {
+		return fmt.Errorf("metadata must have at most %d entries", MaxMetadataEntries)
+	}
+	for k, v := range a.Metadata {
+		if strings.TrimSpace(k) == "" {
+			return fmt.Errorf("metadata key is required")
+		}
+		if utf8.RuneCountInString(k) > MaxMetadataKeyLength {
+			return fmt.Errorf("metadata key %q must be at most %d characters", k, MaxMetadataKeyLength)
+		}
+		if strings.IndexFunc(k, unicode.IsControl) >= 0 {
+			return fmt.Errorf("metadata key %q must not contain control characters", k)
+		}
+		if utf8.RuneCountInString(v) > MaxMetadataValueLength {
+			return fmt.Errorf("metadata value of %q must be at most %d characters", k, MaxMetadataValueLength)
+		}
+	}
+	return nil
+}
+
+// DisplayTitle returns the title given by the owner, or the title of the destination page
+func (a AnonyURL) DisplayTitle() string {
+	if a.Title != "" {
+		return a.Title
+	}
+	if a.Preview != nil {
+		return a.Preview.Title
+	}
+	return ""
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAnonyURL_SetAnnotation(t *testing.T) {
+	a := AnonyURL{}
+	a.SetAnnotation("  My link ", " memo ", nil)
+	want := AnonyURL{Title: "My link", Notes: " memo ", Metadata: map[string]string{}}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("AnonyURL.SetAnnotation() = %+v, want %+v", a, want)
+	}
+}
+
+func TestAnonyURL_ValidateAnnotation(t *testing.T) {
+	tooMany := map[string]string{}
+	for i := 0; i <= MaxMetadataEntries; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "v"
+	}
+	tests := []struct {
+		name    string
+		a       AnonyURL
+		wantErr bool
+	}{
+		{name: "NORMAL: 空", a: AnonyURL{}, wantErr: false},
+		{
+			name: "NORMAL: 上限の長さ",
+			a: AnonyURL{
+				Title:    strings.Repeat("あ", MaxTitleLength),
+				Notes:    strings.Repeat("い", MaxNotesLength),
+				Metadata: map[string]string{strings.Repeat("k", MaxMetadataKeyLength): strings.Repeat("v", MaxMetadataValueLength)},
+			},
+			wantErr: false,
+		},
+		{name: "ERROR: タイトルが長すぎる", a: AnonyURL{Title: strings.Repeat("あ", MaxTitleLength+1)}, wantErr: true},
+		{name: "ERROR: メモが長すぎる", a: AnonyURL{Notes: strings.Repeat("い", MaxNotesLength+1)}, wantErr: true},
+		{name: "ERROR: キーが多すぎる", a: AnonyURL{Metadata: tooMany}, wantErr: true},
+		{name: "ERROR: キーが空", a: AnonyURL{Metadata: map[string]string{" ": "v"}}, wantErr: true},
+		{name: "ERROR: キーが長すぎる", a: AnonyURL{Metadata: map[string]string{strings.Repeat("k", MaxMetadataKeyLength+1): "v"}}, wantErr: true},
+		{name: "ERROR: キーに制御文字", a: AnonyURL{Metadata: map[string]string{"a\nb": "v"}}, wantErr: true},
+		{name: "ERROR: 値が長すぎる", a: AnonyURL{Metadata: map[string]string{"k": strings.Repeat("v", MaxMetadataValueLength+1)}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.a.ValidateAnnotation(); (err != nil) != tt.wantErr {
+				t.Errorf("AnonyURL.ValidateAnnotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAnonyURL_DisplayTitle(t *testing.T) {
+	tests := []struct {
+		name string
+		a    AnonyURL
+		want string
+	}{
+		{name: "NORMAL: 所有者のタイトルを優先する", a: AnonyURL{Title: "mine", Preview: &LinkPreview{Title: "page"}}, want: "mine"},
+		{name: "NORMAL: 無い場合はプレビューのタイトル", a: AnonyURL{Preview: &LinkPreview{Title: "page"}}, want: "page"},
+		{name: "NORMAL: どちらも無い", a: AnonyURL{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.DisplayTitle(); got != tt.want {
+				t.Errorf("AnonyURL.DisplayTitle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,22 +1,66 @@
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RedirectMode is the HTTP status code of the redirect to the original URL
+// 中間ページを表示する場合はInterstitialで指定する
+const (
+	RedirectModeMovedPermanently  int64 = 301
+	RedirectModeFound             int64 = 302
+	RedirectModeTemporaryRedirect int64 = 307
+	RedirectModePermanentRedirect int64 = 308
+)
+
+// DefaultRedirectMode is used when the redirect mode is not specified
+const DefaultRedirectMode = RedirectModeFound
 
 // AnonyURL is a conversion of url
 type AnonyURL struct {
-	ID       string `json:"id" db:"id"`
-	Original string `json:"original" db:"original"`
-	Short    string `json:"short" db:"short"`
-	Status   int64  `json:"status" db:"status"` // 1: 有効, 2: 無効
+	ID           string     `json:"id" db:"id"`
+	Original     string     `json:"original" db:"original"`
+	Short        string     `json:"short" db:"short"`                 // ホストを含まない短縮コード
+	Status       int64      `json:"status" db:"status"`               // 1: 有効, 2: 無効
+	DomainID     string     `json:"domain_id" db:"domain_id"`         // 空文字: デフォルトのホスト
+	RedirectMode int64      `json:"redirect_mode" db:"redirect_mode"` // 0: DefaultRedirectMode
+	Interstitial bool       `json:"interstitial" db:"interstitial"`   // RedirectModeの代わりに, meta refreshとJavaScriptでリダイレクトするHTMLを返す
+	QueryMode    int64      `json:"query_mode" db:"query_mode"`       // 0: 無視, 1: 追加, 2: 上書き
+	ForwardPath  bool       `json:"forward_path" db:"forward_path"`   // コード以降のパスを引き継ぐ
+	UTM          UTM        `json:"utm"`                              // リダイレクト時に付与する
+	Clicks       int64      `json:"clicks" db:"clicks"`
+	Variants     []*Variant `json:"variants"`                       // A/Bテストの振り分け先. 空の場合はOriginalへリダイレクトする
+	ActiveFrom   *time.Time `json:"active_from" db:"active_from"`   // nil: 制限なし
+	ActiveUntil  *time.Time `json:"active_until" db:"active_until"` // nil: 制限なし
+	Fallback     string     `json:"fallback" db:"fallback"`         // 有効期間外のリダイレクト先. 空文字の場合は404
+	// 登録後にリダイレクト先がブロックリストに載った場合の理由. 空文字でない場合はリダイレクトしない
+	BlockedReason string `json:"blocked_reason" db:"blocked_reason"`
+	// リダイレクト先の最後の死活確認の結果. LastCheckedAtがnilの場合は未確認
+	LastStatusCode int64      `json:"last_status_code" db:"last_status_code"`
+	LastLatencyMS  int64      `json:"last_latency_ms" db:"last_latency_ms"`
+	LastCheckedAt  *time.Time `json:"last_checked_at" db:"last_checked_at"`
+	// リダイレクト先のページから取得したプレビュー. 未取得の場合はnil
+	Preview *LinkPreview `json:"preview"`
+	// DBで設定される. 保存前はゼロ値
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	// 所有者が付けるタイトル, メモ, 任意のキーと値. 空の場合はリダイレクト先のプレビューを使う
+	Title    string            `json:"title" db:"title"`
+	Notes    string            `json:"notes" db:"notes"`
+	Metadata map[string]string `json:"metadata"`
+	// 付けられたタグ. 一覧の取得時のみ設定する
+	Tags []*Tag `json:"tags"`
 }
 
 // NewAnonyURL create a new AnonyURL
 func NewAnonyURL(id string, original string, short string, status int64) *AnonyURL {
 	return &AnonyURL{
-		ID:       id,
-		Original: original,
-		Short:    short,
-		Status:   status,
+		ID:           id,
+		Original:     original,
+		Short:        short,
+		Status:       status,
+		RedirectMode: DefaultRedirectMode,
 	}
 }
 
@@ -25,8 +69,12 @@ func (a AnonyURL) ValidateAnonyURL() error {
 	if a.ID == "" {
 		return fmt.Errorf("id is required")
 	}
-	if a.Original == "" {
-		return fmt.Errorf("original is required")
+	if err := validateDestination("original", a.Original); err != nil {
+		return err
+	}
+	// 重複の判定はoriginalの文字列で行うため, 正規化済みであること
+	if original, _ := NormalizeURL(a.Original); original != a.Original {
+		return fmt.Errorf("original is not normalized")
 	}
 	if a.Short == "" {
 		return fmt.Errorf("short is required")
@@ -38,5 +86,85 @@ func (a AnonyURL) ValidateAnonyURL() error {
 	if (a.Status < 1) || (a.Status > 2) {
 		return fmt.Errorf("status is out of range")
 	}
+	if a.RedirectMode != 0 && !IsValidRedirectMode(a.RedirectMode) {
+		return fmt.Errorf("redirect_mode is invalid")
+	}
+	if (a.QueryMode < QueryModeIgnore) || (a.QueryMode > QueryModeOverride) {
+		return fmt.Errorf("query_mode is out of range")
+	}
+	if err := a.UTM.ValidateUTM(); err != nil {
+		return err
+	}
+	if err := a.ValidateVariants(); err != nil {
+		return err
+	}
+	if err := a.ValidateSchedule(); err != nil {
+		return err
+	}
+	if err := a.ValidateAnnotation(); err != nil {
+		return err
+	}
 	return nil
 }
+
+// IsValidRedirectMode returns whether the mode is supported
+func IsValidRedirectMode(mode int64) bool {
+	switch mode {
+	case RedirectModeMovedPermanently, RedirectModeFound, RedirectModeTemporaryRedirect, RedirectModePermanentRedirect:
+		return true
+	}
+	return false
+}
+
+// GetRedirectMode returns the redirect mode, or DefaultRedirectMode if it is not specified
+func (a AnonyURL) GetRedirectMode() int64 {
+	if a.RedirectMode == 0 {
+		return DefaultRedirectMode
+	}
+	return a.RedirectMode
+}
+
+// IsRedirectable reports whether the redirect server redirects the AnonyURL
+// 無効なものとブロックされたものは404になる
+func (a AnonyURL) IsRedirectable() bool {
+	return a.Status == 1 && a.BlockedReason == ""
+}
+
+// ShortURL composes the short URL served on the host
+func (a AnonyURL) ShortURL(host string) string {
+	return strings.TrimSuffix(host, "/") + "/" + a.Short
+}
+
+// ConflictingSetting returns the name of the setting of req which differs from the registered AnonyURL
+// 登録済みのoriginalとUTMの組を保存し直す場合は, ステータスとVariantのみ更新するため, それ以外の指定が異なる場合は保存しない
+// ドメインは常に比較し, それ以外は指定された場合のみ比較する
+func (a AnonyURL) ConflictingSetting(req AnonyURL) string {
+	switch {
+	case req.DomainID != a.DomainID:
+		return "domain"
+	case req.RedirectMode != 0 && req.GetRedirectMode() != a.GetRedirectMode():
+		return "redirect_mode"
+	case req.Interstitial != a.Interstitial && (req.Interstitial || req.RedirectMode != 0):
+		return "redirect_mode"
+	case req.QueryMode != 0 && req.QueryMode != a.QueryMode:
+		return "query_mode"
+	case req.ForwardPath && !a.ForwardPath:
+		return "forward_path"
+	case req.ActiveFrom != nil && (a.ActiveFrom == nil || !req.ActiveFrom.Equal(*a.ActiveFrom)):
+		return "active_from"
+	case req.ActiveUntil != nil && (a.ActiveUntil == nil || !req.ActiveUntil.Equal(*a.ActiveUntil)):
+		return "active_until"
+	case req.Fallback != "" && req.Fallback != a.Fallback:
+		return "fallback_url"
+	case req.Title != "" && req.Title != a.Title:
+		return "title"
+	case req.Notes != "" && req.Notes != a.Notes:
+		return "notes"
+	}
+	for k, v := range req.Metadata {
+		if w, ok := a.Metadata[k]; !ok || w != v {
+			return "metadata"
+		}
+	}
+	return ""
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestNewAnonyURL(t *testing.T) {
@@ -26,10 +27,11 @@ func TestNewAnonyURL(t *testing.T) {
 				status:   1,
 			},
 			want: &AnonyURL{
-				ID:       "test-id",
-				Original: "original-url",
-				Short:    "short-url",
-				Status:   1,
+				ID:           "test-id",
+				Original:     "original-url",
+				Short:        "short-url",
+				Status:       1,
+				RedirectMode: 302,
 			},
 		},
 	}
@@ -44,10 +46,11 @@ func TestNewAnonyURL(t *testing.T) {
 
 func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 	type fields struct {
-		ID       string
-		Original string
-		Short    string
-		Status   int64
+		ID           string
+		Original     string
+		Short        string
+		Status       int64
+		RedirectMode int64
 	}
 	tests := []struct {
 		name    string
@@ -58,7 +61,7 @@ func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 			name: "NORMAL: 正常な場合は, nilを返す",
 			fields: fields{
 				ID:       "id",
-				Original: "original",
+				Original: "https://example.com/original",
 				Short:    "short",
 				Status:   1,
 			},
@@ -67,7 +70,7 @@ func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 		{
 			name: "ERROR: IDがない場合",
 			fields: fields{
-				Original: "original",
+				Original: "https://example.com/original",
 				Short:    "short",
 				Status:   1,
 			},
@@ -82,11 +85,31 @@ func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "ERROR: Originalのスキームがhttp, https以外の場合",
+			fields: fields{
+				ID:       "id",
+				Original: "ftp://example.com/original",
+				Short:    "short",
+				Status:   1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: Originalが正規化されていない場合",
+			fields: fields{
+				ID:       "id",
+				Original: "https://EXAMPLE.com:443/original",
+				Short:    "short",
+				Status:   1,
+			},
+			wantErr: true,
+		},
 		{
 			name: "ERROR: Shortがない場合",
 			fields: fields{
 				ID:       "id",
-				Original: "original",
+				Original: "https://example.com/original",
 				Status:   1,
 			},
 			wantErr: true,
@@ -95,7 +118,7 @@ func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 			name: "ERROR: Statusがない場合",
 			fields: fields{
 				ID:       "id",
-				Original: "original",
+				Original: "https://example.com/original",
 				Short:    "short",
 			},
 			wantErr: true,
@@ -104,7 +127,7 @@ func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 			name: "ERROR: Statusが1未満の場合",
 			fields: fields{
 				ID:       "id",
-				Original: "original",
+				Original: "https://example.com/original",
 				Short:    "short",
 				Status:   0,
 			},
@@ -114,20 +137,43 @@ func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 			name: "ERROR: Statusが2より大きい場合",
 			fields: fields{
 				ID:       "id",
-				Original: "original",
+				Original: "https://example.com/original",
 				Short:    "short",
 				Status:   3,
 			},
 			wantErr: true,
 		},
+		{
+			name: "NORMAL: RedirectModeを指定できる",
+			fields: fields{
+				ID:           "id",
+				Original:     "https://example.com/original",
+				Short:        "short",
+				Status:       1,
+				RedirectMode: 307,
+			},
+			wantErr: false,
+		},
+		{
+			name: "ERROR: RedirectModeが不正な値の場合",
+			fields: fields{
+				ID:           "id",
+				Original:     "https://example.com/original",
+				Short:        "short",
+				Status:       1,
+				RedirectMode: 303,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := AnonyURL{
-				ID:       tt.fields.ID,
-				Original: tt.fields.Original,
-				Short:    tt.fields.Short,
-				Status:   tt.fields.Status,
+				ID:           tt.fields.ID,
+				Original:     tt.fields.Original,
+				Short:        tt.fields.Short,
+				Status:       tt.fields.Status,
+				RedirectMode: tt.fields.RedirectMode,
 			}
 			if err := a.ValidateAnonyURL(); (err != nil) != tt.wantErr {
 				t.Errorf("AnonyURL.ValidateAnonyURL() error = %v, wantErr %v", err, tt.wantErr)
@@ -135,3 +181,120 @@ func TestAnonyURL_ValidateAnonyURL(t *testing.T) {
 		})
 	}
 }
+
+func TestAnonyURL_ShortURL(t *testing.T) {
+	type args struct {
+		host string
+	}
+	tests := []struct {
+		name  string
+		short string
+		args  args
+		want  string
+	}{
+		{
+			name:  "NORMAL: hostとコードを結合する",
+			short: "abcd1234",
+			args: args{
+				host: "https://example.com",
+			},
+			want: "https://example.com/abcd1234",
+		},
+		{
+			name:  "NORMAL: hostの末尾の/は無視する",
+			short: "abcd1234",
+			args: args{
+				host: "https://example.com/",
+			},
+			want: "https://example.com/abcd1234",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnonyURL{Short: tt.short}
+			if got := a.ShortURL(tt.args.host); got != tt.want {
+				t.Errorf("AnonyURL.ShortURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnonyURL_GetRedirectMode(t *testing.T) {
+	tests := []struct {
+		name         string
+		redirectMode int64
+		want         int64
+	}{
+		{
+			name:         "NORMAL: 指定されたRedirectModeを返す",
+			redirectMode: 308,
+			want:         308,
+		},
+		{
+			name:         "NORMAL: 指定されていない場合はDefaultRedirectModeを返す",
+			redirectMode: 0,
+			want:         DefaultRedirectMode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnonyURL{RedirectMode: tt.redirectMode}
+			if got := a.GetRedirectMode(); got != tt.want {
+				t.Errorf("AnonyURL.GetRedirectMode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnonyURL_IsRedirectable(t *testing.T) {
+	tests := []struct {
+		name string
+		an   AnonyURL
+		want bool
+	}{
+		{name: "NORMAL: 有効", an: AnonyURL{Status: 1}, want: true},
+		{name: "NORMAL: 無効", an: AnonyURL{Status: 2}, want: false},
+		{name: "NORMAL: ブロックされている", an: AnonyURL{Status: 1, BlockedReason: "phishing"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.an.IsRedirectable(); got != tt.want {
+				t.Errorf("AnonyURL.IsRedirectable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnonyURL_ConflictingSetting(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	registered := AnonyURL{
+		DomainID:     "domain",
+		RedirectMode: RedirectModeFound,
+		ActiveFrom:   &from,
+		Title:        "My link",
+		Metadata:     map[string]string{"owner": "team-a"},
+	}
+	other := from.Add(time.Hour)
+	tests := []struct {
+		name string
+		req  AnonyURL
+		want string
+	}{
+		{name: "NORMAL: 同じ設定", req: AnonyURL{DomainID: "domain", RedirectMode: RedirectModeFound, ActiveFrom: &from, Metadata: map[string]string{"owner": "team-a"}}, want: ""},
+		{name: "NORMAL: 指定しない設定は比較しない", req: AnonyURL{DomainID: "domain"}, want: ""},
+		{name: "NORMAL: デフォルトのホストで保存し直す場合", req: AnonyURL{}, want: "domain"},
+		{name: "NORMAL: 別のドメイン", req: AnonyURL{DomainID: "other"}, want: "domain"},
+		{name: "NORMAL: 別のリダイレクトモード", req: AnonyURL{DomainID: "domain", RedirectMode: RedirectModeMovedPermanently}, want: "redirect_mode"},
+		{name: "NORMAL: 中間ページに変える", req: AnonyURL{DomainID: "domain", Interstitial: true}, want: "redirect_mode"},
+		{name: "NORMAL: 別の有効期間", req: AnonyURL{DomainID: "domain", ActiveFrom: &other}, want: "active_from"},
+		{name: "NORMAL: 別のタイトル", req: AnonyURL{DomainID: "domain", Title: "Other"}, want: "title"},
+		{name: "NORMAL: 別のメタデータ", req: AnonyURL{DomainID: "domain", Metadata: map[string]string{"owner": "team-b"}}, want: "metadata"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := registered.ConflictingSetting(tt.req); got != tt.want {
+				t.Errorf("AnonyURL.ConflictingSetting() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
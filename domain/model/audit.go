@@ -0,0 +1,153 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// 監査ログの対象の種類
+const (
+	AuditTargetAnonyURL = "anony_url"
+	AuditTargetUser     = "user"
+	AuditTargetDomain   = "domain"
+	AuditTargetTag      = "tag"
+	AuditTargetWebhook  = "webhook"
+)
+
+// 監査ログに記録する操作. "<対象の種類>.<操作>"
+const (
+	AuditAnonyURLCreate           = "anony_url.create"
+	AuditAnonyURLUpdateStatus     = "anony_url.update_status"
+	AuditAnonyURLSetVariants      = "anony_url.set_variants"
+	AuditAnonyURLSetSchedule      = "anony_url.set_schedule"
+	AuditAnonyURLUpdateMetadata   = "anony_url.update_metadata"
+	AuditAnonyURLSetRedirectRules = "anony_url.set_redirect_rules"
+	AuditAnonyURLTag              = "anony_url.tag"
+	AuditAnonyURLUntag            = "anony_url.untag"
+	AuditUserCreate               = "user.create"
+	AuditUserUpdate               = "user.update"
+	AuditUserDelete               = "user.delete"
+	AuditDomainRegister           = "domain.register"
+	AuditDomainVerify             = "domain.verify"
+	AuditTagCreate                = "tag.create"
+	AuditTagRename                = "tag.rename"
+	AuditTagDelete                = "tag.delete"
+	AuditWebhookCreate            = "webhook.create"
+	AuditWebhookDelete            = "webhook.delete"
+	AuditWebhookRedeliver         = "webhook.redeliver"
+)
+
+// 監査ログの取得件数
+const (
+	DefaultAuditEventsLimit = 50
+	MaxAuditEventsLimit     = 500
+)
+
+// AuditEvent is an append-only record of a mutating operation
+// 変更と同じトランザクションで書き込み, 更新や削除はしない
+type AuditEvent struct {
+	ID string `json:"id" db:"id"`
+	// 操作したユーザー. ユーザーの作成では作成されたユーザー
+	ActorID    string `json:"actor_id" db:"actor_id"`
+	Action     string `json:"action" db:"action"`
+	TargetType string `json:"target_type" db:"target_type"`
+	TargetID   string `json:"target_id" db:"target_id"`
+	// 変更前後の値のJSON. 作成ではBefore, 削除ではAfterがnil
+	Before    json.RawMessage `json:"before" db:"before_value"`
+	After     json.RawMessage `json:"after" db:"after_value"`
+	RequestID string          `json:"request_id" db:"request_id"`
+	ClientIP  string          `json:"client_ip" db:"client_ip"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+}
+
+// NewAuditEvent creates a new AuditEvent, before and after are encoded to JSON
+// before, afterがnilの場合は値なしとして扱う
+func NewAuditEvent(id, actorID, action, targetType, targetID string, before, after interface{}, info RequestInfo, at time.Time) (*AuditEvent, error) {
+	b, err := auditValue(before)
+	if err != nil {
+		return nil, fmt.Errorf("before: %w", err)
+	}
+	a, err := auditValue(after)
+	if err != nil {
+		return nil, fmt.Errorf("after: %w", err)
+	}
+	return &AuditEvent{
+		ID:         id,
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     b,
+		After:      a,
+		RequestID:  info.RequestID,
+		ClientIP:   info.ClientIP,
+		CreatedAt:  at,
+	}, nil
+}
+
+func auditValue(v interface{}) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// nilのポインタなど
+	if string(b) == "null" {
+		return nil, nil
+	}
+	return b, nil
+}
+
+// AuditEventFilter is the condition of listing audit events
+// 空文字とnilの条件は絞り込まない
+type AuditEventFilter struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Since      *time.Time // この時刻以降
+	Until      *time.Time // この時刻より前
+	Limit      int
+	Offset     int
+}
+
+// Normalize sets the default limit and validates the filter
+func (f *AuditEventFilter) Normalize() error {
+	if f.Limit < 0 || f.Offset < 0 {
+		return fmt.Errorf("limit and offset must not be negative")
+	}
+	if f.Limit == 0 {
+		f.Limit = DefaultAuditEventsLimit
+	}
+	if f.Limit > MaxAuditEventsLimit {
+		return fmt.Errorf("limit must be %d or less", MaxAuditEventsLimit)
+	}
+	if f.Since != nil && f.Until != nil && !f.Since.Before(*f.Until) {
+		return fmt.Errorf("since must be before until")
+	}
+	return nil
+}
+
+// RequestInfo is the information of the request recorded in audit events
+type RequestInfo struct {
+	RequestID string
+	ClientIP  string
+}
+
+const requestInfoContextKey contextKey = "request_info"
+
+// SetRequestInfoInContext set the request information in context
+func SetRequestInfoInContext(parents context.Context, info RequestInfo) context.Context {
+	return context.WithValue(parents, requestInfoContextKey, info)
+}
+
+// GetRequestInfoInContext get the request information in context, or zero value if it is not set
+// バッチ処理などリクエストのない場合は空になる
+func GetRequestInfoInContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoContextKey).(RequestInfo)
+	return info
+}
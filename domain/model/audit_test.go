@@ -0,0 +1,87 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	var nilUser *User
+	info := RequestInfo{RequestID: "req", ClientIP: "192.0.2.1"}
+	tests := []struct {
+		name       string
+		before     interface{}
+		after      interface{}
+		wantBefore string
+		wantAfter  string
+	}{
+		{name: "NORMAL: 作成では変更前がない", before: nil, after: &Tag{ID: "tag", Name: "acme"}, wantAfter: `"id":"tag"`},
+		{name: "NORMAL: nilのポインタは値なし", before: nilUser, after: nil},
+		{name: "NORMAL: パスワードは記録しない", before: &User{ID: "user", EncryptedPass: "secret"}, wantBefore: `"id":"user"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAuditEvent("id", "actor", AuditTagCreate, AuditTargetTag, "target", tt.before, tt.after, info, time.Now())
+			if err != nil {
+				t.Fatalf("NewAuditEvent() error = %v", err)
+			}
+			if !containsJSON(got.Before, tt.wantBefore) || !containsJSON(got.After, tt.wantAfter) {
+				t.Errorf("NewAuditEvent() before = %s, after = %s", got.Before, got.After)
+			}
+			if containsJSON(got.Before, "secret") {
+				t.Errorf("NewAuditEvent() before contains the password: %s", got.Before)
+			}
+			if got.RequestID != info.RequestID || got.ClientIP != info.ClientIP {
+				t.Errorf("NewAuditEvent() request info = %v, %v", got.RequestID, got.ClientIP)
+			}
+		})
+	}
+}
+
+// containsJSON reports whether the JSON contains the substring, or the JSON is nil when the substring is empty
+func containsJSON(b []byte, sub string) bool {
+	if sub == "" {
+		return b == nil
+	}
+	return strings.Contains(string(b), sub)
+}
+
+func TestAuditEventFilter_Normalize(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	tests := []struct {
+		name      string
+		f         AuditEventFilter
+		wantLimit int
+		wantErr   bool
+	}{
+		{name: "NORMAL: 件数の指定がない場合はデフォルト", f: AuditEventFilter{}, wantLimit: DefaultAuditEventsLimit},
+		{name: "NORMAL: 期間の指定", f: AuditEventFilter{Since: &earlier, Until: &now, Limit: 10}, wantLimit: 10},
+		{name: "ERROR: 件数が上限を超える", f: AuditEventFilter{Limit: MaxAuditEventsLimit + 1}, wantErr: true},
+		{name: "ERROR: オフセットが負", f: AuditEventFilter{Offset: -1}, wantErr: true},
+		{name: "ERROR: 期間が逆", f: AuditEventFilter{Since: &now, Until: &earlier}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.f.Normalize()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AuditEventFilter.Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.f.Limit != tt.wantLimit {
+				t.Errorf("AuditEventFilter.Normalize() Limit = %v, want %v", tt.f.Limit, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestGetRequestInfoInContext(t *testing.T) {
+	if got := GetRequestInfoInContext(context.Background()); got != (RequestInfo{}) {
+		t.Errorf("GetRequestInfoInContext() = %v, want zero value", got)
+	}
+	info := RequestInfo{RequestID: "req", ClientIP: "192.0.2.1"}
+	if got := GetRequestInfoInContext(SetRequestInfoInContext(context.Background(), info)); got != info {
+		t.Errorf("GetRequestInfoInContext() = %v, want %v", got, info)
+	}
+}
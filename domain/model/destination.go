@@ -0,0 +1,109 @@
+package model
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// MaxURLLength is the max length of destination URLs
+const MaxURLLength = 2048
+
+// リダイレクト先として許可するスキームとそのデフォルトポート
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// NormalizeURL parses a destination URL and returns the canonical form of it
+// 同じリダイレクト先が1つの文字列になるように,
+// ホストの小文字化とPunycodeへの変換, デフォルトポートの除去, 空のパスを"/"にする正規化を行う
+// "/"以外のパスの末尾のスラッシュは別のリソースを指すことがあるため変更しない
+func NormalizeURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("url is required")
+	}
+	if len(raw) > MaxURLLength {
+		return "", fmt.Errorf("url must be at most %d characters", MaxURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("url is invalid")
+	}
+	// url.Parseでスキームは小文字になる
+	defaultPort, ok := defaultPorts[u.Scheme]
+	if !ok {
+		return "", fmt.Errorf("scheme must be http or https")
+	}
+	if u.Opaque != "" || u.Host == "" {
+		return "", fmt.Errorf("host is required")
+	}
+	// user:pass@host は別のホストに見せかけるために使われるので許可しない
+	if u.User != nil {
+		return "", fmt.Errorf("userinfo is not allowed")
+	}
+
+	host, err := normalizeHost(u.Hostname())
+	if err != nil {
+		return "", err
+	}
+	port := u.Port()
+	if port != "" {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 1 || n > 65535 {
+			return "", fmt.Errorf("port is invalid")
+		}
+		port = strconv.Itoa(n)
+	}
+	if port == defaultPort {
+		port = ""
+	}
+	switch {
+	case port != "":
+		u.Host = net.JoinHostPort(host, port)
+	case strings.Contains(host, ":"):
+		u.Host = "[" + host + "]"
+	default:
+		u.Host = host
+	}
+	if u.Path == "" {
+		u.Path = "/"
+		u.RawPath = ""
+	}
+
+	res := u.String()
+	if len(res) > MaxURLLength {
+		return "", fmt.Errorf("url must be at most %d characters", MaxURLLength)
+	}
+	return res, nil
+}
+
+// normalizeHost converts the host to lower case ASCII, IDN is converted to Punycode
+func normalizeHost(host string) (string, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String(), nil
+	}
+	// 末尾のドットは絶対ドメイン名を表すだけなので取り除く
+	host = strings.TrimSuffix(host, ".")
+	ascii, err := idna.Lookup.ToASCII(host)
+	if err != nil || ascii == "" {
+		return "", fmt.Errorf("host is invalid")
+	}
+	return ascii, nil
+}
+
+// validateDestination validates the URL under the destination policy
+func validateDestination(name, raw string) error {
+	if raw == "" {
+		return fmt.Errorf("%s is required", name)
+	}
+	if _, err := NormalizeURL(raw); err != nil {
+		return fmt.Errorf("%s is invalid: %v", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "NORMAL: 正規化済みの場合はそのまま",
+			raw:     "https://example.com/path?q=1#top",
+			want:    "https://example.com/path?q=1#top",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: スキームとホストを小文字にする",
+			raw:     "HTTPS://Example.COM/Path",
+			want:    "https://example.com/Path",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: 空のパスは/にする",
+			raw:     "https://example.com",
+			want:    "https://example.com/",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: /以外のパスの末尾のスラッシュは残す",
+			raw:     "https://example.com/path/",
+			want:    "https://example.com/path/",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: デフォルトポートを取り除く",
+			raw:     "http://example.com:80/path",
+			want:    "http://example.com/path",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: デフォルト以外のポートは残す",
+			raw:     "https://example.com:8443/path",
+			want:    "https://example.com:8443/path",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: IDNはPunycodeにする",
+			raw:     "https://日本語.jp/",
+			want:    "https://xn--wgv71a119e.jp/",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: 末尾のドットと前後の空白を取り除く",
+			raw:     " https://example.com./ ",
+			want:    "https://example.com/",
+			wantErr: false,
+		},
+		{
+			name:    "NORMAL: IPv6アドレス",
+			raw:     "http://[::1]:80",
+			want:    "http://[::1]/",
+			wantErr: false,
+		},
+		{
+			name:    "ERROR: 空文字の場合",
+			raw:     "",
+			wantErr: true,
+		},
+		{
+			name:    "ERROR: http, https以外のスキームの場合",
+			raw:     "javascript://example.com/%0Aalert(1)",
+			wantErr: true,
+		},
+		{
+			name:    "ERROR: 相対URLの場合",
+			raw:     "/path",
+			wantErr: true,
+		},
+		{
+			name:    "ERROR: ホストがない場合",
+			raw:     "https:example.com",
+			wantErr: true,
+		},
+		{
+			name:    "ERROR: ユーザー情報を含む場合",
+			raw:     "https://example.com@evil.example/",
+			wantErr: true,
+		},
+		{
+			name:    "ERROR: ポートが範囲外の場合",
+			raw:     "https://example.com:70000/",
+			wantErr: true,
+		},
+		{
+			name:    "ERROR: 長すぎる場合",
+			raw:     "https://example.com/" + strings.Repeat("a", MaxURLLength),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeURL(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NormalizeURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeURL_MaxURLLength(t *testing.T) {
+	// 255文字を超えるURLも登録できる
+	raw := "https://example.com/" + strings.Repeat("a", MaxURLLength-len("https://example.com/"))
+	got, err := NormalizeURL(raw)
+	if err != nil {
+		t.Fatalf("NormalizeURL() error = %v", err)
+	}
+	if got != raw {
+		t.Errorf("NormalizeURL() = %v, want %v", got, raw)
+	}
+}
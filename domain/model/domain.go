@@ -0,0 +1,66 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const domainVerificationPrefix = "anony-verification="
+
+var domainNameRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// Domain is a branded domain serving short URLs of the user
+type Domain struct {
+	ID          string `json:"id" db:"id"`
+	Name        string `json:"name" db:"name"`
+	UserID      string `json:"user_id" db:"user_id"`
+	VerifyToken string `json:"verify_token" db:"verify_token"`
+	Verified    bool   `json:"verified" db:"verified"`
+}
+
+// NewDomain create a new Domain
+func NewDomain(id string, name string, userID string, verifyToken string) *Domain {
+	return &Domain{
+		ID:          id,
+		Name:        strings.ToLower(name),
+		UserID:      userID,
+		VerifyToken: verifyToken,
+		Verified:    false,
+	}
+}
+
+// ValidateDomain validates Domain params
+func (d Domain) ValidateDomain() error {
+	if d.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if d.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if d.UserID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+	if d.VerifyToken == "" {
+		return fmt.Errorf("verify_token is required")
+	}
+	if len(d.Name) > 253 || !domainNameRegexp.MatchString(d.Name) {
+		return fmt.Errorf("name is invalid domain name")
+	}
+	return nil
+}
+
+// VerificationRecordName is the name of TXT record to verify ownership
+func (d Domain) VerificationRecordName() string {
+	return "_anony-verification." + d.Name
+}
+
+// VerificationRecordValue is the value of TXT record to verify ownership
+func (d Domain) VerificationRecordValue() string {
+	return domainVerificationPrefix + d.VerifyToken
+}
+
+// URL is the base URL of short URLs served on the domain
+func (d Domain) URL() string {
+	return "https://" + d.Name
+}
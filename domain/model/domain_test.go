@@ -0,0 +1,161 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewDomain(t *testing.T) {
+	type args struct {
+		id          string
+		name        string
+		userID      string
+		verifyToken string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *Domain
+	}{
+		{
+			name: "NORMAL: 正常にDomainを作成できる",
+			args: args{
+				id:          "id",
+				name:        "Go.Example.com",
+				userID:      "user-id",
+				verifyToken: "token",
+			},
+			want: &Domain{
+				ID:          "id",
+				Name:        "go.example.com",
+				UserID:      "user-id",
+				VerifyToken: "token",
+				Verified:    false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDomain(tt.args.id, tt.args.name, tt.args.userID, tt.args.verifyToken); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomain_ValidateDomain(t *testing.T) {
+	type fields struct {
+		ID          string
+		Name        string
+		UserID      string
+		VerifyToken string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{
+			name: "NORMAL: 正常な場合は, nilを返す",
+			fields: fields{
+				ID:          "id",
+				Name:        "go.example.com",
+				UserID:      "user-id",
+				VerifyToken: "token",
+			},
+			wantErr: false,
+		},
+		{
+			name: "ERROR: IDがない場合",
+			fields: fields{
+				Name:        "go.example.com",
+				UserID:      "user-id",
+				VerifyToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: Nameがない場合",
+			fields: fields{
+				ID:          "id",
+				UserID:      "user-id",
+				VerifyToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: UserIDがない場合",
+			fields: fields{
+				ID:          "id",
+				Name:        "go.example.com",
+				VerifyToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: VerifyTokenがない場合",
+			fields: fields{
+				ID:     "id",
+				Name:   "go.example.com",
+				UserID: "user-id",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: スキームを含む場合",
+			fields: fields{
+				ID:          "id",
+				Name:        "https://go.example.com",
+				UserID:      "user-id",
+				VerifyToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: ポートを含む場合",
+			fields: fields{
+				ID:          "id",
+				Name:        "go.example.com:8080",
+				UserID:      "user-id",
+				VerifyToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: トップレベルドメインがない場合",
+			fields: fields{
+				ID:          "id",
+				Name:        "localhost",
+				UserID:      "user-id",
+				VerifyToken: "token",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Domain{
+				ID:          tt.fields.ID,
+				Name:        tt.fields.Name,
+				UserID:      tt.fields.UserID,
+				VerifyToken: tt.fields.VerifyToken,
+			}
+			if err := d.ValidateDomain(); (err != nil) != tt.wantErr {
+				t.Errorf("Domain.ValidateDomain() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDomain_VerificationRecord(t *testing.T) {
+	d := Domain{Name: "go.example.com", VerifyToken: "token"}
+	if got := d.VerificationRecordName(); got != "_anony-verification.go.example.com" {
+		t.Errorf("Domain.VerificationRecordName() = %v", got)
+	}
+	if got := d.VerificationRecordValue(); got != "anony-verification=token" {
+		t.Errorf("Domain.VerificationRecordValue() = %v", got)
+	}
+	if got := d.URL(); got != "https://go.example.com" {
+		t.Errorf("Domain.URL() = %v", got)
+	}
+}
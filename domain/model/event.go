@@ -0,0 +1,108 @@
+package model
+
+import "time"
+
+// ドメインイベントの名前. usecase.EventBusの購読に使う
+const (
+	EventAnonyURLCreated         = "AnonyURLCreated"
+	EventAnonyURLStatusChanged   = "AnonyURLStatusChanged"
+	EventAnonyURLUpdated         = "AnonyURLUpdated"
+	EventAnonyURLScheduleReached = "AnonyURLScheduleReached"
+	EventAnonyURLClicked         = "AnonyURLClicked"
+	EventUserCreated             = "UserCreated"
+	EventUserDeleted             = "UserDeleted"
+)
+
+// Event is a domain event published by usecases after the change is committed
+type Event interface {
+	EventName() string
+	OccurredAt() time.Time
+}
+
+// AnonyURLCreated is published when a new AnonyURL is saved
+// 登録済みのoriginalとUTMの組の場合はAnonyURLStatusChangedを発行する
+type AnonyURLCreated struct {
+	AnonyURL *AnonyURL
+	UserID   string
+	At       time.Time
+}
+
+// EventName returns the name of the event
+func (e AnonyURLCreated) EventName() string { return EventAnonyURLCreated }
+
+// OccurredAt returns the time of the event
+func (e AnonyURLCreated) OccurredAt() time.Time { return e.At }
+
+// AnonyURLStatusChanged is published when the owner activates or deactivates the AnonyURL
+// 登録済みのoriginalとUTMの組を保存した場合は, ステータスが同じでも発行する
+// 有効期間の境界はAnonyURLScheduleReachedで発行する
+type AnonyURLStatusChanged struct {
+	AnonyURL *AnonyURL
+	UserID   string
+	At       time.Time
+}
+
+// EventName returns the name of the event
+func (e AnonyURLStatusChanged) EventName() string { return EventAnonyURLStatusChanged }
+
+// OccurredAt returns the time of the event
+func (e AnonyURLStatusChanged) OccurredAt() time.Time { return e.At }
+
+// AnonyURLUpdated is published when the owner changes the variants, the schedule or the annotation of the AnonyURL
+type AnonyURLUpdated struct {
+	AnonyURL *AnonyURL
+	UserID   string
+	At       time.Time
+}
+
+// EventName returns the name of the event
+func (e AnonyURLUpdated) EventName() string { return EventAnonyURLUpdated }
+
+// OccurredAt returns the time of the event
+func (e AnonyURLUpdated) OccurredAt() time.Time { return e.At }
+
+// EventName returns the name of the event
+// StatusChangeEventはスケジューラーが有効期間の境界で発行する
+func (e StatusChangeEvent) EventName() string { return EventAnonyURLScheduleReached }
+
+// OccurredAt returns the time of the event
+func (e StatusChangeEvent) OccurredAt() time.Time { return e.At }
+
+// AnonyURLClicked is published when the AnonyURL redirects
+type AnonyURLClicked struct {
+	// クリック前の値. Clicksは加算されていない
+	AnonyURL  *AnonyURL
+	UserID    string
+	VariantID string
+	At        time.Time
+}
+
+// EventName returns the name of the event
+func (e AnonyURLClicked) EventName() string { return EventAnonyURLClicked }
+
+// OccurredAt returns the time of the event
+func (e AnonyURLClicked) OccurredAt() time.Time { return e.At }
+
+// UserCreated is published when a user signs up
+type UserCreated struct {
+	User *User
+	At   time.Time
+}
+
+// EventName returns the name of the event
+func (e UserCreated) EventName() string { return EventUserCreated }
+
+// OccurredAt returns the time of the event
+func (e UserCreated) OccurredAt() time.Time { return e.At }
+
+// UserDeleted is published when a user is deleted
+type UserDeleted struct {
+	UserID string
+	At     time.Time
+}
+
+// EventName returns the name of the event
+func (e UserDeleted) EventName() string { return EventUserDeleted }
+
+// OccurredAt returns the time of the event
+func (e UserDeleted) OccurredAt() time.Time { return e.At }
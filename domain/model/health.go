@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// LinkHealth is the result of checking whether a destination is reachable
+type LinkHealth struct {
+	StatusCode int64         // 0: 接続できなかった
+	Latency    time.Duration // 最初のレスポンスを受け取るまでの時間
+	CheckedAt  time.Time
+}
+
+// IsBroken returns true if the destination is unreachable or responds with an error status
+func (h LinkHealth) IsBroken() bool {
+	return h.StatusCode == 0 || h.StatusCode >= 400
+}
+
+// Health returns the last health check of the AnonyURL, or nil if it has not been checked
+func (a AnonyURL) Health() *LinkHealth {
+	if a.LastCheckedAt == nil {
+		return nil
+	}
+	return &LinkHealth{
+		StatusCode: a.LastStatusCode,
+		Latency:    time.Duration(a.LastLatencyMS) * time.Millisecond,
+		CheckedAt:  *a.LastCheckedAt,
+	}
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLinkHealth_IsBroken(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int64
+		want       bool
+	}{
+		{name: "NORMAL: 200", statusCode: 200, want: false},
+		{name: "NORMAL: 3xx", statusCode: 304, want: false},
+		{name: "NORMAL: 404", statusCode: 404, want: true},
+		{name: "NORMAL: 503", statusCode: 503, want: true},
+		{name: "NORMAL: 接続できなかった場合", statusCode: 0, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (LinkHealth{StatusCode: tt.statusCode}).IsBroken(); got != tt.want {
+				t.Errorf("LinkHealth.IsBroken() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnonyURL_Health(t *testing.T) {
+	checkedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		a    AnonyURL
+		want *LinkHealth
+	}{
+		{name: "NORMAL: 未確認の場合", a: AnonyURL{}, want: nil},
+		{
+			name: "NORMAL: 確認済みの場合",
+			a:    AnonyURL{LastStatusCode: 404, LastLatencyMS: 120, LastCheckedAt: &checkedAt},
+			want: &LinkHealth{StatusCode: 404, Latency: 120 * time.Millisecond, CheckedAt: checkedAt},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Health(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AnonyURL.Health() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// プレビューの各項目のカラム長
+const (
+	MaxPreviewTitleLength       = 255
+	MaxPreviewDescriptionLength = 1024
+)
+
+// LinkPreview is the metadata of the destination page shown with the AnonyURL
+type LinkPreview struct {
+	Title       string    `json:"title" db:"preview_title"`             // og:title, 無い場合は<title>
+	Description string    `json:"description" db:"preview_description"` // og:description, 無い場合はmeta description
+	Image       string    `json:"image" db:"preview_image"`             // og:image
+	Favicon     string    `json:"favicon" db:"preview_favicon"`
+	FetchedAt   time.Time `json:"fetched_at" db:"preview_fetched_at"`
+}
+
+// Truncate trims spaces and truncates the texts to the column lengths
+// URLは長すぎる場合は保存しない
+func (p *LinkPreview) Truncate() {
+	p.Title = truncateRunes(strings.Join(strings.Fields(p.Title), " "), MaxPreviewTitleLength)
+	p.Description = truncateRunes(strings.Join(strings.Fields(p.Description), " "), MaxPreviewDescriptionLength)
+	if len(p.Image) > MaxURLLength {
+		p.Image = ""
+	}
+	if len(p.Favicon) > MaxURLLength {
+		p.Favicon = ""
+	}
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
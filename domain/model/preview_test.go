@@ -0,0 +1,40 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLinkPreview_Truncate(t *testing.T) {
+	longURL := "https://example.com/" + strings.Repeat("a", MaxURLLength)
+	tests := []struct {
+		name string
+		p    LinkPreview
+		want LinkPreview
+	}{
+		{
+			name: "NORMAL: 空白をまとめる",
+			p:    LinkPreview{Title: "  Example\n\t Domain ", Description: "line1\nline2"},
+			want: LinkPreview{Title: "Example Domain", Description: "line1 line2"},
+		},
+		{
+			name: "NORMAL: 文字数で切り詰める",
+			p:    LinkPreview{Title: strings.Repeat("あ", MaxPreviewTitleLength+1), Description: strings.Repeat("い", MaxPreviewDescriptionLength+1)},
+			want: LinkPreview{Title: strings.Repeat("あ", MaxPreviewTitleLength), Description: strings.Repeat("い", MaxPreviewDescriptionLength)},
+		},
+		{
+			name: "NORMAL: 長すぎるURLは保存しない",
+			p:    LinkPreview{Image: longURL, Favicon: "https://example.com/favicon.ico"},
+			want: LinkPreview{Favicon: "https://example.com/favicon.ico"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.p.Truncate()
+			if !reflect.DeepEqual(tt.p, tt.want) {
+				t.Errorf("LinkPreview.Truncate() = %+v, want %+v", tt.p, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package model
+
+// クォータの種類
+const (
+	QuotaAnonyURLs       = "anony_urls"
+	QuotaActiveAnonyURLs = "active_anony_urls"
+	QuotaCreationsPerDay = "creations_per_day"
+)
+
+// Quota is the limits of the user's AnonyURLs, 0 means unlimited
+type Quota struct {
+	MaxAnonyURLs       int64 `json:"max_anony_urls"`
+	MaxActiveAnonyURLs int64 `json:"max_active_anony_urls"`
+	// 直近24時間に作成できるAnonyURLの数
+	MaxCreationsPerDay int64 `json:"max_creations_per_day"`
+}
+
+// UserQuota overrides the default quota for the user, nil means the default
+type UserQuota struct {
+	UserID             string `db:"user_id"`
+	MaxAnonyURLs       *int64 `db:"max_anony_urls"`
+	MaxActiveAnonyURLs *int64 `db:"max_active_anony_urls"`
+	MaxCreationsPerDay *int64 `db:"max_creations_per_day"`
+}
+
+// QuotaUsage is the current usage of the user's quota
+type QuotaUsage struct {
+	AnonyURLs        int64 `db:"anony_urls"`
+	ActiveAnonyURLs  int64 `db:"active_anony_urls"`
+	CreationsLastDay int64 `db:"creations_last_day"`
+}
+
+// Override returns the quota whose limits are replaced by the ones set for the user
+func (q Quota) Override(u *UserQuota) Quota {
+	if u == nil {
+		return q
+	}
+	if u.MaxAnonyURLs != nil {
+		q.MaxAnonyURLs = *u.MaxAnonyURLs
+	}
+	if u.MaxActiveAnonyURLs != nil {
+		q.MaxActiveAnonyURLs = *u.MaxActiveAnonyURLs
+	}
+	if u.MaxCreationsPerDay != nil {
+		q.MaxCreationsPerDay = *u.MaxCreationsPerDay
+	}
+	return q
+}
+
+// Unlimited reports whether the quota has no limits
+func (q Quota) Unlimited() bool {
+	return q.MaxAnonyURLs <= 0 && q.MaxActiveAnonyURLs <= 0 && q.MaxCreationsPerDay <= 0
+}
+
+// Exceeded returns the kind of the limit exceeded by creating and activating AnonyURLs, or "" if all limits are kept
+// createdは新しく作成する数, activatedは新しく有効になる数(作成を含む)
+func (q Quota) Exceeded(u QuotaUsage, created, activated int64) string {
+	if created > 0 && q.MaxAnonyURLs > 0 && u.AnonyURLs+created > q.MaxAnonyURLs {
+		return QuotaAnonyURLs
+	}
+	if created > 0 && q.MaxCreationsPerDay > 0 && u.CreationsLastDay+created > q.MaxCreationsPerDay {
+		return QuotaCreationsPerDay
+	}
+	if activated > 0 && q.MaxActiveAnonyURLs > 0 && u.ActiveAnonyURLs+activated > q.MaxActiveAnonyURLs {
+		return QuotaActiveAnonyURLs
+	}
+	return ""
+}
+
+// Max returns the limit of the kind
+func (q Quota) Max(kind string) int64 {
+	switch kind {
+	case QuotaAnonyURLs:
+		return q.MaxAnonyURLs
+	case QuotaActiveAnonyURLs:
+		return q.MaxActiveAnonyURLs
+	case QuotaCreationsPerDay:
+		return q.MaxCreationsPerDay
+	}
+	return 0
+}
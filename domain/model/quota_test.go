@@ -0,0 +1,48 @@
+package model
+
+import "testing"
+
+func TestQuota_Override(t *testing.T) {
+	zero, ten := int64(0), int64(10)
+	q := Quota{MaxAnonyURLs: 100, MaxActiveAnonyURLs: 50, MaxCreationsPerDay: 20}
+	tests := []struct {
+		name string
+		u    *UserQuota
+		want Quota
+	}{
+		{name: "NORMAL: ユーザーごとの設定がない場合はデフォルト", u: nil, want: q},
+		{name: "NORMAL: 設定した項目だけ置き換える", u: &UserQuota{MaxAnonyURLs: &ten}, want: Quota{MaxAnonyURLs: 10, MaxActiveAnonyURLs: 50, MaxCreationsPerDay: 20}},
+		{name: "NORMAL: 0は無制限", u: &UserQuota{MaxCreationsPerDay: &zero}, want: Quota{MaxAnonyURLs: 100, MaxActiveAnonyURLs: 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := q.Override(tt.u); got != tt.want {
+				t.Errorf("Quota.Override() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuota_Exceeded(t *testing.T) {
+	q := Quota{MaxAnonyURLs: 10, MaxActiveAnonyURLs: 5, MaxCreationsPerDay: 3}
+	tests := []struct {
+		name      string
+		u         QuotaUsage
+		created   int64
+		activated int64
+		want      string
+	}{
+		{name: "NORMAL: 上限ちょうどまで作成できる", u: QuotaUsage{AnonyURLs: 9, ActiveAnonyURLs: 4, CreationsLastDay: 2}, created: 1, activated: 1, want: ""},
+		{name: "NORMAL: 作成しない場合はリンクの数の上限を見ない", u: QuotaUsage{AnonyURLs: 10, CreationsLastDay: 3}, activated: 1, want: ""},
+		{name: "ERROR: リンクの数", u: QuotaUsage{AnonyURLs: 10}, created: 1, want: QuotaAnonyURLs},
+		{name: "ERROR: 1日に作成できる数", u: QuotaUsage{CreationsLastDay: 2}, created: 2, want: QuotaCreationsPerDay},
+		{name: "ERROR: 有効なリンクの数", u: QuotaUsage{ActiveAnonyURLs: 5}, activated: 1, want: QuotaActiveAnonyURLs},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := q.Exceeded(tt.u, tt.created, tt.activated); got != tt.want {
+				t.Errorf("Quota.Exceeded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
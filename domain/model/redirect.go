@@ -0,0 +1,82 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// QueryMode is how to pass the query of the short URL to the original URL
+const (
+	// QueryModeIgnore drops the incoming query
+	QueryModeIgnore int64 = 0
+	// QueryModeAppend appends the incoming query to the original query
+	QueryModeAppend int64 = 1
+	// QueryModeOverride replaces the original query having the same keys as the incoming query
+	QueryModeOverride int64 = 2
+)
+
+// Destination returns the URL to redirect to
+// extraPathはコード以降のエスケープされたパス, queryはリクエストのクエリ
+func (a AnonyURL) Destination(extraPath string, query url.Values) (string, error) {
+	dest, err := url.Parse(a.Original)
+	if err != nil {
+		return "", fmt.Errorf("original is invalid url: %w", err)
+	}
+
+	extraPath = strings.Trim(extraPath, "/")
+	if a.ForwardPath && extraPath != "" {
+		rawPath := strings.TrimSuffix(dest.EscapedPath(), "/") + "/" + extraPath
+		path, err := url.PathUnescape(rawPath)
+		if err != nil {
+			return "", fmt.Errorf("path is invalid: %w", err)
+		}
+		dest.Path = path
+		dest.RawPath = rawPath
+	}
+
+	// リンクのUTMは元のURLのUTMより優先する
+	if !a.UTM.IsZero() {
+		dest.RawQuery = mergeQuery(dest.RawQuery, a.UTM.Values(), QueryModeOverride)
+	}
+	if len(query) != 0 {
+		dest.RawQuery = mergeQuery(dest.RawQuery, query, a.QueryMode)
+	}
+	return dest.String(), nil
+}
+
+// mergeQuery merges the incoming query into the raw query of the original URL
+// 元のクエリの順序とエンコードはそのまま残す
+func mergeQuery(rawQuery string, query url.Values, mode int64) string {
+	switch mode {
+	case QueryModeAppend:
+		return joinRawQuery(rawQuery, query.Encode())
+	case QueryModeOverride:
+		kept := []string{}
+		for _, v := range strings.Split(rawQuery, "&") {
+			if v == "" {
+				continue
+			}
+			key, err := url.QueryUnescape(strings.SplitN(v, "=", 2)[0])
+			if err == nil {
+				if _, ok := query[key]; ok {
+					continue
+				}
+			}
+			kept = append(kept, v)
+		}
+		return joinRawQuery(strings.Join(kept, "&"), query.Encode())
+	default:
+		return rawQuery
+	}
+}
+
+func joinRawQuery(a, b string) string {
+	if a == "" {
+		return b
+	}
+	if b == "" {
+		return a
+	}
+	return a + "&" + b
+}
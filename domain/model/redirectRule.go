@@ -0,0 +1,196 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Platform of the user agent
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+	PlatformWindows = "windows"
+	PlatformMacOS   = "macos"
+	PlatformLinux   = "linux"
+	PlatformOther   = "other"
+)
+
+// MaxRedirectRules is the max number of rules per AnonyURL
+const MaxRedirectRules = 20
+
+var (
+	languageTagRegexp = regexp.MustCompile(`^[a-z]{2,8}(-[a-z0-9]{1,8})*$`)
+	countryCodeRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// RedirectRule is a conditional destination of AnonyURL
+// 条件が空の項目は全てにマッチする
+type RedirectRule struct {
+	ID          string     `json:"id" db:"id"`
+	AnonyURLID  string     `json:"url_id" db:"url_id"`
+	Priority    int64      `json:"priority" db:"priority"`         // 小さい順に評価する
+	Platform    string     `json:"platform" db:"platform"`         // ios, android, windows, macos, linux, other
+	Language    string     `json:"language" db:"language"`         // Accept-Languageの最優先の言語. "ja"は"ja-jp"にもマッチする
+	Country     string     `json:"country" db:"country"`           // ISO 3166-1 alpha-2
+	ActiveFrom  *time.Time `json:"active_from" db:"active_from"`   // この時刻以降にマッチする
+	ActiveUntil *time.Time `json:"active_until" db:"active_until"` // この時刻より前にマッチする
+	Destination string     `json:"destination" db:"destination"`
+}
+
+// NewRedirectRule creates a new RedirectRule
+func NewRedirectRule(id, anonyURLID string, priority int64, destination string) *RedirectRule {
+	return &RedirectRule{
+		ID:          id,
+		AnonyURLID:  anonyURLID,
+		Priority:    priority,
+		Destination: destination,
+	}
+}
+
+// ValidateRedirectRule validates RedirectRule params
+func (r RedirectRule) ValidateRedirectRule() error {
+	if r.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if r.AnonyURLID == "" {
+		return fmt.Errorf("url_id is required")
+	}
+	if err := validateDestination("destination", r.Destination); err != nil {
+		return err
+	}
+	if r.Platform != "" && !IsValidPlatform(r.Platform) {
+		return fmt.Errorf("platform is invalid")
+	}
+	if r.Language != "" && !languageTagRegexp.MatchString(r.Language) {
+		return fmt.Errorf("language is invalid")
+	}
+	if r.Country != "" && !countryCodeRegexp.MatchString(r.Country) {
+		return fmt.Errorf("country is invalid")
+	}
+	if r.ActiveFrom != nil && r.ActiveUntil != nil && !r.ActiveFrom.Before(*r.ActiveUntil) {
+		return fmt.Errorf("active_from must be before active_until")
+	}
+	return nil
+}
+
+// IsValidPlatform returns whether the platform is supported
+func IsValidPlatform(platform string) bool {
+	switch platform {
+	case PlatformIOS, PlatformAndroid, PlatformWindows, PlatformMacOS, PlatformLinux, PlatformOther:
+		return true
+	}
+	return false
+}
+
+// Match returns whether the request matches all conditions of the rule
+func (r RedirectRule) Match(c RuleContext) bool {
+	if r.Platform != "" && r.Platform != c.Platform {
+		return false
+	}
+	if r.Language != "" && !matchLanguage(r.Language, c.Language) {
+		return false
+	}
+	if r.Country != "" && r.Country != c.Country {
+		return false
+	}
+	if r.ActiveFrom != nil && c.Now.Before(*r.ActiveFrom) {
+		return false
+	}
+	if r.ActiveUntil != nil && !c.Now.Before(*r.ActiveUntil) {
+		return false
+	}
+	return true
+}
+
+// matchLanguage returns whether the language tag is the range or its sub tag
+func matchLanguage(rangeTag, tag string) bool {
+	return tag == rangeTag || strings.HasPrefix(tag, rangeTag+"-")
+}
+
+// MatchRedirectRule returns the first rule matching the request in order of priority, or nil
+func MatchRedirectRule(rules []*RedirectRule, c RuleContext) *RedirectRule {
+	sorted := make([]*RedirectRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+	for _, v := range sorted {
+		if v.Match(c) {
+			return v
+		}
+	}
+	return nil
+}
+
+// RuleContext is attributes of a request evaluated by RedirectRule
+type RuleContext struct {
+	Platform string
+	Language string // 小文字の言語タグ
+	Country  string // 判定できない場合は空文字
+	Now      time.Time
+}
+
+// NewRuleContext creates RuleContext from the request headers
+func NewRuleContext(userAgent, acceptLanguage, country string, now time.Time) RuleContext {
+	return RuleContext{
+		Platform: DetectPlatform(userAgent),
+		Language: PreferredLanguage(acceptLanguage),
+		Country:  strings.ToUpper(country),
+		Now:      now,
+	}
+}
+
+// DetectPlatform detects the platform from User-Agent
+func DetectPlatform(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	switch {
+	// AndroidのUser-Agentは"Linux"を含むので先に判定する
+	case strings.Contains(ua, "android"):
+		return PlatformAndroid
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"), strings.Contains(ua, "ipod"):
+		return PlatformIOS
+	case strings.Contains(ua, "windows"):
+		return PlatformWindows
+	case strings.Contains(ua, "macintosh"), strings.Contains(ua, "mac os x"):
+		return PlatformMacOS
+	case strings.Contains(ua, "linux"), strings.Contains(ua, "x11"):
+		return PlatformLinux
+	}
+	return PlatformOther
+}
+
+// PreferredLanguage returns the language tag with the highest quality in Accept-Language
+// 同じqの場合は先に書かれたものを優先する
+func PreferredLanguage(acceptLanguage string) string {
+	lang := ""
+	best := 0.0
+	for _, v := range strings.Split(acceptLanguage, ",") {
+		parts := strings.Split(v, ";")
+		tag := strings.ToLower(strings.TrimSpace(parts[0]))
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		for _, p := range parts[1:] {
+			p = strings.TrimSpace(p)
+			if !strings.HasPrefix(p, "q=") {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimPrefix(p, "q="), 64)
+			if err != nil {
+				q = 0
+				break
+			}
+			q = parsed
+		}
+		if q > best {
+			lang = tag
+			best = q
+		}
+	}
+	return lang
+}
@@ -0,0 +1,195 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+const (
+	uaIPhone  = "Mozilla/5.0 (iPhone; CPU iPhone OS 14_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.0 Mobile/15E148 Safari/604.1"
+	uaAndroid = "Mozilla/5.0 (Linux; Android 11; Pixel 5) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.101 Mobile Safari/537.36"
+	uaWindows = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36"
+	uaMac     = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.0.2 Safari/605.1.15"
+	uaLinux   = "Mozilla/5.0 (X11; Linux x86_64; rv:84.0) Gecko/20100101 Firefox/84.0"
+)
+
+func TestDetectPlatform(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		want      string
+	}{
+		{name: "NORMAL: iPhone", userAgent: uaIPhone, want: PlatformIOS},
+		{name: "NORMAL: Android", userAgent: uaAndroid, want: PlatformAndroid},
+		{name: "NORMAL: Windows", userAgent: uaWindows, want: PlatformWindows},
+		{name: "NORMAL: Mac", userAgent: uaMac, want: PlatformMacOS},
+		{name: "NORMAL: Linux", userAgent: uaLinux, want: PlatformLinux},
+		{name: "NORMAL: 判定できない場合", userAgent: "curl/7.64.1", want: PlatformOther},
+		{name: "NORMAL: 空文字", userAgent: "", want: PlatformOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectPlatform(tt.userAgent); got != tt.want {
+				t.Errorf("DetectPlatform() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreferredLanguage(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "NORMAL: 1つの場合", acceptLanguage: "ja", want: "ja"},
+		{name: "NORMAL: 先頭を優先する", acceptLanguage: "ja-JP,ja;q=0.9,en-US;q=0.8", want: "ja-jp"},
+		{name: "NORMAL: qが大きいものを優先する", acceptLanguage: "en;q=0.5, fr;q=0.8", want: "fr"},
+		{name: "NORMAL: q=0は除く", acceptLanguage: "de;q=0, *", want: ""},
+		{name: "NORMAL: 不正なqは除く", acceptLanguage: "de;q=x, en;q=0.1", want: "en"},
+		{name: "NORMAL: 空文字", acceptLanguage: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PreferredLanguage(tt.acceptLanguage); got != tt.want {
+				t.Errorf("PreferredLanguage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchRedirectRule(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+	rules := []*RedirectRule{
+		{ID: "ios", Priority: 0, Platform: PlatformIOS, Destination: "https://apps.apple.com/app"},
+		{ID: "android", Priority: 1, Platform: PlatformAndroid, Destination: "https://play.google.com/store/apps"},
+		{ID: "ja-jp", Priority: 2, Language: "ja", Country: "JP", Destination: "https://example.jp"},
+		{ID: "en", Priority: 3, Language: "en-us", Destination: "https://example.com/us"},
+		{ID: "expired", Priority: 4, ActiveUntil: &now, Destination: "https://example.com/expired"},
+		{ID: "future", Priority: 5, ActiveFrom: &after, Destination: "https://example.com/future"},
+		{ID: "now", Priority: 6, Platform: PlatformLinux, ActiveFrom: &before, ActiveUntil: &after, Destination: "https://example.com/now"},
+	}
+	tests := []struct {
+		name  string
+		rules []*RedirectRule
+		ctx   RuleContext
+		want  string
+	}{
+		{
+			name:  "NORMAL: iOSの場合",
+			rules: rules,
+			ctx:   NewRuleContext(uaIPhone, "ja-JP", "JP", now),
+			want:  "ios",
+		},
+		{
+			name:  "NORMAL: Androidの場合",
+			rules: rules,
+			ctx:   NewRuleContext(uaAndroid, "en-US", "US", now),
+			want:  "android",
+		},
+		{
+			name:  "NORMAL: 言語と国が一致する場合",
+			rules: rules,
+			ctx:   NewRuleContext(uaWindows, "ja-JP,en;q=0.5", "jp", now),
+			want:  "ja-jp",
+		},
+		{
+			name:  "NORMAL: 言語が一致しても国が一致しない場合",
+			rules: rules,
+			ctx:   NewRuleContext(uaWindows, "ja", "US", now),
+			want:  "",
+		},
+		{
+			name:  "NORMAL: 地域付きの言語は同じ地域のみ一致する",
+			rules: rules,
+			ctx:   NewRuleContext(uaMac, "en-GB", "GB", now),
+			want:  "",
+		},
+		{
+			name:  "NORMAL: 地域付きの言語が一致する場合",
+			rules: rules,
+			ctx:   NewRuleContext(uaMac, "en-US", "", now),
+			want:  "en",
+		},
+		{
+			name:  "NORMAL: 期間内のルールのみ一致する",
+			rules: rules,
+			ctx:   NewRuleContext(uaLinux, "fr", "FR", now),
+			want:  "now",
+		},
+		{
+			name:  "NORMAL: 終了したルールは一致せず, 開始したルールが一致する",
+			rules: rules,
+			ctx:   NewRuleContext(uaLinux, "fr", "FR", after),
+			want:  "future",
+		},
+		{
+			name: "NORMAL: Priorityの順に評価する",
+			rules: []*RedirectRule{
+				{ID: "second", Priority: 2, Destination: "https://example.com/2"},
+				{ID: "first", Priority: 1, Destination: "https://example.com/1"},
+			},
+			ctx:  NewRuleContext(uaLinux, "", "", now),
+			want: "first",
+		},
+		{
+			name:  "NORMAL: ルールがない場合",
+			rules: []*RedirectRule{},
+			ctx:   NewRuleContext(uaIPhone, "ja", "JP", now),
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MatchRedirectRule(tt.rules, tt.ctx)
+			gotID := ""
+			if got != nil {
+				gotID = got.ID
+			}
+			if gotID != tt.want {
+				t.Errorf("MatchRedirectRule() = %v, want %v", gotID, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedirectRule_ValidateRedirectRule(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	valid := func() RedirectRule {
+		return RedirectRule{
+			ID:          "id",
+			AnonyURLID:  "url-id",
+			Platform:    PlatformIOS,
+			Language:    "ja-jp",
+			Country:     "JP",
+			Destination: "https://apps.apple.com/app",
+		}
+	}
+	tests := []struct {
+		name    string
+		modify  func(r *RedirectRule)
+		wantErr bool
+	}{
+		{name: "NORMAL: 正常な場合", modify: func(r *RedirectRule) {}, wantErr: false},
+		{name: "NORMAL: 条件がない場合", modify: func(r *RedirectRule) { r.Platform, r.Language, r.Country = "", "", "" }, wantErr: false},
+		{name: "ERROR: idがない場合", modify: func(r *RedirectRule) { r.ID = "" }, wantErr: true},
+		{name: "ERROR: url_idがない場合", modify: func(r *RedirectRule) { r.AnonyURLID = "" }, wantErr: true},
+		{name: "ERROR: destinationがない場合", modify: func(r *RedirectRule) { r.Destination = "" }, wantErr: true},
+		{name: "ERROR: destinationがhttpでない場合", modify: func(r *RedirectRule) { r.Destination = "javascript:alert(1)" }, wantErr: true},
+		{name: "ERROR: platformが不正な場合", modify: func(r *RedirectRule) { r.Platform = "ps5" }, wantErr: true},
+		{name: "ERROR: languageが不正な場合", modify: func(r *RedirectRule) { r.Language = "ja_JP" }, wantErr: true},
+		{name: "ERROR: countryが不正な場合", modify: func(r *RedirectRule) { r.Country = "jpn" }, wantErr: true},
+		{name: "ERROR: 期間が不正な場合", modify: func(r *RedirectRule) { r.ActiveFrom, r.ActiveUntil = &now, &now }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.modify(&r)
+			if err := r.ValidateRedirectRule(); (err != nil) != tt.wantErr {
+				t.Errorf("RedirectRule.ValidateRedirectRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAnonyURL_Destination(t *testing.T) {
+	type fields struct {
+		Original    string
+		QueryMode   int64
+		ForwardPath bool
+		UTM         UTM
+	}
+	type args struct {
+		extraPath string
+		query     url.Values
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "NORMAL: パスとクエリがない場合はOriginalを返す",
+			fields: fields{
+				Original: "https://example.com/page?a=1",
+			},
+			args:    args{},
+			want:    "https://example.com/page?a=1",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: QueryModeIgnoreの場合はクエリを無視する",
+			fields: fields{
+				Original:  "https://example.com/page?a=1",
+				QueryMode: QueryModeIgnore,
+			},
+			args: args{
+				query: url.Values{"utm_source": {"mail"}},
+			},
+			want:    "https://example.com/page?a=1",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: QueryModeAppendの場合はクエリを追加する",
+			fields: fields{
+				Original:  "https://example.com/page?a=1&b=x%20y",
+				QueryMode: QueryModeAppend,
+			},
+			args: args{
+				query: url.Values{"a": {"2"}, "utm_source": {"mail"}},
+			},
+			want:    "https://example.com/page?a=1&b=x%20y&a=2&utm_source=mail",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: QueryModeOverrideの場合は同じキーを上書きする",
+			fields: fields{
+				Original:  "https://example.com/page?a=1&b=x%20y&a=3",
+				QueryMode: QueryModeOverride,
+			},
+			args: args{
+				query: url.Values{"a": {"2"}},
+			},
+			want:    "https://example.com/page?b=x%20y&a=2",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: Originalにクエリがない場合",
+			fields: fields{
+				Original:  "https://example.com/page",
+				QueryMode: QueryModeAppend,
+			},
+			args: args{
+				query: url.Values{"q": {"日本語 & more"}},
+			},
+			want:    "https://example.com/page?q=%E6%97%A5%E6%9C%AC%E8%AA%9E+%26+more",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: ForwardPathの場合はパスを追加する",
+			fields: fields{
+				Original:    "https://example.com/docs/?a=1",
+				ForwardPath: true,
+			},
+			args: args{
+				extraPath: "xyz/a%2Fb%20c",
+			},
+			want:    "https://example.com/docs/xyz/a%2Fb%20c?a=1",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: ForwardPathでない場合はパスを無視する",
+			fields: fields{
+				Original: "https://example.com/docs",
+			},
+			args: args{
+				extraPath: "xyz",
+			},
+			want:    "https://example.com/docs",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: パスとクエリを両方引き継ぐ",
+			fields: fields{
+				Original:    "https://example.com",
+				QueryMode:   QueryModeAppend,
+				ForwardPath: true,
+			},
+			args: args{
+				extraPath: "xyz",
+				query:     url.Values{"utm_source": {"mail"}},
+			},
+			want:    "https://example.com/xyz?utm_source=mail",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: UTMは元のURLの同じキーを上書きする",
+			fields: fields{
+				Original: "https://example.com/page?utm_source=old&a=1",
+				UTM: UTM{
+					Source:   "twitter",
+					Campaign: "spring sale",
+				},
+			},
+			args:    args{},
+			want:    "https://example.com/page?a=1&utm_campaign=spring+sale&utm_source=twitter",
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: リクエストのクエリはUTMの後に適用される",
+			fields: fields{
+				Original:  "https://example.com/page",
+				QueryMode: QueryModeOverride,
+				UTM: UTM{
+					Source: "twitter",
+					Medium: "social",
+				},
+			},
+			args: args{
+				query: url.Values{"utm_medium": {"email"}},
+			},
+			want:    "https://example.com/page?utm_source=twitter&utm_medium=email",
+			wantErr: false,
+		},
+		{
+			name: "ERROR: パスのエスケープが不正な場合",
+			fields: fields{
+				Original:    "https://example.com",
+				ForwardPath: true,
+			},
+			args: args{
+				extraPath: "%zz",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnonyURL{
+				Original:    tt.fields.Original,
+				QueryMode:   tt.fields.QueryMode,
+				ForwardPath: tt.fields.ForwardPath,
+				UTM:         tt.fields.UTM,
+			}
+			got, err := a.Destination(tt.args.extraPath, tt.args.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AnonyURL.Destination() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("AnonyURL.Destination() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// StatusChangeEvent is emitted when a scheduled AnonyURL starts or stops redirecting
+type StatusChangeEvent struct {
+	AnonyURLID string
+	Active     bool // true: active_fromを迎えた, false: active_untilを迎えた
+	At         time.Time
+}
+
+// InSchedule returns whether now is in the activation window
+// active_fromは含み, active_untilは含まない
+func (a AnonyURL) InSchedule(now time.Time) bool {
+	if a.ActiveFrom != nil && now.Before(*a.ActiveFrom) {
+		return false
+	}
+	if a.ActiveUntil != nil && !now.Before(*a.ActiveUntil) {
+		return false
+	}
+	return true
+}
+
+// ValidateSchedule validates the activation window and the fallback
+func (a AnonyURL) ValidateSchedule() error {
+	if a.ActiveFrom != nil && a.ActiveUntil != nil && !a.ActiveFrom.Before(*a.ActiveUntil) {
+		return fmt.Errorf("active_from must be before active_until")
+	}
+	if a.Fallback != "" {
+		if err := validateDestination("fallback", a.Fallback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScheduleEvents returns events of the boundaries in (from, to] in order of time
+func (a AnonyURL) ScheduleEvents(from, to time.Time) []StatusChangeEvent {
+	events := []StatusChangeEvent{}
+	if a.ActiveFrom != nil && a.ActiveFrom.After(from) && !a.ActiveFrom.After(to) {
+		events = append(events, StatusChangeEvent{AnonyURLID: a.ID, Active: true, At: *a.ActiveFrom})
+	}
+	if a.ActiveUntil != nil && a.ActiveUntil.After(from) && !a.ActiveUntil.After(to) {
+		events = append(events, StatusChangeEvent{AnonyURLID: a.ID, Active: false, At: *a.ActiveUntil})
+	}
+	return events
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAnonyURL_InSchedule(t *testing.T) {
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := from.Add(24 * time.Hour)
+	tests := []struct {
+		name        string
+		activeFrom  *time.Time
+		activeUntil *time.Time
+		now         time.Time
+		want        bool
+	}{
+		{name: "NORMAL: 期間の指定がない場合", now: from, want: true},
+		{name: "NORMAL: 開始前", activeFrom: &from, activeUntil: &until, now: from.Add(-time.Second), want: false},
+		{name: "NORMAL: 開始時刻ちょうど", activeFrom: &from, activeUntil: &until, now: from, want: true},
+		{name: "NORMAL: 期間内", activeFrom: &from, activeUntil: &until, now: from.Add(time.Hour), want: true},
+		{name: "NORMAL: 終了時刻ちょうど", activeFrom: &from, activeUntil: &until, now: until, want: false},
+		{name: "NORMAL: 開始のみ指定", activeFrom: &from, now: until.Add(time.Hour), want: true},
+		{name: "NORMAL: 終了のみ指定", activeUntil: &until, now: from.Add(-time.Hour), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnonyURL{ActiveFrom: tt.activeFrom, ActiveUntil: tt.activeUntil}
+			if got := a.InSchedule(tt.now); got != tt.want {
+				t.Errorf("AnonyURL.InSchedule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnonyURL_ValidateSchedule(t *testing.T) {
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := from.Add(24 * time.Hour)
+	tests := []struct {
+		name        string
+		activeFrom  *time.Time
+		activeUntil *time.Time
+		fallback    string
+		wantErr     bool
+	}{
+		{name: "NORMAL: 指定がない場合", wantErr: false},
+		{name: "NORMAL: 正常な場合", activeFrom: &from, activeUntil: &until, fallback: "https://example.com/closed", wantErr: false},
+		{name: "ERROR: 開始が終了より後の場合", activeFrom: &until, activeUntil: &from, wantErr: true},
+		{name: "ERROR: 開始と終了が同じ場合", activeFrom: &from, activeUntil: &from, wantErr: true},
+		{name: "ERROR: fallbackが不正な場合", fallback: "example.com/closed", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnonyURL{ActiveFrom: tt.activeFrom, ActiveUntil: tt.activeUntil, Fallback: tt.fallback}
+			if err := a.ValidateSchedule(); (err != nil) != tt.wantErr {
+				t.Errorf("AnonyURL.ValidateSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAnonyURL_ScheduleEvents(t *testing.T) {
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Minute)
+	inside := from.Add(30 * time.Second)
+	outside := to.Add(time.Second)
+	tests := []struct {
+		name        string
+		activeFrom  *time.Time
+		activeUntil *time.Time
+		want        []StatusChangeEvent
+	}{
+		{
+			name:       "NORMAL: 開始が区間内",
+			activeFrom: &inside,
+			want:       []StatusChangeEvent{{AnonyURLID: "id", Active: true, At: inside}},
+		},
+		{
+			name:        "NORMAL: 終了が区間の末尾",
+			activeUntil: &to,
+			want:        []StatusChangeEvent{{AnonyURLID: "id", Active: false, At: to}},
+		},
+		{
+			name:       "NORMAL: 区間の先頭は含まない",
+			activeFrom: &from,
+			want:       []StatusChangeEvent{},
+		},
+		{
+			name:        "NORMAL: 区間外",
+			activeFrom:  &outside,
+			activeUntil: &outside,
+			want:        []StatusChangeEvent{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := AnonyURL{ID: "id", ActiveFrom: tt.activeFrom, ActiveUntil: tt.activeUntil}
+			if got := a.ScheduleEvents(from, to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AnonyURL.ScheduleEvents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
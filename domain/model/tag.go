@@ -0,0 +1,129 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxTagNameLength is the max number of characters in a tag name
+	MaxTagNameLength = 64
+	// MaxTagDepth is the max number of levels of nested tags
+	// 親タグの削除はDBのON DELETE CASCADEで子孫に伝播するため, MySQLの上限(15段)より小さくする
+	MaxTagDepth = 8
+	// TagPathSeparator separates the names of the ancestors in the path of a tag
+	TagPathSeparator = "/"
+)
+
+// Tag is a label of AnonyURLs
+// 親を持つタグはフォルダの階層として扱い, 親タグで絞り込むと子孫のタグが付いたリンクも含める
+type Tag struct {
+	ID       string `json:"id" db:"id"`
+	UserID   string `json:"user_id" db:"user_id"`
+	Name     string `json:"name" db:"name"`
+	ParentID string `json:"parent_id" db:"parent_id"` // 空文字: 最上位
+	// 最上位からの名前をTagPathSeparatorで結合したもの. Tags.FillPathsで設定する
+	Path string `json:"path" db:"-"`
+}
+
+// NewTag create a new Tag
+func NewTag(id string, userID string, name string, parentID string) *Tag {
+	return &Tag{
+		ID:       id,
+		UserID:   userID,
+		Name:     strings.TrimSpace(name),
+		ParentID: parentID,
+	}
+}
+
+// ValidateTag validates Tag params
+func (t Tag) ValidateTag() error {
+	if t.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if t.UserID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+	return ValidateTagName(t.Name)
+}
+
+// ValidateTagName validates the name of a tag
+func ValidateTagName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if utf8.RuneCountInString(name) > MaxTagNameLength {
+		return fmt.Errorf("name must be %d characters or less", MaxTagNameLength)
+	}
+	// パスの区切り文字と紛らわしいため使えない
+	if strings.Contains(name, TagPathSeparator) {
+		return fmt.Errorf("name must not contain %q", TagPathSeparator)
+	}
+	return nil
+}
+
+// Tags are all tags of a user
+type Tags []*Tag
+
+// Find returns the tag of the id, or nil if it is not found
+func (ts Tags) Find(id string) *Tag {
+	for _, t := range ts {
+		if t.ID == id {
+			return t
+		}
+	}
+	return nil
+}
+
+// Depth returns the number of levels from the top to the tag, which is 1 for a top level tag
+func (ts Tags) Depth(id string) int {
+	depth := 0
+	// 親が見つからない場合や循環している場合に備えて, 上限を超えたら打ち切る
+	for t := ts.Find(id); t != nil && depth <= len(ts); t = ts.Find(t.ParentID) {
+		depth++
+	}
+	return depth
+}
+
+// Path returns the names from the top to the tag joined by TagPathSeparator
+func (ts Tags) Path(id string) string {
+	var names []string
+	for t := ts.Find(id); t != nil && len(names) <= len(ts); t = ts.Find(t.ParentID) {
+		names = append([]string{t.Name}, names...)
+	}
+	return strings.Join(names, TagPathSeparator)
+}
+
+// FillPaths sets the path of each tag
+func (ts Tags) FillPaths() {
+	for _, t := range ts {
+		t.Path = ts.Path(t.ID)
+	}
+}
+
+// Descendants returns the ids of the tag and all its descendants
+func (ts Tags) Descendants(id string) []string {
+	res := []string{id}
+	seen := map[string]bool{id: true}
+	// 幅優先で子を辿る. resに追加した順に親として調べる
+	for i := 0; i < len(res); i++ {
+		for _, t := range ts {
+			if t.ParentID == res[i] && !seen[t.ID] {
+				seen[t.ID] = true
+				res = append(res, t.ID)
+			}
+		}
+	}
+	return res
+}
+
+// HasSibling returns true if another tag with the name exists under the parent
+func (ts Tags) HasSibling(name, parentID, exceptID string) bool {
+	for _, t := range ts {
+		if t.ID != exceptID && t.ParentID == parentID && strings.EqualFold(t.Name, name) {
+			return true
+		}
+	}
+	return false
+}
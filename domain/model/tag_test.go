@@ -0,0 +1,106 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewTag(t *testing.T) {
+	want := &Tag{ID: "id", UserID: "user-id", Name: "work", ParentID: "parent-id"}
+	if got := NewTag("id", "user-id", "  work ", "parent-id"); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewTag() = %v, want %v", got, want)
+	}
+}
+
+func TestTag_ValidateTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     Tag
+		wantErr bool
+	}{
+		{name: "NORMAL: 正常な場合は, nilを返す", tag: Tag{ID: "id", UserID: "user-id", Name: "仕事"}, wantErr: false},
+		{name: "NORMAL: 64文字まで", tag: Tag{ID: "id", UserID: "user-id", Name: strings.Repeat("あ", 64)}, wantErr: false},
+		{name: "ERROR: IDが空", tag: Tag{UserID: "user-id", Name: "work"}, wantErr: true},
+		{name: "ERROR: UserIDが空", tag: Tag{ID: "id", Name: "work"}, wantErr: true},
+		{name: "ERROR: Nameが空", tag: Tag{ID: "id", UserID: "user-id"}, wantErr: true},
+		{name: "ERROR: Nameが65文字", tag: Tag{ID: "id", UserID: "user-id", Name: strings.Repeat("あ", 65)}, wantErr: true},
+		{name: "ERROR: Nameに区切り文字を含む", tag: Tag{ID: "id", UserID: "user-id", Name: "a/b"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tag.ValidateTag(); (err != nil) != tt.wantErr {
+				t.Errorf("Tag.ValidateTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTags(t *testing.T) {
+	// work ─┬─ clients ── acme
+	//       └─ internal
+	// home
+	ts := Tags{
+		{ID: "work", Name: "Work"},
+		{ID: "clients", Name: "Clients", ParentID: "work"},
+		{ID: "acme", Name: "ACME", ParentID: "clients"},
+		{ID: "internal", Name: "Internal", ParentID: "work"},
+		{ID: "home", Name: "Home"},
+	}
+
+	depths := map[string]int{"work": 1, "clients": 2, "acme": 3, "home": 1, "unknown": 0}
+	for id, want := range depths {
+		if got := ts.Depth(id); got != want {
+			t.Errorf("Tags.Depth(%q) = %v, want %v", id, got, want)
+		}
+	}
+
+	paths := map[string]string{"acme": "Work/Clients/ACME", "internal": "Work/Internal", "home": "Home", "unknown": ""}
+	for id, want := range paths {
+		if got := ts.Path(id); got != want {
+			t.Errorf("Tags.Path(%q) = %v, want %v", id, got, want)
+		}
+	}
+
+	ts.FillPaths()
+	for _, tag := range ts {
+		if tag.Path != ts.Path(tag.ID) {
+			t.Errorf("Tags.FillPaths() path of %q = %v", tag.ID, tag.Path)
+		}
+	}
+
+	descendants := map[string][]string{
+		"work":    {"work", "clients", "internal", "acme"},
+		"clients": {"clients", "acme"},
+		"home":    {"home"},
+	}
+	for id, want := range descendants {
+		if got := ts.Descendants(id); !reflect.DeepEqual(got, want) {
+			t.Errorf("Tags.Descendants(%q) = %v, want %v", id, got, want)
+		}
+	}
+
+	if !ts.HasSibling("clients", "work", "") {
+		t.Errorf("Tags.HasSibling() = false, want true for the same name in another case")
+	}
+	if ts.HasSibling("Clients", "work", "clients") {
+		t.Errorf("Tags.HasSibling() = true, want false for the tag itself")
+	}
+	if ts.HasSibling("Clients", "", "") {
+		t.Errorf("Tags.HasSibling() = true, want false under another parent")
+	}
+}
+
+func TestTags_Cycle(t *testing.T) {
+	// 不正なデータでも無限ループにならない
+	ts := Tags{
+		{ID: "a", Name: "A", ParentID: "b"},
+		{ID: "b", Name: "B", ParentID: "a"},
+	}
+	if got := ts.Depth("a"); got != 3 {
+		t.Errorf("Tags.Depth() = %v, want %v", got, 3)
+	}
+	if got := ts.Descendants("a"); len(got) > 3 {
+		t.Errorf("Tags.Descendants() = %v", got)
+	}
+}
@@ -13,7 +13,7 @@ type User struct {
 	ID            string `json:"id" db:"id"`
 	Name          string `json:"name" db:"name"`
 	Email         string `json:"email" db:"email"`
-	EncryptedPass string `db:"password"`
+	EncryptedPass string `json:"-" db:"password"`
 }
 
 // NewUser create a new user.
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// maxUTMLength is the max length of each UTM parameter
+const maxUTMLength = 255
+
+// UTM is UTM parameters merged into the original URL at redirect time
+type UTM struct {
+	Source   string `json:"utm_source" db:"utm_source"`
+	Medium   string `json:"utm_medium" db:"utm_medium"`
+	Campaign string `json:"utm_campaign" db:"utm_campaign"`
+	Term     string `json:"utm_term" db:"utm_term"`
+	Content  string `json:"utm_content" db:"utm_content"`
+}
+
+// IsZero returns whether no UTM parameter is set
+func (u UTM) IsZero() bool {
+	return u == UTM{}
+}
+
+// Values returns the UTM parameters which are set
+func (u UTM) Values() url.Values {
+	v := url.Values{}
+	for key, value := range map[string]string{
+		"utm_source":   u.Source,
+		"utm_medium":   u.Medium,
+		"utm_campaign": u.Campaign,
+		"utm_term":     u.Term,
+		"utm_content":  u.Content,
+	} {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+	return v
+}
+
+// ValidateUTM validates UTM params
+func (u UTM) ValidateUTM() error {
+	for key, value := range u.Values() {
+		if len(value[0]) > maxUTMLength {
+			return fmt.Errorf("%s is too long", key)
+		}
+	}
+	return nil
+}
+
+// ValidateCampaignChannel validates UTM params of a channel in a campaign
+// キャンペーンではsourceとcampaignでチャネルを区別する
+func (u UTM) ValidateCampaignChannel() error {
+	if u.Source == "" {
+		return fmt.Errorf("utm_source is required")
+	}
+	if u.Campaign == "" {
+		return fmt.Errorf("utm_campaign is required")
+	}
+	return u.ValidateUTM()
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUTM_Values(t *testing.T) {
+	tests := []struct {
+		name string
+		utm  UTM
+		want string
+	}{
+		{
+			name: "NORMAL: 全て設定されている場合",
+			utm: UTM{
+				Source:   "source",
+				Medium:   "medium",
+				Campaign: "campaign",
+				Term:     "term",
+				Content:  "content",
+			},
+			want: "utm_campaign=campaign&utm_content=content&utm_medium=medium&utm_source=source&utm_term=term",
+		},
+		{
+			name: "NORMAL: 空文字のパラメータは含まない",
+			utm: UTM{
+				Source: "source",
+			},
+			want: "utm_source=source",
+		},
+		{
+			name: "NORMAL: 何も設定されていない場合",
+			utm:  UTM{},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.utm.Values().Encode(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UTM.Values() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUTM_ValidateCampaignChannel(t *testing.T) {
+	tests := []struct {
+		name    string
+		utm     UTM
+		wantErr bool
+	}{
+		{
+			name: "NORMAL: sourceとcampaignがある場合",
+			utm: UTM{
+				Source:   "source",
+				Campaign: "campaign",
+			},
+			wantErr: false,
+		},
+		{
+			name: "ERROR: sourceがない場合",
+			utm: UTM{
+				Campaign: "campaign",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: campaignがない場合",
+			utm: UTM{
+				Source: "source",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: 長すぎるパラメータがある場合",
+			utm: UTM{
+				Source:   "source",
+				Campaign: "campaign",
+				Content:  strings.Repeat("a", 256),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.utm.ValidateCampaignChannel(); (err != nil) != tt.wantErr {
+				t.Errorf("UTM.ValidateCampaignChannel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+const (
+	// MaxVariants is the max number of destinations of A/B split per AnonyURL
+	MaxVariants = 10
+	// MaxVariantWeight is the max weight of a variant
+	MaxVariantWeight = 10000
+)
+
+// Variant is one of weighted destinations of AnonyURL for A/B split
+type Variant struct {
+	ID          string `json:"id" db:"id"`
+	AnonyURLID  string `json:"url_id" db:"url_id"`
+	Destination string `json:"destination" db:"destination"`
+	Weight      int64  `json:"weight" db:"weight"`
+	Clicks      int64  `json:"clicks" db:"clicks"`
+}
+
+// NewVariant creates a new Variant
+func NewVariant(id, destination string, weight int64) *Variant {
+	return &Variant{
+		ID:          id,
+		Destination: destination,
+		Weight:      weight,
+	}
+}
+
+// ValidateVariant validates Variant params
+func (v Variant) ValidateVariant() error {
+	if v.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if err := validateDestination("destination", v.Destination); err != nil {
+		return err
+	}
+	if (v.Weight < 1) || (v.Weight > MaxVariantWeight) {
+		return fmt.Errorf("weight must be between 1 and %d", MaxVariantWeight)
+	}
+	return nil
+}
+
+// ValidateVariants validates variants of AnonyURL
+func (a AnonyURL) ValidateVariants() error {
+	if len(a.Variants) == 0 {
+		return nil
+	}
+	if len(a.Variants) > MaxVariants {
+		return fmt.Errorf("the number of variants must be %d or less", MaxVariants)
+	}
+	// 恒久的なリダイレクトはブラウザにキャッシュされ, 振り分けができなくなる
+	switch a.GetRedirectMode() {
+	case RedirectModeMovedPermanently, RedirectModePermanentRedirect:
+		return fmt.Errorf("variants can not be used with permanent redirect")
+	}
+	for i, v := range a.Variants {
+		if err := v.ValidateVariant(); err != nil {
+			return fmt.Errorf("variants[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// PickVariant picks a variant in proportion to the weights, or returns nil if there are no variants
+// 同じkeyには常に同じVariantを返す
+func (a AnonyURL) PickVariant(key string) *Variant {
+	var total int64
+	for _, v := range a.Variants {
+		total += v.Weight
+	}
+	if total <= 0 {
+		return nil
+	}
+	h := fnv.New64a()
+	h.Write([]byte(a.ID + "\x00" + key))
+	n := int64(h.Sum64() % uint64(total))
+	for _, v := range a.Variants {
+		if n < v.Weight {
+			return v
+		}
+		n -= v.Weight
+	}
+	return nil
+}
+
+// FindVariant returns the variant of the id, or nil if it is not found
+func (a AnonyURL) FindVariant(id string) *Variant {
+	for _, v := range a.Variants {
+		if v.ID == id {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAnonyURL_PickVariant(t *testing.T) {
+	an := AnonyURL{
+		ID: "url-id",
+		Variants: []*Variant{
+			{ID: "a", Destination: "https://example.com/a", Weight: 1},
+			{ID: "b", Destination: "https://example.com/b", Weight: 3},
+		},
+	}
+
+	t.Run("NORMAL: 同じkeyには同じVariantを返す", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("visitor-%d", i)
+			if got, again := an.PickVariant(key), an.PickVariant(key); got != again {
+				t.Errorf("AnonyURL.PickVariant() = %v, again %v", got.ID, again.ID)
+			}
+		}
+	})
+
+	t.Run("NORMAL: 重みに比例して振り分ける", func(t *testing.T) {
+		const n = 20000
+		counts := map[string]int{}
+		for i := 0; i < n; i++ {
+			counts[an.PickVariant(fmt.Sprintf("visitor-%d", i)).ID]++
+		}
+		ratio := float64(counts["b"]) / n
+		if math.Abs(ratio-0.75) > 0.02 {
+			t.Errorf("AnonyURL.PickVariant() ratio of b = %v, want about 0.75", ratio)
+		}
+	})
+
+	t.Run("NORMAL: Variantがない場合はnilを返す", func(t *testing.T) {
+		if got := (AnonyURL{ID: "url-id"}).PickVariant("visitor"); got != nil {
+			t.Errorf("AnonyURL.PickVariant() = %v, want nil", got)
+		}
+	})
+}
+
+func TestAnonyURL_ValidateVariants(t *testing.T) {
+	tooMany := make([]*Variant, MaxVariants+1)
+	for i := range tooMany {
+		tooMany[i] = NewVariant(fmt.Sprint(i), "https://example.com", 1)
+	}
+	tests := []struct {
+		name         string
+		redirectMode int64
+		variants     []*Variant
+		wantErr      bool
+	}{
+		{
+			name:     "NORMAL: Variantがない場合",
+			variants: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "NORMAL: 正常な場合",
+			variants: []*Variant{NewVariant("a", "https://example.com/a", 50), NewVariant("b", "https://example.com/b", 50)},
+			wantErr:  false,
+		},
+		{
+			name:     "ERROR: 多すぎる場合",
+			variants: tooMany,
+			wantErr:  true,
+		},
+		{
+			name:         "ERROR: 恒久的なリダイレクトの場合",
+			redirectMode: RedirectModeMovedPermanently,
+			variants:     []*Variant{NewVariant("a", "https://example.com/a", 50)},
+			wantErr:      true,
+		},
+		{
+			name:     "ERROR: weightが0の場合",
+			variants: []*Variant{NewVariant("a", "https://example.com/a", 0)},
+			wantErr:  true,
+		},
+		{
+			name:     "ERROR: weightが大きすぎる場合",
+			variants: []*Variant{NewVariant("a", "https://example.com/a", MaxVariantWeight+1)},
+			wantErr:  true,
+		},
+		{
+			name:     "ERROR: destinationが不正な場合",
+			variants: []*Variant{NewVariant("a", "ftp://example.com/a", 1)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			an := AnonyURL{RedirectMode: tt.redirectMode, Variants: tt.variants}
+			if err := an.ValidateVariants(); (err != nil) != tt.wantErr {
+				t.Errorf("AnonyURL.ValidateVariants() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Webhookで通知するイベントの種類
+const (
+	WebhookEventAnonyURLCreated     = "anony_url.created"
+	WebhookEventAnonyURLDeactivated = "anony_url.deactivated"
+	WebhookEventAnonyURLExpired     = "anony_url.expired"
+	WebhookEventAnonyURLClicked     = "anony_url.clicked"
+)
+
+// WebhookEventTypes is all the event types in the order shown to users
+var WebhookEventTypes = []string{
+	WebhookEventAnonyURLCreated,
+	WebhookEventAnonyURLDeactivated,
+	WebhookEventAnonyURLExpired,
+	WebhookEventAnonyURLClicked,
+}
+
+// Webhookの送信の状態
+const (
+	WebhookDeliveryPending   int64 = 0
+	WebhookDeliveryDelivered int64 = 1
+	// WebhookDeliveryDead is a delivery given up after MaxWebhookAttempts
+	WebhookDeliveryDead int64 = 2
+)
+
+// 送信の再試行. 失敗するたびに間隔を倍にする
+const (
+	MaxWebhookAttempts     = 8
+	WebhookRetryBaseDelay  = 30 * time.Second
+	WebhookRetryMaxDelay   = 6 * time.Hour
+	MaxWebhooksPerUser     = 20
+	MaxWebhookSecretLength = 128
+	MaxWebhookErrorLength  = 1024
+)
+
+// Webhook is a subscription of the user to the events of the user's AnonyURLs
+type Webhook struct {
+	ID     string `json:"id" db:"id"`
+	UserID string `json:"user_id" db:"user_id"`
+	URL    string `json:"url" db:"url"`
+	// ペイロードのHMAC-SHA256の署名の鍵
+	Secret     string    `json:"-" db:"secret"`
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// NewWebhook creates a new Webhook
+func NewWebhook(id, userID, url, secret string, eventTypes []string) *Webhook {
+	return &Webhook{
+		ID:         id,
+		UserID:     userID,
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	}
+}
+
+// ValidateWebhook validates Webhook params
+func (w Webhook) ValidateWebhook() error {
+	if w.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if w.UserID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+	if err := validateDestination("url", w.URL); err != nil {
+		return err
+	}
+	if w.Secret == "" {
+		return fmt.Errorf("secret is required")
+	}
+	if len(w.Secret) > MaxWebhookSecretLength {
+		return fmt.Errorf("secret must be at most %d characters", MaxWebhookSecretLength)
+	}
+	if len(w.EventTypes) == 0 {
+		return fmt.Errorf("event_types are required")
+	}
+	seen := map[string]struct{}{}
+	for _, t := range w.EventTypes {
+		if !IsWebhookEventType(t) {
+			return fmt.Errorf("event type %q is unknown", t)
+		}
+		if _, ok := seen[t]; ok {
+			return fmt.Errorf("event type %q is duplicated", t)
+		}
+		seen[t] = struct{}{}
+	}
+	return nil
+}
+
+// Subscribes returns whether the webhook receives the event type
+func (w Webhook) Subscribes(eventType string) bool {
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWebhookEventType returns whether the event type is supported
+func IsWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvent is the JSON payload sent to webhooks
+// IDは同じイベントの全ての送信で共通. 受信側で重複を除くのに使う
+type WebhookEvent struct {
+	ID        string               `json:"id"`
+	Type      string               `json:"type"`
+	CreatedAt time.Time            `json:"created_at"`
+	AnonyURL  WebhookEventAnonyURL `json:"anony_url"`
+	// anony_url.clickedでA/Bテストの振り分け先が使われた場合のみ
+	VariantID string `json:"variant_id,omitempty"`
+}
+
+// WebhookEventAnonyURL is the AnonyURL in WebhookEvent
+type WebhookEventAnonyURL struct {
+	ID       string `json:"id"`
+	Original string `json:"original_url"`
+	Short    string `json:"short"`
+	DomainID string `json:"domain_id,omitempty"`
+	UTM      UTM    `json:"utm"`
+	IsActive bool   `json:"is_active"`
+	Title    string `json:"title,omitempty"`
+	Clicks   int64  `json:"clicks"`
+}
+
+// NewWebhookEvent creates a new WebhookEvent of the AnonyURL. IDはoutboxに書き込む時に付ける
+func NewWebhookEvent(eventType string, an *AnonyURL, at time.Time) *WebhookEvent {
+	return &WebhookEvent{
+		Type:      eventType,
+		CreatedAt: at,
+		AnonyURL: WebhookEventAnonyURL{
+			ID:       an.ID,
+			Original: an.Original,
+			Short:    an.Short,
+			DomainID: an.DomainID,
+			UTM:      an.UTM,
+			IsActive: an.Status == 1,
+			Title:    an.DisplayTitle(),
+			Clicks:   an.Clicks,
+		},
+	}
+}
+
+// WebhookDelivery is a delivery of an event to a webhook in the outbox
+type WebhookDelivery struct {
+	ID        string `json:"id" db:"id"`
+	WebhookID string `json:"webhook_id" db:"webhook_id"`
+	EventID   string `json:"event_id" db:"event_id"`
+	EventType string `json:"event_type" db:"event_type"`
+	// 送信するJSON. 送信時ではなくイベントの発生時の内容を送る
+	Payload       []byte     `json:"payload" db:"payload"`
+	Status        int64      `json:"status" db:"status"`
+	Attempts      int64      `json:"attempts" db:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at" db:"next_attempt_at"`
+	LastError     string     `json:"last_error" db:"last_error"`
+	DeliveredAt   *time.Time `json:"delivered_at" db:"delivered_at"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+}
+
+// Fail records the failed attempt at now
+// MaxWebhookAttemptsに達した場合はWebhookDeliveryDeadにする
+func (d *WebhookDelivery) Fail(reason string, now time.Time) {
+	d.Attempts++
+	d.LastError = truncateRunes(reason, MaxWebhookErrorLength)
+	if d.Attempts >= MaxWebhookAttempts {
+		d.Status = WebhookDeliveryDead
+		return
+	}
+	d.Status = WebhookDeliveryPending
+	d.NextAttemptAt = now.Add(WebhookRetryDelay(d.Attempts))
+}
+
+// Succeed records the successful attempt at now
+func (d *WebhookDelivery) Succeed(now time.Time) {
+	d.Attempts++
+	d.LastError = ""
+	d.Status = WebhookDeliveryDelivered
+	d.DeliveredAt = &now
+}
+
+// WebhookRetryDelay returns the delay before the next attempt after the failed attempts
+func WebhookRetryDelay(attempts int64) time.Duration {
+	d := WebhookRetryBaseDelay
+	for i := int64(1); i < attempts; i++ {
+		d *= 2
+		if d >= WebhookRetryMaxDelay {
+			return WebhookRetryMaxDelay
+		}
+	}
+	return d
+}
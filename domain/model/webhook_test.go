@@ -0,0 +1,71 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhook_ValidateWebhook(t *testing.T) {
+	valid := Webhook{ID: "id", UserID: "user-id", URL: "https://hooks.example.com/anony", Secret: "secret", EventTypes: []string{WebhookEventAnonyURLCreated}}
+	tests := []struct {
+		name    string
+		modify  func(w *Webhook)
+		wantErr bool
+	}{
+		{name: "NORMAL: 正常な場合は, nilを返す", modify: func(w *Webhook) {}, wantErr: false},
+		{name: "NORMAL: 全てのイベント", modify: func(w *Webhook) { w.EventTypes = WebhookEventTypes }, wantErr: false},
+		{name: "ERROR: IDが空", modify: func(w *Webhook) { w.ID = "" }, wantErr: true},
+		{name: "ERROR: URLが不正", modify: func(w *Webhook) { w.URL = "ftp://hooks.example.com/" }, wantErr: true},
+		{name: "ERROR: Secretが空", modify: func(w *Webhook) { w.Secret = "" }, wantErr: true},
+		{name: "ERROR: Secretが長すぎる", modify: func(w *Webhook) { w.Secret = strings.Repeat("s", MaxWebhookSecretLength+1) }, wantErr: true},
+		{name: "ERROR: イベントが空", modify: func(w *Webhook) { w.EventTypes = nil }, wantErr: true},
+		{name: "ERROR: 不明なイベント", modify: func(w *Webhook) { w.EventTypes = []string{"anony_url.deleted"} }, wantErr: true},
+		{
+			name:    "ERROR: イベントが重複",
+			modify:  func(w *Webhook) { w.EventTypes = []string{WebhookEventAnonyURLClicked, WebhookEventAnonyURLClicked} },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := valid
+			tt.modify(&w)
+			if err := w.ValidateWebhook(); (err != nil) != tt.wantErr {
+				t.Errorf("Webhook.ValidateWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int64
+		want     time.Duration
+	}{
+		{attempts: 1, want: 30 * time.Second},
+		{attempts: 2, want: time.Minute},
+		{attempts: 4, want: 4 * time.Minute},
+		{attempts: 20, want: WebhookRetryMaxDelay},
+	}
+	for _, tt := range tests {
+		if got := WebhookRetryDelay(tt.attempts); got != tt.want {
+			t.Errorf("WebhookRetryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestWebhookDelivery_Fail(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &WebhookDelivery{}
+	d.Fail("status 500", now)
+	if d.Status != WebhookDeliveryPending || d.Attempts != 1 || !d.NextAttemptAt.Equal(now.Add(30*time.Second)) {
+		t.Errorf("WebhookDelivery.Fail() = %+v", d)
+	}
+	for d.Status == WebhookDeliveryPending {
+		d.Fail("status 500", now)
+	}
+	if d.Status != WebhookDeliveryDead || d.Attempts != MaxWebhookAttempts || d.LastError != "status 500" {
+		t.Errorf("WebhookDelivery.Fail() = %+v, want dead after %d attempts", d, MaxWebhookAttempts)
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Tatsuemon/anony/domain/model"
 )
@@ -12,9 +13,30 @@ type AnonyURLRepository interface {
 	FindByID(id string) (*model.AnonyURL, error)
 	FindByUserID(userID string) ([]*model.AnonyURL, error)
 	FindByUserIDWithStatus(userID string, status int64) ([]*model.AnonyURL, error)
-	FindByOriginalInUser(original string, userID string) (*model.AnonyURL, error)
-	FindByAnonyURL(anonyURL string) (*model.AnonyURL, error)
-	GetIDByOriginalUser(original, userID string) (string, error)
+	FindByOriginalInUser(original string, utm model.UTM, userID string) (*model.AnonyURL, error)
+	FindByAnonyURL(domainID, anonyURL string) (*model.AnonyURL, error)
+	GetIDByOriginalUser(original string, utm model.UTM, userID string) (string, error)
+	// GetUserIDByID returns the owner of the AnonyURL, or "" if it does not exist
+	GetUserIDByID(id string) (string, error)
 	Save(ctx context.Context, an *model.AnonyURL, userID string) error
 	UpdateStatus(ctx context.Context, id string, status int64) error
+	IncrementClicks(ctx context.Context, id string) error
+	UpdateSchedule(ctx context.Context, id string, activeFrom, activeUntil *time.Time, fallback string) error
+	// FindByScheduleBoundary finds active AnonyURLs whose active_from or active_until is in (from, to]
+	FindByScheduleBoundary(from, to time.Time) ([]*model.AnonyURL, error)
+	// FindPage finds AnonyURLs whose id is greater than afterID in order of id
+	FindPage(afterID string, limit int) ([]*model.AnonyURL, error)
+	// UpdateBlockedReason flags the AnonyURL as blocked, or clears the flag if the reason is empty
+	UpdateBlockedReason(ctx context.Context, id string, reason string) error
+	// UpdateHealth records the last health check of the AnonyURL
+	UpdateHealth(ctx context.Context, id string, h *model.LinkHealth) error
+	// FindBrokenByUserID finds the user's AnonyURLs whose last health check is broken
+	FindBrokenByUserID(userID string) ([]*model.AnonyURL, error)
+	// UpdatePreview records the metadata fetched from the destination page
+	UpdatePreview(ctx context.Context, id string, p *model.LinkPreview) error
+	// UpdateAnnotation replaces the title, the notes and the metadata given by the owner
+	UpdateAnnotation(ctx context.Context, id string, title, notes string, metadata map[string]string) error
+	// FindUpdatedWithin finds AnonyURLs whose updated_at is within d before the current time of the database
+	// クリック数, 死活確認とプレビューの記録ではupdated_atを更新しない
+	FindUpdatedWithin(d time.Duration) ([]*model.AnonyURL, error)
 }
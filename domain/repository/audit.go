@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// AuditEventRepository is a interface of the append-only audit log.
+type AuditEventRepository interface {
+	// Save appends the event. 変更と同じトランザクションで呼ぶ
+	Save(ctx context.Context, e *model.AuditEvent) error
+	// Find finds the events matching the filter in order of created_at desc
+	Find(f model.AuditEventFilter) ([]*model.AuditEvent, error)
+}
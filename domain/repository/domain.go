@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// DomainRepository is a interface of DomainRepository.
+type DomainRepository interface {
+	FindByID(id string) (*model.Domain, error)
+	FindByName(name string) (*model.Domain, error)
+	FindByUserID(userID string) ([]*model.Domain, error)
+	// FindVerified finds all the verified domains
+	FindVerified() ([]*model.Domain, error)
+	Save(ctx context.Context, d *model.Domain) error
+	UpdateVerified(ctx context.Context, id string, verified bool) error
+}
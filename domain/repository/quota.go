@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// QuotaRepository is a interface of the user's quota and its usage.
+type QuotaRepository interface {
+	// FindByUserID finds the quota set for the user, or nil if the user uses the default
+	FindByUserID(userID string) (*model.UserQuota, error)
+	// LockUser locks the user until the transaction ends to count the usage without races
+	LockUser(ctx context.Context, userID string) error
+	// CountUsage counts the user's AnonyURLs, in the transaction if ctx has it
+	CountUsage(ctx context.Context, userID string) (*model.QuotaUsage, error)
+}
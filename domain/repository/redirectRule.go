@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// RedirectRuleRepository is a interface of RedirectRuleRepository.
+type RedirectRuleRepository interface {
+	FindByAnonyURLID(anonyURLID string) ([]*model.RedirectRule, error)
+	// ReplaceByAnonyURLID deletes all rules of the AnonyURL and saves the rules
+	ReplaceByAnonyURLID(ctx context.Context, anonyURLID string, rules []*model.RedirectRule) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// TagRepository is a interface of TagRepository.
+type TagRepository interface {
+	FindByID(id string) (*model.Tag, error)
+	FindByUserID(userID string) (model.Tags, error)
+	Save(ctx context.Context, t *model.Tag) error
+	UpdateName(ctx context.Context, id string, name string) error
+	// Delete deletes the tag, its descendants and their links to AnonyURLs
+	Delete(ctx context.Context, id string) error
+	// AddAnonyURLs tags each AnonyURL with each tag. 既に付いている組は無視する
+	AddAnonyURLs(ctx context.Context, tagIDs []string, anonyURLIDs []string) error
+	// RemoveAnonyURLs untags each AnonyURL from each tag
+	RemoveAnonyURLs(ctx context.Context, tagIDs []string, anonyURLIDs []string) error
+}
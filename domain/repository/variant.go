@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// VariantRepository is a interface of VariantRepository.
+type VariantRepository interface {
+	FindByAnonyURLID(anonyURLID string) ([]*model.Variant, error)
+	// ReplaceByAnonyURLID deletes all variants of the AnonyURL and saves the variants
+	ReplaceByAnonyURLID(ctx context.Context, anonyURLID string, variants []*model.Variant) error
+	IncrementClicks(ctx context.Context, id string) error
+}
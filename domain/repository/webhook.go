@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// WebhookRepository is a interface of WebhookRepository.
+type WebhookRepository interface {
+	FindByID(id string) (*model.Webhook, error)
+	FindByUserID(userID string) ([]*model.Webhook, error)
+	Save(ctx context.Context, w *model.Webhook) error
+	// Delete deletes the webhook and its deliveries
+	Delete(ctx context.Context, id string) error
+}
+
+// WebhookDeliveryRepository is a interface of the outbox of webhooks.
+type WebhookDeliveryRepository interface {
+	FindByID(id string) (*model.WebhookDelivery, error)
+	// SaveAll writes the deliveries to the outbox. リンクの変更と同じトランザクションで呼ぶ
+	// 削除されたWebhookへの送信は書き込まない
+	SaveAll(ctx context.Context, ds []*model.WebhookDelivery) error
+	// ClaimDueWebhooks claims at most limit webhooks whose first pending delivery is due at now until the time
+	// 他のプロセスが確保したWebhookは飛ばす. 確保と書き込みが割り込まれないよう, トランザクションの中で呼ぶ
+	ClaimDueWebhooks(ctx context.Context, now, until time.Time, limit int) ([]string, error)
+	// ReleaseWebhook releases the claim of the webhook sent at now
+	ReleaseWebhook(ctx context.Context, webhookID string, now time.Time) error
+	// FindPendingByWebhookID finds at most limit pending deliveries of the webhook in the order written
+	FindPendingByWebhookID(webhookID string, limit int) ([]*model.WebhookDelivery, error)
+	// FindDeadByWebhookID finds the deliveries given up in order of created_at desc
+	FindDeadByWebhookID(webhookID string) ([]*model.WebhookDelivery, error)
+	// UpdateAttempt records the status, the attempts, the next attempt and the error of the delivery
+	UpdateAttempt(ctx context.Context, d *model.WebhookDelivery) error
+}
@@ -1,13 +1,18 @@
 package service
 
 import (
+	"net/url"
+	"strings"
+
+	"github.com/Tatsuemon/anony/domain/model"
 	"github.com/Tatsuemon/anony/domain/repository"
 )
 
 // AnonyURLService is a service.
 type AnonyURLService interface {
 	ExistID(id string) (bool, error)
-	ExistOriginalInUser(original, userID string) (bool, error)
+	ExistOriginalInUser(original string, utm model.UTM, userID string) (bool, error)
+	ExistAnonyURL(domainID, anonyURL string) (bool, error)
 }
 
 type anonyURLService struct {
@@ -27,10 +32,39 @@ func (a *anonyURLService) ExistID(id string) (bool, error) {
 	return an != nil, nil
 }
 
-func (a *anonyURLService) ExistOriginalInUser(original, userID string) (bool, error) {
-	an, err := a.repo.FindByOriginalInUser(original, userID)
+func (a *anonyURLService) ExistOriginalInUser(original string, utm model.UTM, userID string) (bool, error) {
+	an, err := a.repo.FindByOriginalInUser(original, utm, userID)
+	if err != nil {
+		return false, err
+	}
+	return an != nil, nil
+}
+
+func (a *anonyURLService) ExistAnonyURL(domainID, anonyURL string) (bool, error) {
+	an, err := a.repo.FindByAnonyURL(domainID, anonyURL)
 	if err != nil {
 		return false, err
 	}
 	return an != nil, nil
 }
+
+// FindAnonyURLByPath finds the AnonyURL by the code at the head of the escaped path of a short URL
+// 戻り値の2つ目はコード以降のパス. リダイレクトサーバーとリダイレクトチェーンの解決で同じ方法で探す
+// 移行前の"userprefix/code"形式のコードのため, 見つからない場合は2セグメントまで試す
+func FindAnonyURLByPath(escapedPath string, find func(code string) (*model.AnonyURL, error)) (*model.AnonyURL, string, error) {
+	segments := strings.SplitN(strings.TrimPrefix(escapedPath, "/"), "/", 3)
+	for n := 1; n <= 2 && n <= len(segments); n++ {
+		code, err := url.PathUnescape(strings.Join(segments[:n], "/"))
+		if err != nil || code == "" {
+			return nil, "", nil
+		}
+		an, err := find(code)
+		if err != nil {
+			return nil, "", err
+		}
+		if an != nil {
+			return an, strings.Join(segments[n:], "/"), nil
+		}
+	}
+	return nil, "", nil
+}
@@ -109,7 +109,7 @@ func Test_anonyURLService_ExistID(t *testing.T) {
 
 func Test_anonyURLService_ExistOriginalInUser(t *testing.T) {
 	type mocks struct {
-		FakeFindByOriginalInUser func(original string, userID string) (*model.AnonyURL, error)
+		FakeFindByOriginalInUser func(original string, utm model.UTM, userID string) (*model.AnonyURL, error)
 	}
 	type args struct {
 		original string
@@ -129,7 +129,7 @@ func Test_anonyURLService_ExistOriginalInUser(t *testing.T) {
 				userID:   "user-id",
 			},
 			mocks: mocks{
-				FakeFindByOriginalInUser: func(original string, userID string) (*model.AnonyURL, error) {
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
 					return nil, nil
 				},
 			},
@@ -143,7 +143,7 @@ func Test_anonyURLService_ExistOriginalInUser(t *testing.T) {
 				userID:   "user-id",
 			},
 			mocks: mocks{
-				FakeFindByOriginalInUser: func(original string, userID string) (*model.AnonyURL, error) {
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
 					return &model.AnonyURL{
 						ID:       "id",
 						Original: "original",
@@ -162,7 +162,7 @@ func Test_anonyURLService_ExistOriginalInUser(t *testing.T) {
 				userID:   "user-id",
 			},
 			mocks: mocks{
-				FakeFindByOriginalInUser: func(original string, userID string) (*model.AnonyURL, error) {
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
 					return nil, fmt.Errorf("error")
 				},
 			},
@@ -177,7 +177,7 @@ func Test_anonyURLService_ExistOriginalInUser(t *testing.T) {
 					FakeFindByOriginalInUser: tt.mocks.FakeFindByOriginalInUser,
 				},
 			}
-			got, err := a.ExistOriginalInUser(tt.args.original, tt.args.userID)
+			got, err := a.ExistOriginalInUser(tt.args.original, model.UTM{}, tt.args.userID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("anonyURLService.ExistOriginalInUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -188,3 +188,85 @@ func Test_anonyURLService_ExistOriginalInUser(t *testing.T) {
 		})
 	}
 }
+
+func Test_anonyURLService_ExistAnonyURL(t *testing.T) {
+	type mocks struct {
+		FakeFindByAnonyURL func(domainID, anonyURL string) (*model.AnonyURL, error)
+	}
+	type args struct {
+		domainID string
+		anonyURL string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		mocks   mocks
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "NORMAL: 重複するものが存在しない場合",
+			args: args{
+				domainID: "",
+				anonyURL: "short",
+			},
+			mocks: mocks{
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+					return nil, nil
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: 重複するものが存在する場合",
+			args: args{
+				domainID: "",
+				anonyURL: "short",
+			},
+			mocks: mocks{
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+					return &model.AnonyURL{
+						ID:       "id",
+						Original: "original",
+						Short:    "short",
+						Status:   1,
+					}, nil
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "ERROR: anonyURLRepo.FindByAnonyURLがERRORを返す時",
+			args: args{
+				domainID: "",
+				anonyURL: "short",
+			},
+			mocks: mocks{
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+					return nil, fmt.Errorf("error")
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &anonyURLService{
+				repo: testutils.AnonyURLRepoMock{
+					FakeFindByAnonyURL: tt.mocks.FakeFindByAnonyURL,
+				},
+			}
+			got, err := a.ExistAnonyURL(tt.args.domainID, tt.args.anonyURL)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("anonyURLService.ExistAnonyURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("anonyURLService.ExistAnonyURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/google/uuid"
+)
+
+// AuditService is a service of the audit log.
+type AuditService interface {
+	// Record appends the operation of the actor to the audit log
+	// 変更と同じトランザクションのctxで呼ぶ. リクエストIDとIPアドレスはctxから取り出す
+	Record(ctx context.Context, actorID, action, targetType, targetID string, before, after interface{}) error
+}
+
+type auditService struct {
+	repo repository.AuditEventRepository
+}
+
+// NewAuditService create a new service.
+func NewAuditService(r repository.AuditEventRepository) AuditService {
+	return &auditService{r}
+}
+
+func (s *auditService) Record(ctx context.Context, actorID, action, targetType, targetID string, before, after interface{}) error {
+	e, err := model.NewAuditEvent(uuid.New().String(), actorID, action, targetType, targetID, before, after, model.GetRequestInfoInContext(ctx), time.Now())
+	if err != nil {
+		return err
+	}
+	return s.repo.Save(ctx, e)
+}
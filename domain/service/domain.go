@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// TXTResolver looks up DNS TXT records. *net.Resolver implements it.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// DomainService is a service of Domain.
+type DomainService interface {
+	ExistsName(name string) (bool, error)
+	VerifyOwnership(ctx context.Context, d *model.Domain) (bool, error)
+}
+
+type domainService struct {
+	repo     repository.DomainRepository
+	resolver TXTResolver
+}
+
+// NewDomainService create a new service of domain.
+func NewDomainService(r repository.DomainRepository, res TXTResolver) DomainService {
+	return &domainService{r, res}
+}
+
+func (d *domainService) ExistsName(name string) (bool, error) {
+	domain, err := d.repo.FindByName(name)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to domainService.ExistsName")
+	}
+	return domain != nil, nil
+}
+
+// VerifyOwnership checks the TXT record of the domain has the verification value
+func (d *domainService) VerifyOwnership(ctx context.Context, domain *model.Domain) (bool, error) {
+	records, err := d.resolver.LookupTXT(ctx, domain.VerificationRecordName())
+	if err != nil {
+		// レコードが存在しない場合はエラーではなく, 未確認として扱う
+		if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to domainService.VerifyOwnership")
+	}
+	for _, v := range records {
+		if v == domain.VerificationRecordValue() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
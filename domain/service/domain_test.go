@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestNewDomainService(t *testing.T) {
+	tests := []struct {
+		name string
+		want DomainService
+	}{
+		{
+			name: "NORMAL: NewDomainService",
+			want: &domainService{testutils.DomainRepoMock{}, testutils.TXTResolverMock{}},
+		},
+	}
+	for _, tt := range tests {
+		repo := testutils.DomainRepoMock{}
+		resolver := testutils.TXTResolverMock{}
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDomainService(repo, resolver); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDomainService() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_domainService_ExistsName(t *testing.T) {
+	type mocks struct {
+		FakeFindByName func(name string) (*model.Domain, error)
+	}
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		mocks   mocks
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "NORMAL: 重複するものが存在しない場合",
+			args: args{
+				name: "go.example.com",
+			},
+			mocks: mocks{
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return nil, nil
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: 重複するものが存在する場合",
+			args: args{
+				name: "go.example.com",
+			},
+			mocks: mocks{
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return &model.Domain{ID: "id", Name: "go.example.com"}, nil
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "ERROR: domainRepo.FindByNameがErrorを返す",
+			args: args{
+				name: "go.example.com",
+			},
+			mocks: mocks{
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return nil, fmt.Errorf("error")
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &domainService{
+				repo: testutils.DomainRepoMock{
+					FakeFindByName: tt.mocks.FakeFindByName,
+				},
+			}
+			got, err := d.ExistsName(tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("domainService.ExistsName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("domainService.ExistsName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_domainService_VerifyOwnership(t *testing.T) {
+	type mocks struct {
+		FakeLookupTXT func(ctx context.Context, name string) ([]string, error)
+	}
+	domain := &model.Domain{ID: "id", Name: "go.example.com", UserID: "user-id", VerifyToken: "token"}
+	tests := []struct {
+		name    string
+		mocks   mocks
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "NORMAL: TXTレコードに確認用の値がある場合",
+			mocks: mocks{
+				FakeLookupTXT: func(ctx context.Context, name string) ([]string, error) {
+					if name != "_anony-verification.go.example.com" {
+						return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+					}
+					return []string{"other", "anony-verification=token"}, nil
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: TXTレコードの値が一致しない場合",
+			mocks: mocks{
+				FakeLookupTXT: func(ctx context.Context, name string) ([]string, error) {
+					return []string{"anony-verification=other-token"}, nil
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: TXTレコードが存在しない場合",
+			mocks: mocks{
+				FakeLookupTXT: func(ctx context.Context, name string) ([]string, error) {
+					return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "ERROR: DNSの問い合わせに失敗した場合",
+			mocks: mocks{
+				FakeLookupTXT: func(ctx context.Context, name string) ([]string, error) {
+					return nil, &net.DNSError{Err: "server misbehaving", Name: name, IsTemporary: true}
+				},
+			},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &domainService{
+				resolver: testutils.TXTResolverMock{
+					FakeLookupTXT: tt.mocks.FakeLookupTXT,
+				},
+			}
+			got, err := d.VerifyOwnership(context.Background(), domain)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("domainService.VerifyOwnership() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("domainService.VerifyOwnership() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
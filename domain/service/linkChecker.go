@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// LinkChecker checks whether destinations of AnonyURLs are reachable
+// HTTPでの実装はinfrastructure/linkcheckerにある
+type LinkChecker interface {
+	// Check requests the destination and returns the result
+	// 接続できなかった場合はStatusCodeが0の結果を返し, errorはctxが終了した場合のみ返す
+	Check(ctx context.Context, destination string) (*model.LinkHealth, error)
+}
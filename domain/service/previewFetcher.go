@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// PreviewFetcher fetches the metadata of destination pages for link previews
+// HTTPでの実装はinfrastructure/previewにある
+type PreviewFetcher interface {
+	// Fetch returns the title, description, image and favicon of the destination page
+	Fetch(ctx context.Context, destination string) (*model.LinkPreview, error)
+}
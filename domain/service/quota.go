@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+)
+
+// QuotaExceededError is returned when creating or activating AnonyURLs exceeds the user's quota
+type QuotaExceededError struct {
+	Kind string
+	Max  int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota of %s is exceeded: the limit is %d", e.Kind, e.Max)
+}
+
+// QuotaService is a service of the user's quota.
+type QuotaService interface {
+	// Check returns *QuotaExceededError if creating and activating the AnonyURLs exceeds the quota
+	// 保存と同じトランザクションのctxで呼ぶ. トランザクションが終わるまでユーザーをロックする
+	Check(ctx context.Context, userID string, created, activated int64) error
+	// Get returns the quota of the user and its current usage
+	Get(ctx context.Context, userID string) (*model.Quota, *model.QuotaUsage, error)
+}
+
+type quotaService struct {
+	repo     repository.QuotaRepository
+	defaults model.Quota
+}
+
+// NewQuotaService create a new service, defaults is the quota of users without their own quota
+func NewQuotaService(r repository.QuotaRepository, defaults model.Quota) QuotaService {
+	return &quotaService{r, defaults}
+}
+
+func (s *quotaService) Check(ctx context.Context, userID string, created, activated int64) error {
+	if created <= 0 && activated <= 0 {
+		return nil
+	}
+	q, err := s.quota(userID)
+	if err != nil {
+		return err
+	}
+	if q.Unlimited() {
+		return nil
+	}
+	if err := s.repo.LockUser(ctx, userID); err != nil {
+		return err
+	}
+	u, err := s.repo.CountUsage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if kind := q.Exceeded(*u, created, activated); kind != "" {
+		return &QuotaExceededError{Kind: kind, Max: q.Max(kind)}
+	}
+	return nil
+}
+
+func (s *quotaService) Get(ctx context.Context, userID string) (*model.Quota, *model.QuotaUsage, error) {
+	q, err := s.quota(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	u, err := s.repo.CountUsage(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &q, u, nil
+}
+
+func (s *quotaService) quota(userID string) (model.Quota, error) {
+	uq, err := s.repo.FindByUserID(userID)
+	if err != nil {
+		return model.Quota{}, err
+	}
+	return s.defaults.Override(uq), nil
+}
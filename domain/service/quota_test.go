@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestQuotaService_Check(t *testing.T) {
+	one := int64(1)
+	defaults := model.Quota{MaxAnonyURLs: 10, MaxActiveAnonyURLs: 5, MaxCreationsPerDay: 3}
+	tests := []struct {
+		name      string
+		userQuota *model.UserQuota
+		usage     model.QuotaUsage
+		created   int64
+		activated int64
+		wantKind  string
+		wantLock  bool
+	}{
+		{name: "NORMAL: 上限に達していない", usage: model.QuotaUsage{AnonyURLs: 9, ActiveAnonyURLs: 4, CreationsLastDay: 2}, created: 1, activated: 1, wantLock: true},
+		{name: "NORMAL: 作成も有効化もしない場合は数えない", usage: model.QuotaUsage{AnonyURLs: 10}},
+		{name: "NORMAL: 無効で作成する場合は有効なリンクの上限を見ない", usage: model.QuotaUsage{ActiveAnonyURLs: 5}, created: 1, wantLock: true},
+		{name: "NORMAL: ユーザーごとの上限0は無制限", userQuota: &model.UserQuota{MaxAnonyURLs: new(int64), MaxActiveAnonyURLs: new(int64), MaxCreationsPerDay: new(int64)}, usage: model.QuotaUsage{AnonyURLs: 100}, created: 1},
+		{name: "ERROR: リンクの数の上限", usage: model.QuotaUsage{AnonyURLs: 10}, created: 1, activated: 1, wantKind: model.QuotaAnonyURLs, wantLock: true},
+		{name: "ERROR: 有効なリンクの数の上限", usage: model.QuotaUsage{ActiveAnonyURLs: 5}, activated: 1, wantKind: model.QuotaActiveAnonyURLs, wantLock: true},
+		{name: "ERROR: 1日に作成できる数の上限", usage: model.QuotaUsage{CreationsLastDay: 3}, created: 1, wantKind: model.QuotaCreationsPerDay, wantLock: true},
+		{name: "ERROR: ユーザーごとの上限", userQuota: &model.UserQuota{MaxAnonyURLs: &one}, usage: model.QuotaUsage{AnonyURLs: 1}, created: 1, wantKind: model.QuotaAnonyURLs, wantLock: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locked := false
+			s := NewQuotaService(testutils.QuotaRepoMock{
+				FakeFindByUserID: func(userID string) (*model.UserQuota, error) { return tt.userQuota, nil },
+				FakeLockUser: func(ctx context.Context, userID string) error {
+					locked = true
+					return nil
+				},
+				FakeCountUsage: func(ctx context.Context, userID string) (*model.QuotaUsage, error) {
+					if !locked {
+						t.Errorf("QuotaService.Check() counted the usage without locking the user")
+					}
+					return &tt.usage, nil
+				},
+			}, defaults)
+			err := s.Check(context.Background(), "user", tt.created, tt.activated)
+			var exceeded *QuotaExceededError
+			if errors.As(err, &exceeded) {
+				if exceeded.Kind != tt.wantKind {
+					t.Errorf("QuotaService.Check() kind = %v, want %v", exceeded.Kind, tt.wantKind)
+				}
+			} else if err != nil || tt.wantKind != "" {
+				t.Errorf("QuotaService.Check() error = %v, want kind %v", err, tt.wantKind)
+			}
+			if locked != tt.wantLock {
+				t.Errorf("QuotaService.Check() locked = %v, want %v", locked, tt.wantLock)
+			}
+		})
+	}
+}
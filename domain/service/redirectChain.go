@@ -0,0 +1,152 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+)
+
+// MaxRedirectChain is the max number of short URLs followed to resolve a destination
+const MaxRedirectChain = 5
+
+var (
+	// ErrRedirectLoop is returned when short URLs redirect to each other
+	ErrRedirectLoop = errors.New("redirect loop is detected")
+	// ErrRedirectChainTooLong is returned when more than MaxRedirectChain short URLs are chained
+	ErrRedirectChainTooLong = fmt.Errorf("redirect chain is longer than %d", MaxRedirectChain)
+	// ErrSelfReference is returned when the destination is on the serving hosts but not a short URL
+	ErrSelfReference = errors.New("destination points at the serving host but is not a short URL")
+)
+
+// RedirectChainService resolves destinations pointing at short URLs served by anony
+type RedirectChainService interface {
+	// Resolve follows short URLs in the destination and returns the final destination
+	// selfが指定された場合は, selfへ戻るリダイレクトもループとして扱う
+	// 無効やブロックされた短縮URLは辿らずに, その短縮URLを返す. A/Bテストとルールは辿らない
+	Resolve(destination string, self *model.AnonyURL) (string, error)
+}
+
+// VerifiedDomainFinder finds the verified domains serving short URLs
+// リダイレクトのたびに呼ばれるので, データベースを引かずに答える
+type VerifiedDomainFinder interface {
+	// FindVerifiedByName returns the verified domain of the name, or nil if it is not a verified domain
+	FindVerifiedByName(name string) (*model.Domain, error)
+}
+
+type redirectChainService struct {
+	repo    repository.AnonyURLRepository
+	domains VerifiedDomainFinder
+	hosts   map[string]struct{}
+}
+
+// NewRedirectChainService creates a service of redirect chains through short URLs served on the hosts and verified domains
+func NewRedirectChainService(r repository.AnonyURLRepository, vd VerifiedDomainFinder, hosts []string) RedirectChainService {
+	s := &redirectChainService{r, vd, map[string]struct{}{}}
+	for _, v := range hosts {
+		parsed, err := url.Parse(v)
+		if err != nil || parsed.Host == "" {
+			continue
+		}
+		s.hosts[strings.ToLower(parsed.Host)] = struct{}{}
+	}
+	return s
+}
+
+func (s *redirectChainService) Resolve(destination string, self *model.AnonyURL) (string, error) {
+	visited := map[string]struct{}{}
+	if self != nil {
+		visited[chainKey(self.DomainID, self.Short)] = struct{}{}
+	}
+	for hops := 0; ; hops++ {
+		u, err := url.Parse(destination)
+		if err != nil {
+			// URLの検証はmodelで行うので, 解釈できない場合は辿らない
+			return destination, nil
+		}
+		domainID, ok, err := s.servingDomainID(u.Host)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return destination, nil
+		}
+		if hops >= MaxRedirectChain {
+			return "", ErrRedirectChainTooLong
+		}
+
+		an, extraPath, err := FindAnonyURLByPath(u.EscapedPath(), func(code string) (*model.AnonyURL, error) {
+			// 作成中のselfはまだ保存されていないため, コードが一致する場合はselfとする
+			if self != nil && self.DomainID == domainID && self.Short == code {
+				return self, nil
+			}
+			return s.repo.FindByAnonyURL(domainID, code)
+		})
+		if err != nil {
+			return "", err
+		}
+		if an == nil {
+			return "", ErrSelfReference
+		}
+		key := chainKey(an.DomainID, an.Short)
+		if _, ok := visited[key]; ok {
+			return "", ErrRedirectLoop
+		}
+		visited[key] = struct{}{}
+
+		// 無効やブロックされた短縮URLはリダイレクトサーバーが404を返すので, そこで止める
+		// selfは保存前なので状態によらず辿る
+		next := an
+		if an != self {
+			if !an.IsRedirectable() {
+				return destination, nil
+			}
+			// 有効期間外の場合はリダイレクトサーバーと同じくFallbackへ進む
+			if !an.InSchedule(time.Now()) {
+				if an.Fallback == "" {
+					return destination, nil
+				}
+				fallback := *an
+				fallback.Original = an.Fallback
+				next = &fallback
+			}
+		}
+
+		// リダイレクトサーバーと同じ方法で次のリダイレクト先を組み立てる
+		destination, err = next.Destination(extraPath, u.Query())
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// servingDomainID returns the domain id if the host serves short URLs
+// デフォルトのホストの場合は空文字を返す
+func (s *redirectChainService) servingDomainID(host string) (string, bool, error) {
+	host = strings.ToLower(host)
+	if _, ok := s.hosts[host]; ok {
+		return "", true, nil
+	}
+	name := host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		name = hostname
+	}
+	// 確認済みでないドメインではリダイレクトしないので, 外部のURLとして扱う
+	d, err := s.domains.FindVerifiedByName(name)
+	if err != nil {
+		return "", false, err
+	}
+	if d == nil {
+		return "", false, nil
+	}
+	return d.ID, true, nil
+}
+
+func chainKey(domainID, short string) string {
+	return domainID + "\x00" + short
+}
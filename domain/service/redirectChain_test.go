@@ -0,0 +1,104 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestRedirectChainService_Resolve(t *testing.T) {
+	expired := time.Now().Add(-time.Hour)
+	// デフォルトのホストと確認済みのドメインで配信される短縮URL
+	anonyURLs := map[string]*model.AnonyURL{
+		"\x00external":   {ID: "1", Status: 1, Short: "external", Original: "https://example.com/"},
+		"\x00chain1":     {ID: "2", Status: 1, Short: "chain1", Original: "https://anony.example/chain2"},
+		"\x00chain2":     {ID: "3", Status: 1, Short: "chain2", Original: "https://go.brand.example/branded"},
+		"d1\x00branded":  {ID: "4", Status: 1, Short: "branded", DomainID: "d1", Original: "https://example.com/final"},
+		"\x00loop1":      {ID: "5", Status: 1, Short: "loop1", Original: "https://anony.example/loop2"},
+		"\x00loop2":      {ID: "6", Status: 1, Short: "loop2", Original: "https://anony.example/loop1"},
+		"\x00forward":    {ID: "7", Status: 1, Short: "forward", Original: "https://example.com/docs", ForwardPath: true, QueryMode: model.QueryModeAppend},
+		"\x00user/code":  {ID: "8", Status: 1, Short: "user/code", Original: "https://example.com/legacy"},
+		"\x00long1":      {ID: "9", Status: 1, Short: "long1", Original: "https://anony.example/long2"},
+		"\x00long2":      {ID: "10", Status: 1, Short: "long2", Original: "https://anony.example/long3"},
+		"\x00long3":      {ID: "11", Status: 1, Short: "long3", Original: "https://anony.example/long4"},
+		"\x00long4":      {ID: "12", Status: 1, Short: "long4", Original: "https://anony.example/long5"},
+		"\x00long5":      {ID: "13", Status: 1, Short: "long5", Original: "https://anony.example/long6"},
+		"\x00long6":      {ID: "14", Status: 1, Short: "long6", Original: "https://example.com/"},
+		"\x00self-short": {ID: "15", Status: 1, Short: "self-short", Original: "https://anony.example/new-code"},
+		"\x00inactive":   {ID: "16", Status: 2, Short: "inactive", Original: "https://example.com/inactive"},
+		"\x00blocked":    {ID: "17", Status: 1, Short: "blocked", Original: "https://anony.example/loop-back", BlockedReason: "phishing"},
+		"\x00expired":    {ID: "18", Status: 1, Short: "expired", Original: "https://example.com/campaign", ActiveUntil: &expired, Fallback: "https://anony.example/external"},
+		"\x00ended":      {ID: "19", Status: 1, Short: "ended", Original: "https://example.com/campaign", ActiveUntil: &expired},
+	}
+	domains := map[string]*model.Domain{
+		"go.brand.example":   {ID: "d1", Name: "go.brand.example", Verified: true},
+		"unverified.example": {ID: "d2", Name: "unverified.example", Verified: false},
+	}
+	s := NewRedirectChainService(
+		testutils.AnonyURLRepoMock{
+			FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+				return anonyURLs[domainID+"\x00"+anonyURL], nil
+			},
+		},
+		testutils.VerifiedDomainFinderMock{
+			FakeFindVerifiedByName: func(name string) (*model.Domain, error) {
+				if d, ok := domains[name]; ok && d.Verified {
+					return d, nil
+				}
+				return nil, nil
+			},
+		},
+		[]string{"https://anony.example", "http://localhost:8080"},
+	)
+
+	tests := []struct {
+		name        string
+		destination string
+		self        *model.AnonyURL
+		want        string
+		wantErr     error
+	}{
+		{name: "NORMAL: 外部のURLはそのまま", destination: "https://example.org/path", want: "https://example.org/path"},
+		{name: "NORMAL: 確認済みでないドメインは外部のURLとして扱う", destination: "https://unverified.example/code", want: "https://unverified.example/code"},
+		{name: "NORMAL: 短縮URLを辿る", destination: "https://anony.example/external", want: "https://example.com/"},
+		{name: "NORMAL: 別名のホストの短縮URLを辿る", destination: "http://LOCALHOST:8080/external", want: "https://example.com/"},
+		{name: "NORMAL: 独自ドメインを含む複数の短縮URLを辿る", destination: "https://anony.example/chain1", want: "https://example.com/final"},
+		{name: "NORMAL: パスとクエリを引き継ぐ", destination: "https://anony.example/forward/guide?lang=ja", want: "https://example.com/docs/guide?lang=ja"},
+		{name: "NORMAL: 2セグメントのコード", destination: "https://anony.example/user/code", want: "https://example.com/legacy"},
+		{name: "NORMAL: 無効な短縮URLで止める", destination: "https://anony.example/inactive", want: "https://anony.example/inactive"},
+		{name: "NORMAL: ブロックされた短縮URLで止める", destination: "https://anony.example/blocked", want: "https://anony.example/blocked"},
+		{name: "NORMAL: 有効期間外の場合はFallbackを辿る", destination: "https://anony.example/expired", want: "https://example.com/"},
+		{name: "NORMAL: 有効期間外でFallbackがない場合は止める", destination: "https://anony.example/ended", want: "https://anony.example/ended"},
+		{name: "ERROR: 短縮URL同士でループする場合", destination: "https://anony.example/loop1", wantErr: ErrRedirectLoop},
+		{
+			name:        "ERROR: 作成中の短縮URLへ戻る場合",
+			destination: "https://anony.example/self-short",
+			self:        &model.AnonyURL{Short: "new-code"},
+			wantErr:     ErrRedirectLoop,
+		},
+		{
+			name:        "ERROR: 自身の短縮URLを指定した場合",
+			destination: "https://go.brand.example/new-code",
+			self:        &model.AnonyURL{Short: "new-code", DomainID: "d1"},
+			wantErr:     ErrRedirectLoop,
+		},
+		{name: "ERROR: 短縮URLが長く連なる場合", destination: "https://anony.example/long1", wantErr: ErrRedirectChainTooLong},
+		{name: "ERROR: 存在しない短縮URLの場合", destination: "https://anony.example/not-found", wantErr: ErrSelfReference},
+		{name: "ERROR: 短縮URLでないパスの場合", destination: "https://anony.example/", wantErr: ErrSelfReference},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Resolve(tt.destination, tt.self)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("redirectChainService.Resolve() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("redirectChainService.Resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
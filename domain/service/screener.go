@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// DestinationScreener screens destinations of AnonyURLs for phishing and malware
+// ブロックリスト等の実装はinfrastructure/screenerにある
+type DestinationScreener interface {
+	// Screen returns the reason if the destination is blocked, or "" if it is allowed
+	Screen(ctx context.Context, destination string) (string, error)
+}
+
+// BlockedDestinationError is returned when a destination is blocked by DestinationScreener
+type BlockedDestinationError struct {
+	Destination string
+	Reason      string
+}
+
+func (e *BlockedDestinationError) Error() string {
+	return fmt.Sprintf("destination %s is blocked: %s", e.Destination, e.Reason)
+}
+
+// ScreenDestinations screens the destinations in order and returns *BlockedDestinationError for the first blocked one
+// 空文字のリダイレクト先は確認しない
+func ScreenDestinations(ctx context.Context, s DestinationScreener, destinations ...string) error {
+	for _, d := range destinations {
+		if d == "" {
+			continue
+		}
+		reason, err := s.Screen(ctx, d)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			return &BlockedDestinationError{Destination: d, Reason: reason}
+		}
+	}
+	return nil
+}
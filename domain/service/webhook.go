@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/google/uuid"
+)
+
+// WebhookSender sends deliveries of events to webhooks
+// HTTPでの実装はinfrastructure/webhookにある
+type WebhookSender interface {
+	// Send posts the payload of the delivery signed with the secret of the webhook
+	// 2xx以外の応答や接続できなかった場合はerrorを返す
+	Send(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery) error
+	// CheckURL returns an error if the sender refuses to send to the URL
+	// 登録時に確認する. 内部のネットワークのアドレスには送らない
+	CheckURL(ctx context.Context, url string) error
+}
+
+// WebhookService is a service of webhooks.
+type WebhookService interface {
+	// Enqueue writes the event to the outbox for each webhook of the user subscribing it
+	// 変更と同じトランザクションのctxで呼ぶ. e.IDは空の場合に付ける. 送信はusecase.WebhookDispatcherが行う
+	Enqueue(ctx context.Context, userID string, e *model.WebhookEvent) error
+}
+
+type webhookService struct {
+	repo         repository.WebhookRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+}
+
+// NewWebhookService create a new service.
+func NewWebhookService(r repository.WebhookRepository, dr repository.WebhookDeliveryRepository) WebhookService {
+	return &webhookService{r, dr}
+}
+
+func (s *webhookService) Enqueue(ctx context.Context, userID string, e *model.WebhookEvent) error {
+	ws, err := s.repo.FindByUserID(userID)
+	if err != nil {
+		return err
+	}
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	ds := []*model.WebhookDelivery{}
+	var payload []byte
+	for _, w := range ws {
+		if !w.Subscribes(e.Type) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(e); err != nil {
+				return err
+			}
+		}
+		ds = append(ds, &model.WebhookDelivery{
+			ID:            uuid.New().String(),
+			WebhookID:     w.ID,
+			EventID:       e.ID,
+			EventType:     e.Type,
+			Payload:       payload,
+			Status:        model.WebhookDeliveryPending,
+			NextAttemptAt: e.CreatedAt,
+		})
+	}
+	return s.deliveryRepo.SaveAll(ctx, ds)
+}
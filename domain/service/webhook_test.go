@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestWebhookService_Enqueue(t *testing.T) {
+	webhooks := []*model.Webhook{
+		{ID: "all", UserID: "user", URL: "https://example.com/all", EventTypes: model.WebhookEventTypes},
+		{ID: "clicked", UserID: "user", URL: "https://example.com/clicked", EventTypes: []string{model.WebhookEventAnonyURLClicked}},
+	}
+	tests := []struct {
+		name      string
+		eventType string
+		want      []string
+	}{
+		{name: "NORMAL: 購読しているWebhookにだけ書き込む", eventType: model.WebhookEventAnonyURLCreated, want: []string{"all"}},
+		{name: "NORMAL: 複数のWebhookに同じイベントを書き込む", eventType: model.WebhookEventAnonyURLClicked, want: []string{"all", "clicked"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved []*model.WebhookDelivery
+			s := NewWebhookService(
+				testutils.WebhookRepoMock{
+					FakeFindByUserID: func(userID string) ([]*model.Webhook, error) { return webhooks, nil },
+				},
+				testutils.WebhookDeliveryRepoMock{
+					FakeSaveAll: func(ctx context.Context, ds []*model.WebhookDelivery) error {
+						saved = ds
+						return nil
+					},
+				},
+			)
+			at := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+			e := model.NewWebhookEvent(tt.eventType, &model.AnonyURL{ID: "url-id", Short: "abc"}, at)
+			if err := s.Enqueue(context.Background(), "user", e); err != nil {
+				t.Fatalf("WebhookService.Enqueue() error = %v", err)
+			}
+			if e.ID == "" || len(saved) != len(tt.want) {
+				t.Fatalf("WebhookService.Enqueue() event = %+v, saved = %v", e, saved)
+			}
+			for i, d := range saved {
+				var got model.WebhookEvent
+				if err := json.Unmarshal(d.Payload, &got); err != nil {
+					t.Fatalf("WebhookService.Enqueue() payload = %s", d.Payload)
+				}
+				if d.WebhookID != tt.want[i] || d.EventID != e.ID || got.ID != e.ID || d.Status != model.WebhookDeliveryPending || !d.NextAttemptAt.Equal(at) {
+					t.Errorf("WebhookService.Enqueue() delivery = %+v", d)
+				}
+			}
+		})
+	}
+}
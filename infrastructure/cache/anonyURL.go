@@ -0,0 +1,278 @@
+// Package cache caches lookups of the repositories in the process.
+// 複数のプロセスで動かす場合, 他のプロセスでの変更は有効期限かWatchでの無効化まで反映されない
+package cache
+
+import (
+	"container/list"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+)
+
+// Config is the configuration of the cache
+type Config struct {
+	// Size is the max number of the cached lookups. 0の場合はキャッシュしない
+	Size int
+	// TTL is how long a found AnonyURL is cached. 0の場合は見つかったAnonyURLをキャッシュしない
+	TTL time.Duration
+	// NegativeTTL is how long an unknown code is cached. 0の場合は存在しないコードをキャッシュしない
+	NegativeTTL time.Duration
+}
+
+type anonyURLKey struct {
+	domainID string
+	anonyURL string
+}
+
+type anonyURLEntry struct {
+	key       anonyURLKey
+	an        *model.AnonyURL // nil: 存在しないコード
+	expiresAt time.Time
+}
+
+// anonyURLCall is an in-flight lookup shared by concurrent misses of the same code
+type anonyURLCall struct {
+	wg  sync.WaitGroup
+	an  *model.AnonyURL
+	err error
+}
+
+// updatedWindowMargin is added to the interval of Watch so that updates committed late are not missed
+const updatedWindowMargin = 5 * time.Second
+
+// AnonyURLRepository caches FindByAnonyURL of the repository in an LRU
+// 他のメソッドはそのまま呼ぶ. このプロセスでの変更はHandleEventで, 他のプロセスでの変更はWatchでupdated_atを確認して反映する
+type AnonyURLRepository struct {
+	repository.AnonyURLRepository
+	config Config
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[anonyURLKey]*list.Element
+	lru     *list.List // 先頭が最近使われたもの
+	calls   map[anonyURLKey]*anonyURLCall
+	// 無効化のたびに増やし, 取得中に無効化された結果をキャッシュしないようにする
+	generation uint64
+}
+
+var _ repository.AnonyURLRepository = (*AnonyURLRepository)(nil)
+
+// NewAnonyURLRepository creates an AnonyURLRepository caching the lookups of r
+func NewAnonyURLRepository(r repository.AnonyURLRepository, c Config) *AnonyURLRepository {
+	return &AnonyURLRepository{
+		AnonyURLRepository: r,
+		config:             c,
+		now:                time.Now,
+		entries:            map[anonyURLKey]*list.Element{},
+		lru:                list.New(),
+		calls:              map[anonyURLKey]*anonyURLCall{},
+	}
+}
+
+// FindByAnonyURL returns the cached AnonyURL, or finds it in the repository
+// 同じコードの同時の取得は1回にまとめる. 呼び出し元が変更できるように, 毎回コピーを返す
+func (r *AnonyURLRepository) FindByAnonyURL(domainID, anonyURL string) (*model.AnonyURL, error) {
+	if r.config.Size <= 0 {
+		return r.AnonyURLRepository.FindByAnonyURL(domainID, anonyURL)
+	}
+	k := anonyURLKey{domainID: domainID, anonyURL: anonyURL}
+	r.mu.Lock()
+	if e, ok := r.get(k); ok {
+		r.mu.Unlock()
+		return copyAnonyURL(e.an), nil
+	}
+	if c, ok := r.calls[k]; ok {
+		r.mu.Unlock()
+		c.wg.Wait()
+		return copyAnonyURL(c.an), c.err
+	}
+	c := &anonyURLCall{}
+	c.wg.Add(1)
+	r.calls[k] = c
+	generation := r.generation
+	r.mu.Unlock()
+
+	r.fetch(k, c, generation)
+	return copyAnonyURL(c.an), c.err
+}
+
+// fetch finds the AnonyURL in the repository and caches it unless it is invalidated meanwhile
+func (r *AnonyURLRepository) fetch(k anonyURLKey, c *anonyURLCall, generation uint64) {
+	defer func() {
+		r.mu.Lock()
+		if r.calls[k] == c {
+			delete(r.calls, k)
+		}
+		if c.err == nil && r.generation == generation {
+			r.add(k, c.an)
+		}
+		r.mu.Unlock()
+		c.wg.Done()
+	}()
+	c.an, c.err = r.AnonyURLRepository.FindByAnonyURL(k.domainID, k.anonyURL)
+}
+
+// Invalidate drops the cached lookup of the code
+// 取得中の結果もキャッシュしない
+func (r *AnonyURLRepository) Invalidate(domainID, anonyURL string) {
+	k := anonyURLKey{domainID: domainID, anonyURL: anonyURL}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if el, ok := r.entries[k]; ok {
+		r.lru.Remove(el)
+		delete(r.entries, k)
+	}
+	delete(r.calls, k)
+	r.generation++
+}
+
+// HandleEvent invalidates the AnonyURL created or changed in the event
+// usecase.EventBusでAnonyURLCreated, AnonyURLStatusChanged, AnonyURLUpdatedを購読する
+// 他のプロセスでの変更はWatchで反映する
+func (r *AnonyURLRepository) HandleEvent(ctx context.Context, e model.Event) error {
+	var an *model.AnonyURL
+	switch e := e.(type) {
+	case model.AnonyURLCreated:
+		an = e.AnonyURL
+	case model.AnonyURLStatusChanged:
+		an = e.AnonyURL
+	case model.AnonyURLUpdated:
+		an = e.AnonyURL
+	}
+	if an != nil {
+		r.Invalidate(an.DomainID, an.Short)
+	}
+	return nil
+}
+
+// Purge drops all the cached lookups including in-flight ones
+func (r *AnonyURLRepository) Purge() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = map[anonyURLKey]*list.Element{}
+	r.lru.Init()
+	r.calls = map[anonyURLKey]*anonyURLCall{}
+	r.generation++
+}
+
+// Watch invalidates the AnonyURLs updated in any process every interval until ctx is done
+// 取得に失敗した場合は, 更新を見逃さないように全て無効化する
+func (r *AnonyURLRepository) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.invalidateUpdated(interval + updatedWindowMargin)
+		}
+	}
+}
+
+// invalidateUpdated invalidates the AnonyURLs updated within d
+func (r *AnonyURLRepository) invalidateUpdated(d time.Duration) {
+	ans, err := r.AnonyURLRepository.FindUpdatedWithin(d)
+	if err != nil {
+		log.Printf("failed to find updated anonyURLs, the cache is purged: %s", err)
+		r.Purge()
+		return
+	}
+	for _, an := range ans {
+		r.Invalidate(an.DomainID, an.Short)
+	}
+}
+
+// Len returns the number of the cached lookups including expired ones
+func (r *AnonyURLRepository) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lru.Len()
+}
+
+// get returns the entry of the key if it is not expired
+func (r *AnonyURLRepository) get(k anonyURLKey) (*anonyURLEntry, bool) {
+	el, ok := r.entries[k]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*anonyURLEntry)
+	if !r.now().Before(e.expiresAt) {
+		r.lru.Remove(el)
+		delete(r.entries, k)
+		return nil, false
+	}
+	r.lru.MoveToFront(el)
+	return e, true
+}
+
+// add caches the result of the lookup, and evicts the least recently used one if the cache is full
+func (r *AnonyURLRepository) add(k anonyURLKey, an *model.AnonyURL) {
+	ttl := r.config.TTL
+	if an == nil {
+		ttl = r.config.NegativeTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+	e := &anonyURLEntry{key: k, an: an, expiresAt: r.now().Add(ttl)}
+	if el, ok := r.entries[k]; ok {
+		el.Value = e
+		r.lru.MoveToFront(el)
+		return
+	}
+	r.entries[k] = r.lru.PushFront(e)
+	for r.lru.Len() > r.config.Size {
+		oldest := r.lru.Back()
+		r.lru.Remove(oldest)
+		delete(r.entries, oldest.Value.(*anonyURLEntry).key)
+	}
+}
+
+// copyAnonyURL returns a deep copy so that callers can modify the cached AnonyURL
+func copyAnonyURL(an *model.AnonyURL) *model.AnonyURL {
+	if an == nil {
+		return nil
+	}
+	c := *an
+	if an.Variants != nil {
+		c.Variants = make([]*model.Variant, len(an.Variants))
+		for i, v := range an.Variants {
+			tmp := *v
+			c.Variants[i] = &tmp
+		}
+	}
+	c.ActiveFrom = copyTime(an.ActiveFrom)
+	c.ActiveUntil = copyTime(an.ActiveUntil)
+	c.LastCheckedAt = copyTime(an.LastCheckedAt)
+	if an.Preview != nil {
+		p := *an.Preview
+		c.Preview = &p
+	}
+	if an.Metadata != nil {
+		c.Metadata = make(map[string]string, len(an.Metadata))
+		for k, v := range an.Metadata {
+			c.Metadata[k] = v
+		}
+	}
+	if an.Tags != nil {
+		c.Tags = make([]*model.Tag, len(an.Tags))
+		for i, t := range an.Tags {
+			tmp := *t
+			c.Tags[i] = &tmp
+		}
+	}
+	return &c
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
@@ -0,0 +1,445 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+// countingRepo serves the AnonyURLs by the code and counts FindByAnonyURL
+func countingRepo(ans map[string]*model.AnonyURL, calls *int64) testutils.AnonyURLRepoMock {
+	return testutils.AnonyURLRepoMock{
+		FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+			atomic.AddInt64(calls, 1)
+			if an, ok := ans[domainID+"/"+anonyURL]; ok {
+				c := *an
+				return &c, nil
+			}
+			return nil, nil
+		},
+	}
+}
+
+func TestAnonyURLRepository_FindByAnonyURL(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	ans := map[string]*model.AnonyURL{
+		"/abc":     {ID: "id1", Short: "abc", Original: "https://example.com"},
+		"domain/a": {ID: "id2", Short: "a", DomainID: "domain", Original: "https://example.org"},
+	}
+	var calls int64
+	r := NewAnonyURLRepository(countingRepo(ans, &calls), Config{Size: 10, TTL: time.Minute, NegativeTTL: 10 * time.Second})
+	r.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		got, err := r.FindByAnonyURL("", "abc")
+		if err != nil || got == nil || got.ID != "id1" {
+			t.Fatalf("AnonyURLRepository.FindByAnonyURL() = %+v, %v", got, err)
+		}
+		// 呼び出し元の変更はキャッシュに影響しない
+		got.Original = "https://changed.example"
+	}
+	if got, _ := r.FindByAnonyURL("", "abc"); got.Original != "https://example.com" {
+		t.Errorf("AnonyURLRepository.FindByAnonyURL() Original = %v, want the cached value", got.Original)
+	}
+	if calls != 1 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 1", calls)
+	}
+	// ドメインごとに別のコード
+	if got, _ := r.FindByAnonyURL("domain", "abc"); got != nil {
+		t.Errorf("AnonyURLRepository.FindByAnonyURL() of another domain = %+v, want nil", got)
+	}
+	if got, _ := r.FindByAnonyURL("domain", "abc"); got != nil || calls != 2 {
+		t.Errorf("AnonyURLRepository.FindByAnonyURL() = %+v, calls = %d, want nil from the cache", got, calls)
+	}
+
+	// 存在しないコードは短い期間で取得し直す
+	now = now.Add(10 * time.Second)
+	r.FindByAnonyURL("domain", "abc")
+	r.FindByAnonyURL("", "abc")
+	if calls != 3 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 3", calls)
+	}
+	now = now.Add(time.Minute)
+	r.FindByAnonyURL("", "abc")
+	if calls != 4 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 4", calls)
+	}
+}
+
+func TestAnonyURLRepository_FindByAnonyURL_Evict(t *testing.T) {
+	var calls int64
+	r := NewAnonyURLRepository(countingRepo(map[string]*model.AnonyURL{}, &calls), Config{Size: 2, TTL: time.Minute, NegativeTTL: time.Minute})
+	r.FindByAnonyURL("", "a")
+	r.FindByAnonyURL("", "b")
+	// aを最近使ったものにして, cの追加でbを追い出す
+	r.FindByAnonyURL("", "a")
+	r.FindByAnonyURL("", "c")
+	if r.Len() != 2 {
+		t.Errorf("AnonyURLRepository.Len() = %v, want 2", r.Len())
+	}
+	r.FindByAnonyURL("", "a")
+	if calls != 3 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 3", calls)
+	}
+	r.FindByAnonyURL("", "b")
+	if calls != 4 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 4", calls)
+	}
+}
+
+func TestAnonyURLRepository_FindByAnonyURL_Config(t *testing.T) {
+	ans := map[string]*model.AnonyURL{"/abc": {ID: "id1", Short: "abc"}}
+	tests := []struct {
+		name      string
+		config    Config
+		code      string
+		wantCalls int64
+	}{
+		{name: "NORMAL: 大きさが0の場合はキャッシュしない", config: Config{Size: 0, TTL: time.Minute, NegativeTTL: time.Minute}, code: "abc", wantCalls: 2},
+		{name: "NORMAL: 見つかったコードのTTLが0の場合", config: Config{Size: 10, TTL: 0, NegativeTTL: time.Minute}, code: "abc", wantCalls: 2},
+		{name: "NORMAL: 存在しないコードのTTLが0の場合", config: Config{Size: 10, TTL: time.Minute, NegativeTTL: 0}, code: "unknown", wantCalls: 2},
+		{name: "NORMAL: 存在しないコードをキャッシュする", config: Config{Size: 10, TTL: 0, NegativeTTL: time.Minute}, code: "unknown", wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int64
+			r := NewAnonyURLRepository(countingRepo(ans, &calls), tt.config)
+			r.FindByAnonyURL("", tt.code)
+			r.FindByAnonyURL("", tt.code)
+			if calls != tt.wantCalls {
+				t.Errorf("repository.FindByAnonyURL() is called %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestAnonyURLRepository_FindByAnonyURL_Error(t *testing.T) {
+	var calls int64
+	r := NewAnonyURLRepository(testutils.AnonyURLRepoMock{
+		FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+			atomic.AddInt64(&calls, 1)
+			return nil, errors.New("connection refused")
+		},
+	}, Config{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+	// エラーは存在しないコードとしてキャッシュしない
+	for i := 0; i < 2; i++ {
+		if _, err := r.FindByAnonyURL("", "abc"); err == nil {
+			t.Errorf("AnonyURLRepository.FindByAnonyURL() error = nil, want error")
+		}
+	}
+	if calls != 2 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 2", calls)
+	}
+}
+
+func TestAnonyURLRepository_FindByAnonyURL_Singleflight(t *testing.T) {
+	var calls int64
+	release := make(chan struct{})
+	r := NewAnonyURLRepository(testutils.AnonyURLRepoMock{
+		FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+			atomic.AddInt64(&calls, 1)
+			<-release
+			return &model.AnonyURL{ID: "id1", Short: anonyURL}, nil
+		},
+	}, Config{Size: 10, TTL: time.Minute})
+
+	const n = 10
+	var wg sync.WaitGroup
+	results := make(chan *model.AnonyURL, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			an, _ := r.FindByAnonyURL("", "abc")
+			results <- an
+		}()
+	}
+	// 全てのゴルーチンが取得中の呼び出しを待つまで待つ
+	for {
+		r.mu.Lock()
+		waiting := r.calls[anonyURLKey{anonyURL: "abc"}] != nil
+		r.mu.Unlock()
+		if waiting && atomic.LoadInt64(&calls) == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if calls != 1 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 1", calls)
+	}
+	seen := map[*model.AnonyURL]bool{}
+	for an := range results {
+		if an == nil || an.ID != "id1" || seen[an] {
+			t.Errorf("AnonyURLRepository.FindByAnonyURL() = %p %+v, want a copy of id1", an, an)
+		}
+		seen[an] = true
+	}
+}
+
+func TestAnonyURLRepository_HandleEvent(t *testing.T) {
+	ans := map[string]*model.AnonyURL{"/abc": {ID: "id1", Short: "abc", Status: 1}, "/def": {ID: "id3", Short: "def", Status: 1}}
+	var calls int64
+	r := NewAnonyURLRepository(countingRepo(ans, &calls), Config{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+	r.FindByAnonyURL("", "abc")
+	r.FindByAnonyURL("", "new")
+	r.FindByAnonyURL("", "def")
+
+	tests := []struct {
+		name  string
+		event model.Event
+		code  string
+	}{
+		{name: "NORMAL: ステータスの変更", event: model.AnonyURLStatusChanged{AnonyURL: &model.AnonyURL{ID: "id1", Short: "abc"}}, code: "abc"},
+		{name: "NORMAL: 存在しないとキャッシュしたコードの作成", event: model.AnonyURLCreated{AnonyURL: &model.AnonyURL{ID: "id2", Short: "new"}}, code: "new"},
+		{name: "NORMAL: 有効期間などの変更", event: model.AnonyURLUpdated{AnonyURL: &model.AnonyURL{ID: "id3", Short: "def"}}, code: "def"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := calls
+			if err := r.HandleEvent(context.Background(), tt.event); err != nil {
+				t.Fatalf("AnonyURLRepository.HandleEvent() error = %v", err)
+			}
+			r.FindByAnonyURL("", tt.code)
+			if calls != before+1 {
+				t.Errorf("repository.FindByAnonyURL() is called %d times after the event, want %d", calls, before+1)
+			}
+		})
+	}
+}
+
+func TestAnonyURLRepository_invalidateUpdated(t *testing.T) {
+	ans := map[string]*model.AnonyURL{"/abc": {ID: "id1", Short: "abc", Status: 1}, "/def": {ID: "id3", Short: "def", Status: 1}}
+	var calls int64
+	var updated []*model.AnonyURL
+	var findErr error
+	var within time.Duration
+	repo := countingRepo(ans, &calls)
+	repo.FakeFindUpdatedWithin = func(d time.Duration) ([]*model.AnonyURL, error) {
+		within = d
+		return updated, findErr
+	}
+	r := NewAnonyURLRepository(repo, Config{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+
+	tests := []struct {
+		name        string
+		updated     []*model.AnonyURL
+		findErr     error
+		wantFetched map[string]bool
+	}{
+		{
+			name:        "NORMAL: 更新されたリンクのみ無効化する",
+			updated:     []*model.AnonyURL{{ID: "id1", Short: "abc"}},
+			wantFetched: map[string]bool{"abc": true, "new": false, "def": false},
+		},
+		{
+			name:        "NORMAL: 存在しないとキャッシュしたコードの作成",
+			updated:     []*model.AnonyURL{{ID: "id2", Short: "new"}},
+			wantFetched: map[string]bool{"abc": false, "new": true, "def": false},
+		},
+		{
+			name:        "ERROR: 取得に失敗した場合は全て無効化する",
+			findErr:     fmt.Errorf("error"),
+			wantFetched: map[string]bool{"abc": true, "new": true, "def": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, code := range []string{"abc", "new", "def"} {
+				r.FindByAnonyURL("", code)
+			}
+			updated, findErr = tt.updated, tt.findErr
+			r.invalidateUpdated(6 * time.Second)
+			if within != 6*time.Second {
+				t.Errorf("repository.FindUpdatedWithin() d = %v, want 6s", within)
+			}
+			for _, code := range []string{"abc", "new", "def"} {
+				before := calls
+				r.FindByAnonyURL("", code)
+				if got := calls != before; got != tt.wantFetched[code] {
+					t.Errorf("FindByAnonyURL(%q) fetched = %v, want %v", code, got, tt.wantFetched[code])
+				}
+			}
+		})
+	}
+}
+
+func TestAnonyURLRepository_Watch(t *testing.T) {
+	var calls int64
+	found := make(chan struct{}, 1)
+	repo := countingRepo(map[string]*model.AnonyURL{}, &calls)
+	repo.FakeFindUpdatedWithin = func(d time.Duration) ([]*model.AnonyURL, error) {
+		select {
+		case found <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}
+	r := NewAnonyURLRepository(repo, Config{Size: 10, TTL: time.Minute})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Watch(ctx, time.Millisecond)
+		close(done)
+	}()
+	select {
+	case <-found:
+	case <-time.After(time.Second):
+		t.Fatalf("AnonyURLRepository.Watch() did not find updated anonyURLs")
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("AnonyURLRepository.Watch() did not return after ctx is done")
+	}
+}
+
+func TestAnonyURLRepository_FindByAnonyURL_DeepCopy(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	ans := map[string]*model.AnonyURL{
+		"/abc": {
+			ID:         "id1",
+			Short:      "abc",
+			Variants:   []*model.Variant{{ID: "v1", Destination: "https://example.com/a", Weight: 1}},
+			ActiveFrom: &from,
+			Preview:    &model.LinkPreview{Title: "title"},
+			Metadata:   map[string]string{"key": "value"},
+			Tags:       []*model.Tag{{ID: "tag1", Name: "name"}},
+		},
+	}
+	var calls int64
+	r := NewAnonyURLRepository(countingRepo(ans, &calls), Config{Size: 10, TTL: time.Minute})
+	got, _ := r.FindByAnonyURL("", "abc")
+	// 呼び出し元がフィールドの中身を変更しても, キャッシュに影響しない
+	got.Variants[0].Destination = "https://changed.example"
+	*got.ActiveFrom = from.Add(time.Hour)
+	got.Preview.Title = "changed"
+	got.Metadata["key"] = "changed"
+	got.Tags[0].Name = "changed"
+
+	got, _ = r.FindByAnonyURL("", "abc")
+	if got.Variants[0].Destination != "https://example.com/a" || !got.ActiveFrom.Equal(from) || got.Preview.Title != "title" || got.Metadata["key"] != "value" || got.Tags[0].Name != "name" {
+		t.Errorf("AnonyURLRepository.FindByAnonyURL() = %+v, want the cached value", got)
+	}
+	if calls != 1 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 1", calls)
+	}
+}
+
+func TestAnonyURLRepository_Invalidate_InFlight(t *testing.T) {
+	var calls int64
+	fetching, release := make(chan struct{}), make(chan struct{})
+	r := NewAnonyURLRepository(testutils.AnonyURLRepoMock{
+		FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+			if atomic.AddInt64(&calls, 1) == 1 {
+				close(fetching)
+				<-release
+			}
+			return &model.AnonyURL{ID: "id1", Short: anonyURL}, nil
+		},
+	}, Config{Size: 10, TTL: time.Minute})
+
+	done := make(chan struct{})
+	go func() {
+		r.FindByAnonyURL("", "abc")
+		close(done)
+	}()
+	<-fetching
+	// 取得中に変更された場合は, 古い可能性がある結果をキャッシュしない
+	r.Invalidate("", "abc")
+	close(release)
+	<-done
+	r.FindByAnonyURL("", "abc")
+	if calls != 2 {
+		t.Errorf("repository.FindByAnonyURL() is called %d times, want 2", calls)
+	}
+}
+
+// benchmarkRepo simulates the latency of MySQL
+func benchmarkRepo(latency time.Duration) testutils.AnonyURLRepoMock {
+	return testutils.AnonyURLRepoMock{
+		FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+			time.Sleep(latency)
+			if anonyURL[0] == 'x' {
+				return nil, nil
+			}
+			return &model.AnonyURL{ID: anonyURL, Short: anonyURL, Original: "https://example.com"}, nil
+		},
+	}
+}
+
+// BenchmarkAnonyURLRepository_FindByAnonyURL compares the latency of lookups of 1000 hot codes under parallel load
+// 一定の割合で存在しないコード(xで始まる)を含める
+func BenchmarkAnonyURLRepository_FindByAnonyURL(b *testing.B) {
+	const latency = 200 * time.Microsecond
+	codes := make([]string, 1000)
+	for i := range codes {
+		if i%10 == 0 {
+			codes[i] = fmt.Sprintf("x%07d", i)
+		} else {
+			codes[i] = fmt.Sprintf("c%07d", i)
+		}
+	}
+	benchmarks := []struct {
+		name   string
+		config Config
+	}{
+		{name: "uncached", config: Config{}},
+		{name: "cached", config: Config{Size: 10000, TTL: time.Minute, NegativeTTL: time.Minute}},
+		{name: "cached-small", config: Config{Size: 100, TTL: time.Minute, NegativeTTL: time.Minute}},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			r := NewAnonyURLRepository(benchmarkRepo(latency), bm.config)
+			// キャッシュが温まった状態で測る
+			for _, c := range codes {
+				r.FindByAnonyURL("", c)
+			}
+			var next int64
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := atomic.AddInt64(&next, 1)
+					if _, err := r.FindByAnonyURL("", codes[i%int64(len(codes))]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkAnonyURLRepository_FindByAnonyURL_Miss measures concurrent misses of a hot code, which are deduplicated
+// 見つかったAnonyURLはキャッシュしないので, 同時の呼び出しのみまとめられる
+func BenchmarkAnonyURLRepository_FindByAnonyURL_Miss(b *testing.B) {
+	var calls int64
+	r := NewAnonyURLRepository(testutils.AnonyURLRepoMock{
+		FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+			atomic.AddInt64(&calls, 1)
+			time.Sleep(200 * time.Microsecond)
+			return &model.AnonyURL{ID: anonyURL, Short: anonyURL}, nil
+		},
+	}, Config{Size: 10000, TTL: 0})
+	b.SetParallelism(8)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := r.FindByAnonyURL("", "abc"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.ReportMetric(float64(atomic.LoadInt64(&calls))/float64(b.N), "queries/op")
+}
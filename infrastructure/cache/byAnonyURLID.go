@@ -0,0 +1,177 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+)
+
+type idEntry struct {
+	v         interface{}
+	expiresAt time.Time
+}
+
+// idCache caches values by the AnonyURL ID for ttl
+type idCache struct {
+	size int
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]idEntry
+	// 無効化のたびに増やし, 取得中に無効化された結果をキャッシュしないようにする
+	generation uint64
+}
+
+func newIDCache(size int, ttl time.Duration) *idCache {
+	return &idCache{size: size, ttl: ttl, now: time.Now, entries: map[string]idEntry{}}
+}
+
+func (c *idCache) disabled() bool {
+	return c.size <= 0 || c.ttl <= 0
+}
+
+// get returns the cached value, and the generation to pass to set if it is not found
+func (c *idCache) get(id string) (interface{}, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[id]
+	if ok && c.now().Before(e.expiresAt) {
+		return e.v, c.generation, true
+	}
+	return nil, c.generation, false
+}
+
+// set caches the value unless it is invalidated after get
+func (c *idCache) set(id string, v interface{}, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	now := c.now()
+	if _, ok := c.entries[id]; !ok && len(c.entries) >= c.size {
+		c.evict(now)
+	}
+	c.entries[id] = idEntry{v: v, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *idCache) invalidate(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, id)
+	c.generation++
+}
+
+// evict deletes expired entries, and a tenth of the entries if it is still full
+func (c *idCache) evict(now time.Time) {
+	for k, v := range c.entries {
+		if !now.Before(v.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	for k := range c.entries {
+		if len(c.entries) < c.size-c.size/10 {
+			break
+		}
+		delete(c.entries, k)
+	}
+}
+
+// VariantRepository caches FindByAnonyURLID of the repository
+// リダイレクトのたびにA/Bテストの振り分け先を探さないようにする. 他のメソッドはそのまま呼ぶ
+// このプロセスでの変更はHandleEventで反映し, 他のプロセスでの変更は有効期限まで反映されない
+type VariantRepository struct {
+	repository.VariantRepository
+	cache *idCache
+}
+
+var _ repository.VariantRepository = (*VariantRepository)(nil)
+
+// NewVariantRepository creates a VariantRepository caching the variants of at most size AnonyURLs for ttl
+func NewVariantRepository(r repository.VariantRepository, size int, ttl time.Duration) *VariantRepository {
+	return &VariantRepository{VariantRepository: r, cache: newIDCache(size, ttl)}
+}
+
+// FindByAnonyURLID returns a copy of the cached variants, or finds them in the repository
+// Variantのないリンクも空のまま保持する
+func (r *VariantRepository) FindByAnonyURLID(anonyURLID string) ([]*model.Variant, error) {
+	if r.cache.disabled() {
+		return r.VariantRepository.FindByAnonyURLID(anonyURLID)
+	}
+	v, generation, ok := r.cache.get(anonyURLID)
+	if ok {
+		return copyVariants(v.([]*model.Variant)), nil
+	}
+	vs, err := r.VariantRepository.FindByAnonyURLID(anonyURLID)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.set(anonyURLID, copyVariants(vs), generation)
+	return vs, nil
+}
+
+// HandleEvent invalidates the variants of the AnonyURL updated in the event
+// usecase.EventBusでAnonyURLUpdatedを購読する
+func (r *VariantRepository) HandleEvent(ctx context.Context, e model.Event) error {
+	if e, ok := e.(model.AnonyURLUpdated); ok && e.AnonyURL != nil {
+		r.cache.invalidate(e.AnonyURL.ID)
+	}
+	return nil
+}
+
+// RedirectRuleRepository caches FindByAnonyURLID of the repository
+// リダイレクトのたびにルールを探さないようにする. 他のメソッドはそのまま呼ぶ
+// ルールの変更は有効期限まで反映されない
+type RedirectRuleRepository struct {
+	repository.RedirectRuleRepository
+	cache *idCache
+}
+
+var _ repository.RedirectRuleRepository = (*RedirectRuleRepository)(nil)
+
+// NewRedirectRuleRepository creates a RedirectRuleRepository caching the rules of at most size AnonyURLs for ttl
+func NewRedirectRuleRepository(r repository.RedirectRuleRepository, size int, ttl time.Duration) *RedirectRuleRepository {
+	return &RedirectRuleRepository{RedirectRuleRepository: r, cache: newIDCache(size, ttl)}
+}
+
+// FindByAnonyURLID returns a copy of the cached rules, or finds them in the repository
+// ルールのないリンクも空のまま保持する
+func (r *RedirectRuleRepository) FindByAnonyURLID(anonyURLID string) ([]*model.RedirectRule, error) {
+	if r.cache.disabled() {
+		return r.RedirectRuleRepository.FindByAnonyURLID(anonyURLID)
+	}
+	v, generation, ok := r.cache.get(anonyURLID)
+	if ok {
+		return copyRedirectRules(v.([]*model.RedirectRule)), nil
+	}
+	rules, err := r.RedirectRuleRepository.FindByAnonyURLID(anonyURLID)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.set(anonyURLID, copyRedirectRules(rules), generation)
+	return rules, nil
+}
+
+func copyVariants(vs []*model.Variant) []*model.Variant {
+	res := make([]*model.Variant, len(vs))
+	for i, v := range vs {
+		c := *v
+		res[i] = &c
+	}
+	return res
+}
+
+func copyRedirectRules(rules []*model.RedirectRule) []*model.RedirectRule {
+	res := make([]*model.RedirectRule, len(rules))
+	for i, rule := range rules {
+		c := *rule
+		c.ActiveFrom = copyTime(rule.ActiveFrom)
+		c.ActiveUntil = copyTime(rule.ActiveUntil)
+		res[i] = &c
+	}
+	return res
+}
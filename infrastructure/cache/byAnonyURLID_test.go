@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestVariantRepository_FindByAnonyURLID(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	vs := map[string][]*model.Variant{
+		"id1": {{ID: "v1", AnonyURLID: "id1", Destination: "https://a.example/", Weight: 1}},
+	}
+	calls := map[string]int{}
+	repo := testutils.VariantRepoMock{
+		FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.Variant, error) {
+			calls[anonyURLID]++
+			if anonyURLID == "broken" {
+				return nil, errors.New("error")
+			}
+			return copyVariants(vs[anonyURLID]), nil
+		},
+	}
+	r := NewVariantRepository(repo, 10, time.Minute)
+	r.cache.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		got, err := r.FindByAnonyURLID("id1")
+		if err != nil || len(got) != 1 || got[0].ID != "v1" {
+			t.Fatalf("VariantRepository.FindByAnonyURLID() = %+v, %v", got, err)
+		}
+		// 呼び出し元の変更はキャッシュに影響しない
+		got[0].Destination = "changed"
+	}
+	if got, _ := r.FindByAnonyURLID("id1"); got[0].Destination != "https://a.example/" {
+		t.Errorf("VariantRepository.FindByAnonyURLID() Destination = %v, want the cached value", got[0].Destination)
+	}
+	// Variantのないリンクもキャッシュし, エラーはキャッシュしない
+	for i := 0; i < 2; i++ {
+		if got, err := r.FindByAnonyURLID("id2"); err != nil || len(got) != 0 {
+			t.Errorf("VariantRepository.FindByAnonyURLID() = %+v, %v, want empty", got, err)
+		}
+		if _, err := r.FindByAnonyURLID("broken"); err == nil {
+			t.Errorf("VariantRepository.FindByAnonyURLID() error = nil, want error")
+		}
+	}
+	if calls["id1"] != 1 || calls["id2"] != 1 || calls["broken"] != 2 {
+		t.Errorf("repository.FindByAnonyURLID() calls = %v", calls)
+	}
+
+	// このプロセスでの変更はイベントで無効化する
+	vs["id1"] = nil
+	if err := r.HandleEvent(context.Background(), model.AnonyURLUpdated{AnonyURL: &model.AnonyURL{ID: "id1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := r.FindByAnonyURLID("id1"); len(got) != 0 || calls["id1"] != 2 {
+		t.Errorf("VariantRepository.FindByAnonyURLID() = %+v, calls = %d, want the new value", got, calls["id1"])
+	}
+	// 他のプロセスでの変更は有効期限が切れたら反映する
+	vs["id2"] = []*model.Variant{{ID: "v2", AnonyURLID: "id2"}}
+	now = now.Add(time.Minute)
+	if got, _ := r.FindByAnonyURLID("id2"); len(got) != 1 || calls["id2"] != 2 {
+		t.Errorf("VariantRepository.FindByAnonyURLID() = %+v, calls = %d, want the new value", got, calls["id2"])
+	}
+}
+
+func TestRedirectRuleRepository_FindByAnonyURLID(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	from := now.Add(-time.Hour)
+	calls := 0
+	repo := testutils.RedirectRuleRepoMock{
+		FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.RedirectRule, error) {
+			calls++
+			at := from
+			return []*model.RedirectRule{{ID: "r1", AnonyURLID: anonyURLID, ActiveFrom: &at, Destination: "https://a.example/"}}, nil
+		},
+	}
+	r := NewRedirectRuleRepository(repo, 10, time.Minute)
+	r.cache.now = func() time.Time { return now }
+	for i := 0; i < 2; i++ {
+		got, err := r.FindByAnonyURLID("id1")
+		if err != nil || len(got) != 1 || !got[0].ActiveFrom.Equal(from) {
+			t.Fatalf("RedirectRuleRepository.FindByAnonyURLID() = %+v, %v", got, err)
+		}
+		// 呼び出し元の変更はキャッシュに影響しない
+		*got[0].ActiveFrom = now
+	}
+	if calls != 1 {
+		t.Errorf("repository.FindByAnonyURLID() calls = %d, want 1", calls)
+	}
+}
+
+func Test_idCache(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	c := newIDCache(20, time.Minute)
+	c.now = func() time.Time { return now }
+	for i := 0; i < 100; i++ {
+		id := fmt.Sprintf("id%d", i)
+		_, generation, _ := c.get(id)
+		c.set(id, i, generation)
+		if len(c.entries) > 20 {
+			t.Fatalf("len(entries) = %d, want at most 20", len(c.entries))
+		}
+	}
+
+	// 取得中に無効化された結果はキャッシュしない
+	_, generation, _ := c.get("stale")
+	c.invalidate("stale")
+	c.set("stale", 1, generation)
+	if _, _, ok := c.get("stale"); ok {
+		t.Errorf("idCache.get() found the value invalidated while fetching")
+	}
+
+	if !newIDCache(0, time.Minute).disabled() || !newIDCache(10, 0).disabled() {
+		t.Errorf("idCache.disabled() = false, want true")
+	}
+}
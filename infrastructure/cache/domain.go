@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+)
+
+// VerifiedDomains holds all the verified domains in memory
+// 確認されたドメインはRefreshかWatchで読み込むまで外部のホストとして扱う
+type VerifiedDomains struct {
+	repo repository.DomainRepository
+
+	mu      sync.RWMutex
+	domains map[string]*model.Domain
+}
+
+var _ service.VerifiedDomainFinder = (*VerifiedDomains)(nil)
+
+// NewVerifiedDomains creates VerifiedDomains loaded from the repository
+func NewVerifiedDomains(r repository.DomainRepository) (*VerifiedDomains, error) {
+	v := &VerifiedDomains{repo: r}
+	if err := v.Refresh(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Refresh reloads the verified domains from the repository
+func (v *VerifiedDomains) Refresh() error {
+	ds, err := v.repo.FindVerified()
+	if err != nil {
+		return err
+	}
+	domains := make(map[string]*model.Domain, len(ds))
+	for _, d := range ds {
+		domains[strings.ToLower(d.Name)] = d
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.domains = domains
+	return nil
+}
+
+// Watch refreshes the verified domains every interval until ctx is done
+// 読み込めない場合はログに残し, 直前に読み込んだドメインを使い続ける
+func (v *VerifiedDomains) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := v.Refresh(); err != nil {
+				log.Printf("failed to refresh the verified domains: %s", err)
+			}
+		}
+	}
+}
+
+// FindVerifiedByName returns a copy of the verified domain, or nil if it is not loaded
+func (v *VerifiedDomains) FindVerifiedByName(name string) (*model.Domain, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	d, ok := v.domains[strings.ToLower(name)]
+	if !ok {
+		return nil, nil
+	}
+	c := *d
+	return &c, nil
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestVerifiedDomains_FindVerifiedByName(t *testing.T) {
+	verified := []*model.Domain{{ID: "d1", Name: "go.brand.example", Verified: true}}
+	var findErr error
+	calls := 0
+	v, err := NewVerifiedDomains(testutils.DomainRepoMock{
+		FakeFindVerified: func() ([]*model.Domain, error) {
+			calls++
+			return verified, findErr
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewVerifiedDomains() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if got, err := v.FindVerifiedByName("GO.brand.example"); err != nil || got == nil || got.ID != "d1" {
+			t.Errorf("VerifiedDomains.FindVerifiedByName() = %+v, %v, want d1", got, err)
+		}
+		if got, err := v.FindVerifiedByName("example.com"); err != nil || got != nil {
+			t.Errorf("VerifiedDomains.FindVerifiedByName() = %+v, %v, want nil", got, err)
+		}
+	}
+	// データベースは読み込み時のみ引く
+	if calls != 1 {
+		t.Errorf("repository.FindVerified() is called %d times, want 1", calls)
+	}
+	// 呼び出し元の変更は保持しているドメインに影響しない
+	got, _ := v.FindVerifiedByName("go.brand.example")
+	got.ID = "changed"
+	if got, _ := v.FindVerifiedByName("go.brand.example"); got.ID != "d1" {
+		t.Errorf("VerifiedDomains.FindVerifiedByName() ID = %v, want d1", got.ID)
+	}
+
+	verified = append(verified, &model.Domain{ID: "d2", Name: "new.example", Verified: true})
+	if err := v.Refresh(); err != nil {
+		t.Fatalf("VerifiedDomains.Refresh() error = %v", err)
+	}
+	if got, _ := v.FindVerifiedByName("new.example"); got == nil {
+		t.Errorf("VerifiedDomains.FindVerifiedByName() = nil after Refresh, want d2")
+	}
+
+	// 読み込めない場合は直前のドメインを使い続ける
+	findErr = errors.New("error")
+	if err := v.Refresh(); err == nil {
+		t.Errorf("VerifiedDomains.Refresh() error = nil, want error")
+	}
+	if got, _ := v.FindVerifiedByName("new.example"); got == nil {
+		t.Errorf("VerifiedDomains.FindVerifiedByName() = nil after a failed Refresh, want d2")
+	}
+}
+
+func TestVerifiedDomains_Watch(t *testing.T) {
+	refreshed := make(chan struct{}, 1)
+	first := true
+	v, err := NewVerifiedDomains(testutils.DomainRepoMock{
+		FakeFindVerified: func() ([]*model.Domain, error) {
+			if first {
+				first = false
+				return nil, nil
+			}
+			select {
+			case refreshed <- struct{}{}:
+			default:
+			}
+			return nil, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewVerifiedDomains() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		v.Watch(ctx, time.Millisecond)
+		close(done)
+	}()
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatalf("VerifiedDomains.Watch() did not refresh the domains")
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("VerifiedDomains.Watch() did not return after ctx is done")
+	}
+}
+
+func TestNewVerifiedDomains_Error(t *testing.T) {
+	_, err := NewVerifiedDomains(testutils.DomainRepoMock{
+		FakeFindVerified: func() ([]*model.Domain, error) { return nil, errors.New("error") },
+	})
+	if err == nil {
+		t.Errorf("NewVerifiedDomains() error = nil, want error")
+	}
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+)
+
+type webhookEntry struct {
+	ws        []*model.Webhook
+	expiresAt time.Time
+}
+
+// WebhookRepository caches FindByUserID of the repository
+// リダイレクトのたびにクリックを購読するWebhookを探さないようにする. 他のメソッドはそのまま呼ぶ
+// APIサーバーでの登録は有効期限まで反映されず, 削除されたWebhookへの送信はoutboxに書き込まれない
+type WebhookRepository struct {
+	repository.WebhookRepository
+	size int
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]webhookEntry
+}
+
+var _ repository.WebhookRepository = (*WebhookRepository)(nil)
+
+// NewWebhookRepository creates a WebhookRepository caching the webhooks of at most size users for ttl
+func NewWebhookRepository(r repository.WebhookRepository, size int, ttl time.Duration) *WebhookRepository {
+	return &WebhookRepository{
+		WebhookRepository: r,
+		size:              size,
+		ttl:               ttl,
+		now:               time.Now,
+		entries:           map[string]webhookEntry{},
+	}
+}
+
+// FindByUserID returns the cached webhooks of the user, or finds them in the repository
+// Webhookのないユーザーも空のまま保持する. 呼び出し元が変更できるように, 毎回コピーを返す
+func (r *WebhookRepository) FindByUserID(userID string) ([]*model.Webhook, error) {
+	if r.size <= 0 || r.ttl <= 0 {
+		return r.WebhookRepository.FindByUserID(userID)
+	}
+	now := r.now()
+	r.mu.Lock()
+	e, ok := r.entries[userID]
+	r.mu.Unlock()
+	if ok && now.Before(e.expiresAt) {
+		return copyWebhooks(e.ws), nil
+	}
+
+	ws, err := r.WebhookRepository.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.entries[userID]; !ok && len(r.entries) >= r.size {
+		r.evict(now)
+	}
+	r.entries[userID] = webhookEntry{ws: copyWebhooks(ws), expiresAt: now.Add(r.ttl)}
+	return ws, nil
+}
+
+// evict deletes expired entries, and a tenth of the entries if it is still full
+func (r *WebhookRepository) evict(now time.Time) {
+	for k, v := range r.entries {
+		if !now.Before(v.expiresAt) {
+			delete(r.entries, k)
+		}
+	}
+	for k := range r.entries {
+		if len(r.entries) < r.size-r.size/10 {
+			break
+		}
+		delete(r.entries, k)
+	}
+}
+
+func copyWebhooks(ws []*model.Webhook) []*model.Webhook {
+	res := make([]*model.Webhook, len(ws))
+	for i, w := range ws {
+		c := *w
+		c.EventTypes = append([]string(nil), w.EventTypes...)
+		res[i] = &c
+	}
+	return res
+}
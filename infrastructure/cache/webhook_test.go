@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestWebhookRepository_FindByUserID(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	ws := map[string][]*model.Webhook{
+		"user1": {{ID: "w1", UserID: "user1", URL: "https://example.com/hook", EventTypes: []string{model.WebhookEventAnonyURLClicked}}},
+	}
+	calls := map[string]int{}
+	repo := testutils.WebhookRepoMock{
+		FakeFindByUserID: func(userID string) ([]*model.Webhook, error) {
+			calls[userID]++
+			if userID == "broken" {
+				return nil, errors.New("error")
+			}
+			return copyWebhooks(ws[userID]), nil
+		},
+	}
+	r := NewWebhookRepository(repo, 10, time.Minute)
+	r.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		got, err := r.FindByUserID("user1")
+		if err != nil || len(got) != 1 || got[0].ID != "w1" {
+			t.Fatalf("WebhookRepository.FindByUserID() = %+v, %v", got, err)
+		}
+		// 呼び出し元の変更はキャッシュに影響しない
+		got[0].EventTypes[0] = "changed"
+	}
+	if got, _ := r.FindByUserID("user1"); got[0].EventTypes[0] != model.WebhookEventAnonyURLClicked {
+		t.Errorf("WebhookRepository.FindByUserID() EventTypes = %v, want the cached value", got[0].EventTypes)
+	}
+	// Webhookのないユーザーもキャッシュする
+	for i := 0; i < 2; i++ {
+		if got, err := r.FindByUserID("user2"); err != nil || len(got) != 0 {
+			t.Errorf("WebhookRepository.FindByUserID() = %+v, %v, want empty", got, err)
+		}
+	}
+	// エラーはキャッシュしない
+	for i := 0; i < 2; i++ {
+		if _, err := r.FindByUserID("broken"); err == nil {
+			t.Errorf("WebhookRepository.FindByUserID() error = nil, want error")
+		}
+	}
+	if calls["user1"] != 1 || calls["user2"] != 1 || calls["broken"] != 2 {
+		t.Errorf("repository.FindByUserID() calls = %v", calls)
+	}
+
+	// 有効期限が切れたら取得し直す
+	ws["user1"] = nil
+	now = now.Add(time.Minute)
+	if got, _ := r.FindByUserID("user1"); len(got) != 0 || calls["user1"] != 2 {
+		t.Errorf("WebhookRepository.FindByUserID() = %+v, calls = %d, want the new value", got, calls["user1"])
+	}
+}
+
+func TestWebhookRepository_Evict(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	repo := testutils.WebhookRepoMock{
+		FakeFindByUserID: func(userID string) ([]*model.Webhook, error) { return nil, nil },
+	}
+	r := NewWebhookRepository(repo, 20, time.Minute)
+	r.now = func() time.Time { return now }
+	for i := 0; i < 100; i++ {
+		if _, err := r.FindByUserID(fmt.Sprintf("user%d", i)); err != nil {
+			t.Fatal(err)
+		}
+		if len(r.entries) > 20 {
+			t.Fatalf("len(entries) = %d, want at most 20", len(r.entries))
+		}
+	}
+}
+
+func TestWebhookRepository_Disabled(t *testing.T) {
+	calls := 0
+	repo := testutils.WebhookRepoMock{
+		FakeFindByUserID: func(userID string) ([]*model.Webhook, error) {
+			calls++
+			return nil, nil
+		},
+	}
+	r := NewWebhookRepository(repo, 0, time.Minute)
+	for i := 0; i < 2; i++ {
+		if _, err := r.FindByUserID("user1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 || len(r.entries) != 0 {
+		t.Errorf("repository.FindByUserID() calls = %d, len(entries) = %d, want 2 and 0", calls, len(r.entries))
+	}
+}
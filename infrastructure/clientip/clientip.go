@@ -0,0 +1,24 @@
+package clientip
+
+import (
+	"net"
+	"strings"
+)
+
+// FromForwardedFor returns the client IP address in the X-Forwarded-For values, or nil if it is not found
+// trustedHopsはサーバーの前にある信頼できるプロキシの数. 各プロキシは受け取った接続元を末尾に追加するため,
+// 末尾からtrustedHops番目がクライアントのIPアドレスになる. それより前の値はクライアントが偽装できるので使わない
+func FromForwardedFor(values []string, trustedHops int) net.IP {
+	if trustedHops <= 0 {
+		return nil
+	}
+	// 複数のヘッダは順に連結したものとして扱う
+	entries := []string{}
+	for _, v := range values {
+		entries = append(entries, strings.Split(v, ",")...)
+	}
+	if len(entries) < trustedHops {
+		return nil
+	}
+	return net.ParseIP(strings.TrimSpace(entries[len(entries)-trustedHops]))
+}
@@ -0,0 +1,32 @@
+package clientip
+
+import (
+	"net"
+	"testing"
+)
+
+func TestFromForwardedFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []string
+		trustedHops int
+		want        net.IP
+	}{
+		{name: "NORMAL: プロキシが1つの場合は末尾を使う", values: []string{"198.51.100.1"}, trustedHops: 1, want: net.ParseIP("198.51.100.1")},
+		{name: "NORMAL: クライアントが先頭を偽装しても末尾を使う", values: []string{"203.0.113.9, 198.51.100.1"}, trustedHops: 1, want: net.ParseIP("198.51.100.1")},
+		{name: "NORMAL: 信頼できるプロキシの分だけ末尾から飛ばす", values: []string{"203.0.113.9, 198.51.100.1, 10.0.0.1"}, trustedHops: 2, want: net.ParseIP("198.51.100.1")},
+		{name: "NORMAL: 複数のヘッダは連結して数える", values: []string{"203.0.113.9", "198.51.100.1, 10.0.0.1"}, trustedHops: 2, want: net.ParseIP("198.51.100.1")},
+		{name: "NORMAL: IPv6", values: []string{" 2001:db8::1 "}, trustedHops: 1, want: net.ParseIP("2001:db8::1")},
+		{name: "ERROR: 信頼できるプロキシがない場合は使わない", values: []string{"198.51.100.1"}, trustedHops: 0},
+		{name: "ERROR: プロキシの数より少ない", values: []string{"198.51.100.1"}, trustedHops: 2},
+		{name: "ERROR: ヘッダがない", trustedHops: 1},
+		{name: "ERROR: 不正な値", values: []string{"198.51.100.1, unknown"}, trustedHops: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromForwardedFor(tt.values, tt.trustedHops); !got.Equal(tt.want) {
+				t.Errorf("FromForwardedFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
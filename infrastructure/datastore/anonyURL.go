@@ -3,7 +3,9 @@ package datastore
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"log"
+	"math"
 	"time"
 
 	"github.com/Tatsuemon/anony/domain/model"
@@ -12,30 +14,123 @@ import (
 	"github.com/pkg/errors"
 )
 
+// カラムが増えた場合はanonyURLReadEntityと合わせてここに追加する
+const selectAnonyURLQuery = "SELECT id, original, short, domain_id, status, redirect_mode, interstitial, query_mode, forward_path, utm_source, utm_medium, utm_campaign, utm_term, utm_content, clicks, active_from, active_until, fallback, blocked_reason, last_status_code, last_latency_ms, last_checked_at, preview_title, preview_description, preview_image, preview_favicon, preview_fetched_at, title, COALESCE(notes, '') AS notes, metadata, user_id, created_at, updated_at FROM urls"
+
+// リンクはoriginalとUTMの組でユーザー内で一意. originalはハッシュのインデックスで絞り込む
+const whereOriginalUTMInUser = " WHERE original_hash = MD5(?) AND original = ? AND utm_source = ? AND utm_medium = ? AND utm_campaign = ? AND utm_term = ? AND utm_content = ? AND user_id = ?"
+
 type anonyURLRepository struct {
 	conn *sqlx.DB
 }
 
 // READで受け取るときに使用
 type anonyURLReadEntity struct {
-	ID        string    `json:"id" db:"id"`
-	Original  string    `json:"original" db:"original"`
-	Short     string    `json:"short" db:"short"`
-	Status    int64     `json:"status" db:"status"`
-	UserID    string    `json:"user_id" db:"user_id"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID                 string     `json:"id" db:"id"`
+	Original           string     `json:"original" db:"original"`
+	Short              string     `json:"short" db:"short"`
+	DomainID           string     `json:"domain_id" db:"domain_id"`
+	Status             int64      `json:"status" db:"status"`
+	RedirectMode       int64      `json:"redirect_mode" db:"redirect_mode"`
+	Interstitial       bool       `json:"interstitial" db:"interstitial"`
+	QueryMode          int64      `json:"query_mode" db:"query_mode"`
+	ForwardPath        bool       `json:"forward_path" db:"forward_path"`
+	UTMSource          string     `json:"utm_source" db:"utm_source"`
+	UTMMedium          string     `json:"utm_medium" db:"utm_medium"`
+	UTMCampaign        string     `json:"utm_campaign" db:"utm_campaign"`
+	UTMTerm            string     `json:"utm_term" db:"utm_term"`
+	UTMContent         string     `json:"utm_content" db:"utm_content"`
+	Clicks             int64      `json:"clicks" db:"clicks"`
+	ActiveFrom         *time.Time `json:"active_from" db:"active_from"`
+	ActiveUntil        *time.Time `json:"active_until" db:"active_until"`
+	Fallback           string     `json:"fallback" db:"fallback"`
+	BlockedReason      string     `json:"blocked_reason" db:"blocked_reason"`
+	LastStatusCode     int64      `json:"last_status_code" db:"last_status_code"`
+	LastLatencyMS      int64      `json:"last_latency_ms" db:"last_latency_ms"`
+	LastCheckedAt      *time.Time `json:"last_checked_at" db:"last_checked_at"`
+	PreviewTitle       string     `json:"preview_title" db:"preview_title"`
+	PreviewDescription string     `json:"preview_description" db:"preview_description"`
+	PreviewImage       string     `json:"preview_image" db:"preview_image"`
+	PreviewFavicon     string     `json:"preview_favicon" db:"preview_favicon"`
+	PreviewFetchedAt   *time.Time `json:"preview_fetched_at" db:"preview_fetched_at"`
+	Title              string     `json:"title" db:"title"`
+	Notes              string     `json:"notes" db:"notes"`
+	Metadata           []byte     `json:"metadata" db:"metadata"`
+	UserID             string     `json:"user_id" db:"user_id"`
+	CreatedAt          time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 func mapAnonyURLReadEntityToAnonyURL(entity anonyURLReadEntity) model.AnonyURL {
+	// preview_fetched_atがNULLの場合は未取得
+	var preview *model.LinkPreview
+	if entity.PreviewFetchedAt != nil {
+		preview = &model.LinkPreview{
+			Title:       entity.PreviewTitle,
+			Description: entity.PreviewDescription,
+			Image:       entity.PreviewImage,
+			Favicon:     entity.PreviewFavicon,
+			FetchedAt:   *entity.PreviewFetchedAt,
+		}
+	}
 	return model.AnonyURL{
-		ID:       entity.ID,
-		Original: entity.Original,
-		Short:    entity.Short,
-		Status:   entity.Status,
+		ID:           entity.ID,
+		Original:     entity.Original,
+		Short:        entity.Short,
+		Status:       entity.Status,
+		DomainID:     entity.DomainID,
+		RedirectMode: entity.RedirectMode,
+		Interstitial: entity.Interstitial,
+		QueryMode:    entity.QueryMode,
+		ForwardPath:  entity.ForwardPath,
+		UTM: model.UTM{
+			Source:   entity.UTMSource,
+			Medium:   entity.UTMMedium,
+			Campaign: entity.UTMCampaign,
+			Term:     entity.UTMTerm,
+			Content:  entity.UTMContent,
+		},
+		Clicks:         entity.Clicks,
+		ActiveFrom:     entity.ActiveFrom,
+		ActiveUntil:    entity.ActiveUntil,
+		Fallback:       entity.Fallback,
+		BlockedReason:  entity.BlockedReason,
+		LastStatusCode: entity.LastStatusCode,
+		LastLatencyMS:  entity.LastLatencyMS,
+		LastCheckedAt:  entity.LastCheckedAt,
+		Preview:        preview,
+		Title:          entity.Title,
+		Notes:          entity.Notes,
+		Metadata:       decodeMetadata(entity.Metadata),
+		CreatedAt:      entity.CreatedAt,
 	}
 }
 
+// decodeMetadata decodes the JSON of the metadata column. NULLの場合は空
+func decodeMetadata(b []byte) map[string]string {
+	m := map[string]string{}
+	if len(b) == 0 {
+		return m
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		log.Printf("failed to decode metadata: %s", err)
+		return map[string]string{}
+	}
+	return m
+}
+
+// encodeMetadata encodes the metadata to JSON. 空の場合はNULLにする
+func encodeMetadata(m map[string]string) (interface{}, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
 // NewAnonyURLRepository creates a repository
 func NewAnonyURLRepository(conn *sqlx.DB) repository.AnonyURLRepository {
 	return &anonyURLRepository{conn: conn}
@@ -43,7 +138,7 @@ func NewAnonyURLRepository(conn *sqlx.DB) repository.AnonyURLRepository {
 
 func (r anonyURLRepository) FindByID(id string) (*model.AnonyURL, error) {
 	ae := anonyURLReadEntity{}
-	if err := r.conn.Get(&ae, "SELECT id, original, short, status, user_id, created_at, updated_at FROM urls WHERE id = ?", id); err != nil {
+	if err := r.conn.Get(&ae, selectAnonyURLQuery+" WHERE id = ?", id); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -56,7 +151,7 @@ func (r anonyURLRepository) FindByID(id string) (*model.AnonyURL, error) {
 
 func (r anonyURLRepository) FindByUserID(userID string) ([]*model.AnonyURL, error) {
 	aes := []anonyURLReadEntity{}
-	if err := r.conn.Select(&aes, "SELECT id, original, short, status, user_id, created_at, updated_at FROM urls WHERE user_id = ?", userID); err != nil {
+	if err := r.conn.Select(&aes, selectAnonyURLQuery+" WHERE user_id = ?", userID); err != nil {
 		return nil, err
 	}
 	res := make([]*model.AnonyURL, len(aes))
@@ -69,7 +164,7 @@ func (r anonyURLRepository) FindByUserID(userID string) ([]*model.AnonyURL, erro
 
 func (r anonyURLRepository) FindByUserIDWithStatus(userID string, status int64) ([]*model.AnonyURL, error) {
 	aes := []anonyURLReadEntity{}
-	if err := r.conn.Select(&aes, "SELECT id, original, short, status, user_id, created_at, updated_at FROM urls WHERE user_id = ? and status = ?", userID, status); err != nil {
+	if err := r.conn.Select(&aes, selectAnonyURLQuery+" WHERE user_id = ? and status = ?", userID, status); err != nil {
 		return nil, err
 	}
 	res := make([]*model.AnonyURL, len(aes))
@@ -80,9 +175,9 @@ func (r anonyURLRepository) FindByUserIDWithStatus(userID string, status int64)
 	return res, nil
 }
 
-func (r anonyURLRepository) FindByOriginalInUser(original string, userID string) (*model.AnonyURL, error) {
+func (r anonyURLRepository) FindByOriginalInUser(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
 	ae := anonyURLReadEntity{}
-	if err := r.conn.Get(&ae, "SELECT id, original, short, status, user_id, created_at, updated_at FROM urls WHERE original = ? AND user_id = ?", original, userID); err != nil {
+	if err := r.conn.Get(&ae, selectAnonyURLQuery+whereOriginalUTMInUser, original, original, utm.Source, utm.Medium, utm.Campaign, utm.Term, utm.Content, userID); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -92,9 +187,10 @@ func (r anonyURLRepository) FindByOriginalInUser(original string, userID string)
 	res := mapAnonyURLReadEntityToAnonyURL(ae)
 	return &res, nil
 }
-func (r anonyURLRepository) FindByAnonyURL(anonyURL string) (*model.AnonyURL, error) {
+
+func (r anonyURLRepository) FindByAnonyURL(domainID, anonyURL string) (*model.AnonyURL, error) {
 	ae := anonyURLReadEntity{}
-	if err := r.conn.Get(&ae, "SELECT id, original, short, status, user_id, created_at, updated_at FROM urls WHERE short = ?", anonyURL); err != nil {
+	if err := r.conn.Get(&ae, selectAnonyURLQuery+" WHERE domain_id = ? AND short = ?", domainID, anonyURL); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -104,9 +200,66 @@ func (r anonyURLRepository) FindByAnonyURL(anonyURL string) (*model.AnonyURL, er
 	return &res, nil
 }
 
-func (r anonyURLRepository) GetIDByOriginalUser(original, userID string) (string, error) {
+func (r anonyURLRepository) FindByScheduleBoundary(from, to time.Time) ([]*model.AnonyURL, error) {
+	aes := []anonyURLReadEntity{}
+	query := selectAnonyURLQuery + " WHERE status = 1 AND ((active_from > ? AND active_from <= ?) OR (active_until > ? AND active_until <= ?))"
+	if err := r.conn.Select(&aes, query, from, to, from, to); err != nil {
+		return nil, err
+	}
+	res := make([]*model.AnonyURL, len(aes))
+	for i, v := range aes {
+		tmp := mapAnonyURLReadEntityToAnonyURL(v)
+		res[i] = &tmp
+	}
+	return res, nil
+}
+
+func (r anonyURLRepository) FindPage(afterID string, limit int) ([]*model.AnonyURL, error) {
+	aes := []anonyURLReadEntity{}
+	if err := r.conn.Select(&aes, selectAnonyURLQuery+" WHERE id > ? ORDER BY id LIMIT ?", afterID, limit); err != nil {
+		return nil, err
+	}
+	res := make([]*model.AnonyURL, len(aes))
+	for i, v := range aes {
+		tmp := mapAnonyURLReadEntityToAnonyURL(v)
+		res[i] = &tmp
+	}
+	return res, nil
+}
+
+// 各プロセスの時計がずれていても取得できるように, データベースの現在時刻から遡る
+func (r anonyURLRepository) FindUpdatedWithin(d time.Duration) ([]*model.AnonyURL, error) {
+	aes := []anonyURLReadEntity{}
+	seconds := int64(math.Ceil(d.Seconds()))
+	if err := r.conn.Select(&aes, selectAnonyURLQuery+" WHERE updated_at >= NOW() - INTERVAL ? SECOND", seconds); err != nil {
+		return nil, err
+	}
+	res := make([]*model.AnonyURL, len(aes))
+	for i, v := range aes {
+		tmp := mapAnonyURLReadEntityToAnonyURL(v)
+		res[i] = &tmp
+	}
+	return res, nil
+}
+
+// 壊れたリンクの判定はmodel.LinkHealth.IsBrokenと合わせる
+func (r anonyURLRepository) FindBrokenByUserID(userID string) ([]*model.AnonyURL, error) {
+	aes := []anonyURLReadEntity{}
+	query := selectAnonyURLQuery + " WHERE user_id = ? AND last_checked_at IS NOT NULL AND (last_status_code = 0 OR last_status_code >= 400) ORDER BY last_checked_at DESC"
+	if err := r.conn.Select(&aes, query, userID); err != nil {
+		return nil, err
+	}
+	res := make([]*model.AnonyURL, len(aes))
+	for i, v := range aes {
+		tmp := mapAnonyURLReadEntityToAnonyURL(v)
+		res[i] = &tmp
+	}
+	return res, nil
+}
+
+func (r anonyURLRepository) GetIDByOriginalUser(original string, utm model.UTM, userID string) (string, error) {
 	var id string
-	if err := r.conn.Get(&id, "SELECT id FROM urls WHERE original = ? AND user_id = ?", original, userID); err != nil {
+	if err := r.conn.Get(&id, "SELECT id FROM urls"+whereOriginalUTMInUser, original, original, utm.Source, utm.Medium, utm.Campaign, utm.Term, utm.Content, userID); err != nil {
 		if err == sql.ErrNoRows {
 			return "", nil
 		}
@@ -115,6 +268,17 @@ func (r anonyURLRepository) GetIDByOriginalUser(original, userID string) (string
 	return id, nil
 }
 
+func (r anonyURLRepository) GetUserIDByID(id string) (string, error) {
+	var userID string
+	if err := r.conn.Get(&userID, "SELECT user_id FROM urls WHERE id = ?", id); err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", err
+	}
+	return userID, nil
+}
+
 func (r anonyURLRepository) Save(ctx context.Context, an *model.AnonyURL, userID string) error {
 	// *sqlx.Tx, *sqlx.DBの両方で使用できるようにinterfaceの指定
 	var tx interface {
@@ -127,7 +291,12 @@ func (r anonyURLRepository) Save(ctx context.Context, an *model.AnonyURL, userID
 		tx = r.conn // context.Contextに存在しない場合は, repositoryの*sqlx.DBを使用
 	}
 
-	stmt, err := tx.Prepare("INSERT INTO `urls` (id, original, short, status, user_id) VALUES(?, ?, ?, ?, ?)")
+	metadata, err := encodeMetadata(an.Metadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.Save()")
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO `urls` (id, original, short, domain_id, status, redirect_mode, interstitial, query_mode, forward_path, utm_source, utm_medium, utm_campaign, utm_term, utm_content, active_from, active_until, fallback, title, notes, metadata, user_id) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 
 	if err != nil {
 		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.Save()")
@@ -140,7 +309,7 @@ func (r anonyURLRepository) Save(ctx context.Context, an *model.AnonyURL, userID
 		}
 	}()
 
-	_, err = stmt.Exec(an.ID, an.Original, an.Short, an.Status, userID)
+	_, err = stmt.Exec(an.ID, an.Original, an.Short, an.DomainID, an.Status, an.GetRedirectMode(), an.Interstitial, an.QueryMode, an.ForwardPath, an.UTM.Source, an.UTM.Medium, an.UTM.Campaign, an.UTM.Term, an.UTM.Content, an.ActiveFrom, an.ActiveUntil, an.Fallback, an.Title, an.Notes, metadata, userID)
 	if err != nil {
 		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.Save()")
 	}
@@ -180,3 +349,173 @@ func (r anonyURLRepository) UpdateStatus(ctx context.Context, id string, status
 	}
 	return nil
 }
+
+func (r anonyURLRepository) IncrementClicks(ctx context.Context, id string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	// updated_atはリンクの設定の更新日時なので, クリック数の更新では変更しない
+	stmt, err := tx.Prepare("UPDATE `urls` SET clicks = clicks + 1, updated_at = updated_at WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.IncrementClicks()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.IncrementClicks()")
+	}
+	return nil
+}
+
+func (r anonyURLRepository) UpdateSchedule(ctx context.Context, id string, activeFrom, activeUntil *time.Time, fallback string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("UPDATE `urls` SET active_from = ?, active_until = ?, fallback = ? WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateSchedule()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(activeFrom, activeUntil, fallback, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateSchedule()")
+	}
+	return nil
+}
+
+func (r anonyURLRepository) UpdateBlockedReason(ctx context.Context, id string, reason string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("UPDATE `urls` SET blocked_reason = ? WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateBlockedReason()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(reason, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateBlockedReason()")
+	}
+	return nil
+}
+
+func (r anonyURLRepository) UpdateHealth(ctx context.Context, id string, h *model.LinkHealth) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	// updated_atはリンクの設定の更新日時なので, 死活確認の結果の更新では変更しない
+	stmt, err := tx.Prepare("UPDATE `urls` SET last_status_code = ?, last_latency_ms = ?, last_checked_at = ?, updated_at = updated_at WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateHealth()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(h.StatusCode, h.Latency.Milliseconds(), h.CheckedAt, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateHealth()")
+	}
+	return nil
+}
+
+func (r anonyURLRepository) UpdatePreview(ctx context.Context, id string, p *model.LinkPreview) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	// updated_atはリンクの設定の更新日時なので, プレビューの更新では変更しない
+	stmt, err := tx.Prepare("UPDATE `urls` SET preview_title = ?, preview_description = ?, preview_image = ?, preview_favicon = ?, preview_fetched_at = ?, updated_at = updated_at WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdatePreview()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(p.Title, p.Description, p.Image, p.Favicon, p.FetchedAt, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdatePreview()")
+	}
+	return nil
+}
+
+func (r anonyURLRepository) UpdateAnnotation(ctx context.Context, id string, title, notes string, metadata map[string]string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	m, err := encodeMetadata(metadata)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateAnnotation()")
+	}
+
+	stmt, err := tx.Prepare("UPDATE `urls` SET title = ?, notes = ?, metadata = ? WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateAnnotation()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(title, notes, m, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.AnonyURLRepository.UpdateAnnotation()")
+	}
+	return nil
+}
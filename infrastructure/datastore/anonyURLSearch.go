@@ -0,0 +1,82 @@
+package datastore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Tatsuemon/anony/usecase/dto"
+	"github.com/Tatsuemon/anony/usecase/queryservice"
+	"github.com/jmoiron/sqlx"
+)
+
+type anonyURLSearcher struct {
+	conn *sqlx.DB
+}
+
+// NewAnonyURLSearcher creates a searcher using FULLTEXT indexes
+func NewAnonyURLSearcher(conn *sqlx.DB) queryservice.AnonyURLSearcher {
+	return &anonyURLSearcher{conn: conn}
+}
+
+// 項目ごとのMATCHの関連度に重みを掛けて合計する. タグは付いている全てのタグの関連度の合計
+var searchScoreExpr = fmt.Sprintf(`(%g * MATCH(short) AGAINST(? IN BOOLEAN MODE)
+	+ %g * (MATCH(title) AGAINST(? IN BOOLEAN MODE) + MATCH(preview_title) AGAINST(? IN BOOLEAN MODE))
+	+ %g * MATCH(original) AGAINST(? IN BOOLEAN MODE)
+	+ %g * MATCH(notes) AGAINST(? IN BOOLEAN MODE)
+	+ %g * COALESCE((SELECT SUM(MATCH(tags.name) AGAINST(? IN BOOLEAN MODE)) FROM url_tags INNER JOIN tags ON tags.id = url_tags.tag_id WHERE url_tags.url_id = urls.id), 0))`,
+	queryservice.SearchWeightShort, queryservice.SearchWeightTitle, queryservice.SearchWeightOriginal, queryservice.SearchWeightNotes, queryservice.SearchWeightTag)
+
+// 1つの語がいずれかの項目に含まれる
+const searchTermCondition = ` AND (MATCH(short) AGAINST(? IN BOOLEAN MODE)
+	OR MATCH(title) AGAINST(? IN BOOLEAN MODE)
+	OR MATCH(preview_title) AGAINST(? IN BOOLEAN MODE)
+	OR MATCH(original) AGAINST(? IN BOOLEAN MODE)
+	OR MATCH(notes) AGAINST(? IN BOOLEAN MODE)
+	OR EXISTS (SELECT 1 FROM url_tags INNER JOIN tags ON tags.id = url_tags.tag_id WHERE url_tags.url_id = urls.id AND MATCH(tags.name) AGAINST(? IN BOOLEAN MODE)))`
+
+type anonyURLSearchEntity struct {
+	anonyURLReadEntity
+	Score float64 `db:"score"`
+}
+
+func (s anonyURLSearcher) SearchAnonyURLs(userID string, q dto.AnonyURLSearchQuery) ([]*dto.AnonyURLSearchResult, error) {
+	phrases := make([]string, 0, len(q.Terms))
+	for _, t := range q.Terms {
+		if p := booleanModePhrase(t); p != "" {
+			phrases = append(phrases, p)
+		}
+	}
+	if len(phrases) == 0 {
+		return []*dto.AnonyURLSearchResult{}, nil
+	}
+
+	all := strings.Join(phrases, " ")
+	query := strings.Replace(selectAnonyURLQuery, " FROM urls", ", "+searchScoreExpr+" AS score FROM urls", 1) + " WHERE user_id = ?"
+	args := []interface{}{all, all, all, all, all, all, userID}
+	for _, p := range phrases {
+		query += searchTermCondition
+		args = append(args, p, p, p, p, p, p)
+	}
+	query += " ORDER BY score DESC, created_at DESC, id LIMIT ? OFFSET ?"
+	args = append(args, q.Limit, q.Offset)
+
+	es := []anonyURLSearchEntity{}
+	if err := s.conn.Select(&es, query, args...); err != nil {
+		return nil, err
+	}
+	res := make([]*dto.AnonyURLSearchResult, len(es))
+	for i, v := range es {
+		an := mapAnonyURLReadEntityToAnonyURL(v.anonyURLReadEntity)
+		res[i] = &dto.AnonyURLSearchResult{AnonyURL: &an, Score: v.Score}
+	}
+	return res, nil
+}
+
+// booleanModePhrase quotes the term as a phrase so that operators in it are not interpreted
+func booleanModePhrase(term string) string {
+	term = strings.TrimSpace(strings.ReplaceAll(term, `"`, ""))
+	if term == "" {
+		return ""
+	}
+	return `"` + term + `"`
+}
@@ -0,0 +1,135 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const selectAuditEventQuery = "SELECT id, actor_id, action, target_type, target_id, before_value, after_value, request_id, client_ip, created_at FROM audit_events"
+
+type auditEventRepository struct {
+	conn *sqlx.DB
+}
+
+// before_value, after_valueのNULLはjson.RawMessageに読み込めないため, []byteで受け取る
+type auditEventEntity struct {
+	ID         string    `db:"id"`
+	ActorID    string    `db:"actor_id"`
+	Action     string    `db:"action"`
+	TargetType string    `db:"target_type"`
+	TargetID   string    `db:"target_id"`
+	Before     []byte    `db:"before_value"`
+	After      []byte    `db:"after_value"`
+	RequestID  string    `db:"request_id"`
+	ClientIP   string    `db:"client_ip"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func mapAuditEventEntityToAuditEvent(e auditEventEntity) *model.AuditEvent {
+	a := &model.AuditEvent{
+		ID:         e.ID,
+		ActorID:    e.ActorID,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		RequestID:  e.RequestID,
+		ClientIP:   e.ClientIP,
+		CreatedAt:  e.CreatedAt,
+	}
+	if len(e.Before) > 0 {
+		a.Before = e.Before
+	}
+	if len(e.After) > 0 {
+		a.After = e.After
+	}
+	return a
+}
+
+// NewAuditEventRepository create a repository of audit event.
+func NewAuditEventRepository(conn *sqlx.DB) repository.AuditEventRepository {
+	return &auditEventRepository{conn: conn}
+}
+
+func (r auditEventRepository) Save(ctx context.Context, e *model.AuditEvent) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO `audit_events` (id, actor_id, action, target_type, target_id, before_value, after_value, request_id, client_ip, created_at) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.auditEventRepository.Save()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	if _, err = stmt.Exec(e.ID, e.ActorID, e.Action, e.TargetType, e.TargetID, nullableJSON(e.Before), nullableJSON(e.After), e.RequestID, e.ClientIP, e.CreatedAt); err != nil {
+		return errors.Wrap(err, "failed to datastore.auditEventRepository.Save()")
+	}
+	return nil
+}
+
+func (r auditEventRepository) Find(f model.AuditEventFilter) ([]*model.AuditEvent, error) {
+	conds := []string{}
+	args := []interface{}{}
+	for _, v := range []struct {
+		column string
+		value  string
+	}{
+		{"actor_id", f.ActorID},
+		{"action", f.Action},
+		{"target_type", f.TargetType},
+		{"target_id", f.TargetID},
+	} {
+		if v.value != "" {
+			conds = append(conds, v.column+" = ?")
+			args = append(args, v.value)
+		}
+	}
+	if f.Since != nil {
+		conds = append(conds, "created_at >= ?")
+		args = append(args, *f.Since)
+	}
+	if f.Until != nil {
+		conds = append(conds, "created_at < ?")
+		args = append(args, *f.Until)
+	}
+	query := selectAuditEventQuery
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	query += " ORDER BY created_at DESC, id DESC LIMIT ? OFFSET ?"
+	args = append(args, f.Limit, f.Offset)
+
+	es := []auditEventEntity{}
+	if err := r.conn.Select(&es, query, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to datastore.auditEventRepository.Find()")
+	}
+	res := make([]*model.AuditEvent, len(es))
+	for i, e := range es {
+		res[i] = mapAuditEventEntityToAuditEvent(e)
+	}
+	return res, nil
+}
+
+// nullableJSON returns nil for the empty JSON to store NULL
+func nullableJSON(b []byte) interface{} {
+	if len(b) == 0 {
+		return nil
+	}
+	return string(b)
+}
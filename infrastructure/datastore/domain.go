@@ -0,0 +1,112 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type domainRepository struct {
+	conn *sqlx.DB
+}
+
+// NewDomainRepository create a repository of domain.
+func NewDomainRepository(conn *sqlx.DB) repository.DomainRepository {
+	return &domainRepository{conn: conn}
+}
+
+func (r domainRepository) FindByID(id string) (*model.Domain, error) {
+	d := model.Domain{}
+	if err := r.conn.Get(&d, "SELECT id, name, user_id, verify_token, verified FROM domains WHERE id = ?", id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r domainRepository) FindByName(name string) (*model.Domain, error) {
+	d := model.Domain{}
+	if err := r.conn.Get(&d, "SELECT id, name, user_id, verify_token, verified FROM domains WHERE name = ?", name); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r domainRepository) FindByUserID(userID string) ([]*model.Domain, error) {
+	ds := make([]*model.Domain, 0)
+	if err := r.conn.Select(&ds, "SELECT id, name, user_id, verify_token, verified FROM domains WHERE user_id = ? ORDER BY name", userID); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+func (r domainRepository) FindVerified() ([]*model.Domain, error) {
+	ds := make([]*model.Domain, 0)
+	if err := r.conn.Select(&ds, "SELECT id, name, user_id, verify_token, verified FROM domains WHERE verified = 1"); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+func (r domainRepository) Save(ctx context.Context, d *model.Domain) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO `domains` (id, name, user_id, verify_token, verified) VALUES(?, ?, ?, ?, ?)")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.domainRepository.Save()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(d.ID, d.Name, d.UserID, d.VerifyToken, d.Verified)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.domainRepository.Save()")
+	}
+	return nil
+}
+
+func (r domainRepository) UpdateVerified(ctx context.Context, id string, verified bool) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("UPDATE `domains` SET verified = ? WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.domainRepository.UpdateVerified()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(verified, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.domainRepository.UpdateVerified()")
+	}
+	return nil
+}
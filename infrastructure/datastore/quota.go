@@ -0,0 +1,76 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type quotaRepository struct {
+	conn *sqlx.DB
+}
+
+// NewQuotaRepository create a repository of quota.
+func NewQuotaRepository(conn *sqlx.DB) repository.QuotaRepository {
+	return &quotaRepository{conn: conn}
+}
+
+func (r quotaRepository) FindByUserID(userID string) (*model.UserQuota, error) {
+	q := model.UserQuota{}
+	if err := r.conn.Get(&q, "SELECT user_id, max_anony_urls, max_active_anony_urls, max_creations_per_day FROM user_quotas WHERE user_id = ?", userID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to datastore.quotaRepository.FindByUserID()")
+	}
+	return &q, nil
+}
+
+func (r quotaRepository) LockUser(ctx context.Context, userID string) error {
+	var tx interface {
+		Get(dest interface{}, query string, args ...interface{}) error
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	// 同じユーザーの作成を直列にし, 数えてから保存するまでに他の作成が割り込まないようにする
+	var id string
+	if err := tx.Get(&id, "SELECT id FROM users WHERE id = ? FOR UPDATE", userID); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New("user is not found")
+		}
+		return errors.Wrap(err, "failed to datastore.quotaRepository.LockUser()")
+	}
+	return nil
+}
+
+func (r quotaRepository) CountUsage(ctx context.Context, userID string) (*model.QuotaUsage, error) {
+	var tx interface {
+		Get(dest interface{}, query string, args ...interface{}) error
+	}
+
+	// 作成中の同じトランザクションで保存したAnonyURLも数える
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	u := model.QuotaUsage{}
+	// created_atはDBの時刻で保存しているため, DBの時刻で24時間前と比べる
+	query := `SELECT
+		COUNT(*) AS anony_urls,
+		COALESCE(SUM(status = 1), 0) AS active_anony_urls,
+		COALESCE(SUM(created_at >= NOW() - INTERVAL 1 DAY), 0) AS creations_last_day
+		FROM urls WHERE user_id = ?`
+	if err := tx.Get(&u, query, userID); err != nil {
+		return nil, errors.Wrap(err, "failed to datastore.quotaRepository.CountUsage()")
+	}
+	return &u, nil
+}
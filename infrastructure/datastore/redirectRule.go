@@ -0,0 +1,69 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type redirectRuleRepository struct {
+	conn *sqlx.DB
+}
+
+// NewRedirectRuleRepository create a repository of redirect rule.
+func NewRedirectRuleRepository(conn *sqlx.DB) repository.RedirectRuleRepository {
+	return &redirectRuleRepository{conn: conn}
+}
+
+func (r redirectRuleRepository) FindByAnonyURLID(anonyURLID string) ([]*model.RedirectRule, error) {
+	rules := make([]*model.RedirectRule, 0)
+	if err := r.conn.Select(&rules, "SELECT id, url_id, priority, platform, language, country, active_from, active_until, destination FROM redirect_rules WHERE url_id = ? ORDER BY priority", anonyURLID); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r redirectRuleRepository) ReplaceByAnonyURLID(ctx context.Context, anonyURLID string, rules []*model.RedirectRule) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	del, err := tx.Prepare("DELETE FROM `redirect_rules` WHERE url_id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.redirectRuleRepository.ReplaceByAnonyURLID()")
+	}
+	defer func() {
+		if closeErr := del.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	if _, err = del.Exec(anonyURLID); err != nil {
+		return errors.Wrap(err, "failed to datastore.redirectRuleRepository.ReplaceByAnonyURLID()")
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO `redirect_rules` (id, url_id, priority, platform, language, country, active_from, active_until, destination) VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.redirectRuleRepository.ReplaceByAnonyURLID()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	for _, v := range rules {
+		_, err = stmt.Exec(v.ID, anonyURLID, v.Priority, v.Platform, v.Language, v.Country, v.ActiveFrom, v.ActiveUntil, v.Destination)
+		if err != nil {
+			return errors.Wrap(err, "failed to datastore.redirectRuleRepository.ReplaceByAnonyURLID()")
+		}
+	}
+	return nil
+}
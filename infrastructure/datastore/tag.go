@@ -0,0 +1,184 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+// parent_idはNULLで最上位を表すので, 空文字に変換して受け取る
+const selectTagQuery = "SELECT id, user_id, name, COALESCE(parent_id, '') AS parent_id FROM tags"
+
+type tagRepository struct {
+	conn *sqlx.DB
+}
+
+// NewTagRepository create a repository of tag.
+func NewTagRepository(conn *sqlx.DB) repository.TagRepository {
+	return &tagRepository{conn: conn}
+}
+
+func (r tagRepository) FindByID(id string) (*model.Tag, error) {
+	t := model.Tag{}
+	if err := r.conn.Get(&t, selectTagQuery+" WHERE id = ?", id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r tagRepository) FindByUserID(userID string) (model.Tags, error) {
+	ts := make(model.Tags, 0)
+	if err := r.conn.Select(&ts, selectTagQuery+" WHERE user_id = ? ORDER BY name", userID); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+func (r tagRepository) Save(ctx context.Context, t *model.Tag) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO `tags` (id, user_id, name, parent_id) VALUES(?, ?, ?, NULLIF(?, ''))")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.Save()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(t.ID, t.UserID, t.Name, t.ParentID)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.Save()")
+	}
+	return nil
+}
+
+func (r tagRepository) UpdateName(ctx context.Context, id string, name string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("UPDATE `tags` SET name = ? WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.UpdateName()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(name, id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.UpdateName()")
+	}
+	return nil
+}
+
+// 子孫のタグとurl_tagsはON DELETE CASCADEで削除される
+func (r tagRepository) Delete(ctx context.Context, id string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("DELETE FROM `tags` WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.Delete()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.Delete()")
+	}
+	return nil
+}
+
+func (r tagRepository) AddAnonyURLs(ctx context.Context, tagIDs []string, anonyURLIDs []string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("INSERT IGNORE INTO `url_tags` (url_id, tag_id) VALUES(?, ?)")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.AddAnonyURLs()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	for _, anonyURLID := range anonyURLIDs {
+		for _, tagID := range tagIDs {
+			if _, err = stmt.Exec(anonyURLID, tagID); err != nil {
+				return errors.Wrap(err, "failed to datastore.tagRepository.AddAnonyURLs()")
+			}
+		}
+	}
+	return nil
+}
+
+func (r tagRepository) RemoveAnonyURLs(ctx context.Context, tagIDs []string, anonyURLIDs []string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("DELETE FROM `url_tags` WHERE url_id = ? AND tag_id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.tagRepository.RemoveAnonyURLs()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	for _, anonyURLID := range anonyURLIDs {
+		for _, tagID := range tagIDs {
+			if _, err = stmt.Exec(anonyURLID, tagID); err != nil {
+				return errors.Wrap(err, "failed to datastore.tagRepository.RemoveAnonyURLs()")
+			}
+		}
+	}
+	return nil
+}
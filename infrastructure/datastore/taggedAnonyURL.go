@@ -0,0 +1,104 @@
+package datastore
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/usecase/dto"
+	"github.com/Tatsuemon/anony/usecase/queryservice"
+	"github.com/jmoiron/sqlx"
+)
+
+type taggedAnonyURLAccessor struct {
+	conn *sqlx.DB
+}
+
+// NewTaggedAnonyURLAccessor create a accessor
+func NewTaggedAnonyURLAccessor(conn *sqlx.DB) queryservice.TaggedAnonyURLAccessor {
+	return &taggedAnonyURLAccessor{conn: conn}
+}
+
+func (a taggedAnonyURLAccessor) FindAnonyURLsByTags(userID string, status int64, tagGroups [][]string) ([]*model.AnonyURL, error) {
+	query := selectAnonyURLQuery + " WHERE user_id = ?"
+	args := []interface{}{userID}
+	if status != 0 {
+		query += " AND status = ?"
+		args = append(args, status)
+	}
+	cond, condArgs := tagGroupsCondition(tagGroups)
+	query += cond
+	args = append(args, condArgs...)
+
+	aes := []anonyURLReadEntity{}
+	if err := a.conn.Select(&aes, query, args...); err != nil {
+		return nil, err
+	}
+	res := make([]*model.AnonyURL, len(aes))
+	for i, v := range aes {
+		tmp := mapAnonyURLReadEntityToAnonyURL(v)
+		res[i] = &tmp
+	}
+	return res, nil
+}
+
+func (a taggedAnonyURLAccessor) CountAnonyURLByUserInTags(userID string, tagGroups [][]string) (*dto.AnonyURLCountByUser, error) {
+	// 該当するリンクが無い場合も0件として返すため, LEFT JOINの結合条件で絞り込む
+	cond, args := tagGroupsCondition(tagGroups)
+	q := `
+	SELECT name, email, COUNT(urls.id) AS count_urls, COUNT(urls.status=1 or null) AS count_active_urls
+	FROM users
+	LEFT JOIN urls ON users.id = urls.user_id` + cond + `
+	WHERE users.id = ?
+	GROUP BY (users.id)
+	`
+
+	res := dto.AnonyURLCountByUser{}
+	if err := a.conn.Get(&res, q, append(args, userID)...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (a taggedAnonyURLAccessor) FindTagIDsByUserID(userID string) (map[string][]string, error) {
+	rows := []struct {
+		URLID string `db:"url_id"`
+		TagID string `db:"tag_id"`
+	}{}
+	q := `
+	SELECT url_tags.url_id, url_tags.tag_id
+	FROM url_tags
+	INNER JOIN tags ON tags.id = url_tags.tag_id
+	WHERE tags.user_id = ?
+	ORDER BY url_tags.created_at, url_tags.tag_id
+	`
+	if err := a.conn.Select(&rows, q, userID); err != nil {
+		return nil, err
+	}
+	res := map[string][]string{}
+	for _, v := range rows {
+		res[v.URLID] = append(res[v.URLID], v.TagID)
+	}
+	return res, nil
+}
+
+// tagGroupsCondition returns the condition that urls has at least one tag of every group
+func tagGroupsCondition(tagGroups [][]string) (string, []interface{}) {
+	var b strings.Builder
+	var args []interface{}
+	for _, g := range tagGroups {
+		if len(g) == 0 {
+			continue
+		}
+		b.WriteString(" AND EXISTS (SELECT 1 FROM url_tags WHERE url_tags.url_id = urls.id AND url_tags.tag_id IN (?")
+		b.WriteString(strings.Repeat(", ?", len(g)-1))
+		b.WriteString("))")
+		for _, id := range g {
+			args = append(args, id)
+		}
+	}
+	return b.String(), args
+}
@@ -0,0 +1,96 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type variantRepository struct {
+	conn *sqlx.DB
+}
+
+// NewVariantRepository create a repository of variant.
+func NewVariantRepository(conn *sqlx.DB) repository.VariantRepository {
+	return &variantRepository{conn: conn}
+}
+
+func (r variantRepository) FindByAnonyURLID(anonyURLID string) ([]*model.Variant, error) {
+	vs := make([]*model.Variant, 0)
+	if err := r.conn.Select(&vs, "SELECT id, url_id, destination, weight, clicks FROM variants WHERE url_id = ? ORDER BY position", anonyURLID); err != nil {
+		return nil, err
+	}
+	return vs, nil
+}
+
+func (r variantRepository) ReplaceByAnonyURLID(ctx context.Context, anonyURLID string, variants []*model.Variant) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	del, err := tx.Prepare("DELETE FROM `variants` WHERE url_id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.variantRepository.ReplaceByAnonyURLID()")
+	}
+	defer func() {
+		if closeErr := del.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	if _, err = del.Exec(anonyURLID); err != nil {
+		return errors.Wrap(err, "failed to datastore.variantRepository.ReplaceByAnonyURLID()")
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO `variants` (id, url_id, position, destination, weight) VALUES(?, ?, ?, ?, ?)")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.variantRepository.ReplaceByAnonyURLID()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+	for i, v := range variants {
+		_, err = stmt.Exec(v.ID, anonyURLID, i, v.Destination, v.Weight)
+		if err != nil {
+			return errors.Wrap(err, "failed to datastore.variantRepository.ReplaceByAnonyURLID()")
+		}
+	}
+	return nil
+}
+
+func (r variantRepository) IncrementClicks(ctx context.Context, id string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("UPDATE `variants` SET clicks = clicks + 1 WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.variantRepository.IncrementClicks()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.variantRepository.IncrementClicks()")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const selectWebhookQuery = "SELECT id, user_id, url, secret, event_types, created_at FROM webhooks"
+
+// event_typesはカンマ区切りで保存する
+const webhookEventTypesSeparator = ","
+
+type webhookRepository struct {
+	conn *sqlx.DB
+}
+
+type webhookEntity struct {
+	ID         string    `db:"id"`
+	UserID     string    `db:"user_id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes string    `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func mapWebhookEntityToWebhook(e webhookEntity) *model.Webhook {
+	return &model.Webhook{
+		ID:         e.ID,
+		UserID:     e.UserID,
+		URL:        e.URL,
+		Secret:     e.Secret,
+		EventTypes: strings.Split(e.EventTypes, webhookEventTypesSeparator),
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+// NewWebhookRepository create a repository of webhook.
+func NewWebhookRepository(conn *sqlx.DB) repository.WebhookRepository {
+	return &webhookRepository{conn: conn}
+}
+
+func (r webhookRepository) FindByID(id string) (*model.Webhook, error) {
+	e := webhookEntity{}
+	if err := r.conn.Get(&e, selectWebhookQuery+" WHERE id = ?", id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return mapWebhookEntityToWebhook(e), nil
+}
+
+func (r webhookRepository) FindByUserID(userID string) ([]*model.Webhook, error) {
+	es := []webhookEntity{}
+	if err := r.conn.Select(&es, selectWebhookQuery+" WHERE user_id = ? ORDER BY created_at, id", userID); err != nil {
+		return nil, err
+	}
+	res := make([]*model.Webhook, len(es))
+	for i, v := range es {
+		res[i] = mapWebhookEntityToWebhook(v)
+	}
+	return res, nil
+}
+
+func (r webhookRepository) Save(ctx context.Context, w *model.Webhook) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO `webhooks` (id, user_id, url, secret, event_types) VALUES(?, ?, ?, ?, ?)")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookRepository.Save()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(w.ID, w.UserID, w.URL, w.Secret, strings.Join(w.EventTypes, webhookEventTypesSeparator))
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookRepository.Save()")
+	}
+	return nil
+}
+
+// webhook_deliveriesはON DELETE CASCADEで削除される
+func (r webhookRepository) Delete(ctx context.Context, id string) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("DELETE FROM `webhooks` WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookRepository.Delete()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(id)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookRepository.Delete()")
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+const selectWebhookDeliveryQuery = "SELECT id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_error, delivered_at, created_at FROM webhook_deliveries"
+
+type webhookDeliveryRepository struct {
+	conn *sqlx.DB
+}
+
+// NewWebhookDeliveryRepository create a repository of the outbox of webhooks.
+func NewWebhookDeliveryRepository(conn *sqlx.DB) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{conn: conn}
+}
+
+func (r webhookDeliveryRepository) FindByID(id string) (*model.WebhookDelivery, error) {
+	d := model.WebhookDelivery{}
+	if err := r.conn.Get(&d, selectWebhookDeliveryQuery+" WHERE id = ?", id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r webhookDeliveryRepository) SaveAll(ctx context.Context, ds []*model.WebhookDelivery) error {
+	if len(ds) == 0 {
+		return nil
+	}
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	// 削除されたWebhookへの送信は外部キーのエラーにせず, 書き込まない
+	stmt, err := tx.Prepare("INSERT INTO `webhook_deliveries` (id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at) SELECT ?, id, ?, ?, ?, ?, ?, ? FROM `webhooks` WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.SaveAll()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	for _, d := range ds {
+		if _, err = stmt.Exec(d.ID, d.EventID, d.EventType, d.Payload, d.Status, d.Attempts, d.NextAttemptAt, d.WebhookID); err != nil {
+			return errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.SaveAll()")
+		}
+	}
+	return nil
+}
+
+func (r webhookDeliveryRepository) ClaimDueWebhooks(ctx context.Context, now, until time.Time, limit int) ([]string, error) {
+	var tx interface {
+		Select(dest interface{}, query string, args ...interface{}) error
+		Exec(query string, args ...interface{}) (sql.Result, error)
+		Rebind(query string) string
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	// 先頭の送信待ちが送信日時を過ぎたWebhookだけを確保し, 再試行を待つ送信より後のものを先に送らない
+	// 他のプロセスが確保中の行は待たずに飛ばす. 送信を終えてから長いものを先に確保する
+	ids := []string{}
+	query := `SELECT w.id FROM webhooks w
+		WHERE (w.dispatch_locked_until IS NULL OR w.dispatch_locked_until <= ?)
+		AND (SELECT d.next_attempt_at FROM webhook_deliveries d WHERE d.webhook_id = w.id AND d.status = ? ORDER BY d.seq LIMIT 1) <= ?
+		ORDER BY w.dispatch_locked_until, w.id LIMIT ? FOR UPDATE SKIP LOCKED`
+	if err := tx.Select(&ids, query, now, model.WebhookDeliveryPending, now, limit); err != nil {
+		return nil, errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.ClaimDueWebhooks()")
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	// 確保はWebhookの更新ではないため, updated_atは変えない
+	query, args, err := sqlx.In("UPDATE `webhooks` SET dispatch_locked_until = ?, updated_at = updated_at WHERE id IN (?)", until, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.ClaimDueWebhooks()")
+	}
+	if _, err := tx.Exec(tx.Rebind(query), args...); err != nil {
+		return nil, errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.ClaimDueWebhooks()")
+	}
+	return ids, nil
+}
+
+func (r webhookDeliveryRepository) ReleaseWebhook(ctx context.Context, webhookID string, now time.Time) error {
+	var tx interface {
+		Exec(query string, args ...interface{}) (sql.Result, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	// 送信を終えた日時を残し, 次の確保では他のWebhookを先にする
+	if _, err := tx.Exec("UPDATE `webhooks` SET dispatch_locked_until = ?, updated_at = updated_at WHERE id = ?", now, webhookID); err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.ReleaseWebhook()")
+	}
+	return nil
+}
+
+func (r webhookDeliveryRepository) FindPendingByWebhookID(webhookID string, limit int) ([]*model.WebhookDelivery, error) {
+	ds := []*model.WebhookDelivery{}
+	query := selectWebhookDeliveryQuery + " WHERE webhook_id = ? AND status = ? ORDER BY seq LIMIT ?"
+	if err := r.conn.Select(&ds, query, webhookID, model.WebhookDeliveryPending, limit); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+func (r webhookDeliveryRepository) FindDeadByWebhookID(webhookID string) ([]*model.WebhookDelivery, error) {
+	ds := []*model.WebhookDelivery{}
+	query := selectWebhookDeliveryQuery + " WHERE webhook_id = ? AND status = ? ORDER BY created_at DESC, id"
+	if err := r.conn.Select(&ds, query, webhookID, model.WebhookDeliveryDead); err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+func (r webhookDeliveryRepository) UpdateAttempt(ctx context.Context, d *model.WebhookDelivery) error {
+	var tx interface {
+		Prepare(query string) (*sql.Stmt, error)
+	}
+
+	tx, ok := GetTx(ctx)
+	if !ok {
+		tx = r.conn
+	}
+
+	stmt, err := tx.Prepare("UPDATE `webhook_deliveries` SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, delivered_at = ? WHERE id = ?")
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.UpdateAttempt()")
+	}
+	defer func() {
+		if closeErr := stmt.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}()
+
+	_, err = stmt.Exec(d.Status, d.Attempts, d.NextAttemptAt, d.LastError, d.DeliveredAt, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to datastore.webhookDeliveryRepository.UpdateAttempt()")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package geoip
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Reader looks up the country of an IP address from a local database
+// 別の形式のデータベースを使う場合はこのinterfaceを実装する
+type Reader interface {
+	// Country returns ISO 3166-1 alpha-2 country code, or "" if it is not found
+	Country(ip net.IP) (string, error)
+}
+
+type nopReader struct{}
+
+// NewNopReader creates a Reader which never finds the country
+func NewNopReader() Reader {
+	return nopReader{}
+}
+
+func (nopReader) Country(ip net.IP) (string, error) {
+	return "", nil
+}
+
+type ipRange struct {
+	start   net.IP
+	end     net.IP
+	country string
+}
+
+type csvReader struct {
+	ranges []ipRange
+}
+
+// NewCSVReader loads a CSV database of "start_ip,end_ip,country_code" lines (DB-IP Lite format)
+func NewCSVReader(path string) (Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+	defer f.Close()
+	return ReadCSV(f)
+}
+
+// ReadCSV reads a CSV database of "start_ip,end_ip,country_code" lines
+func ReadCSV(r io.Reader) (Reader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	ranges := []ipRange{}
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read geoip database: %w", err)
+		}
+		if len(record) < 3 {
+			return nil, fmt.Errorf("line %d: 3 columns are required", line)
+		}
+		start := net.ParseIP(strings.TrimSpace(record[0])).To16()
+		end := net.ParseIP(strings.TrimSpace(record[1])).To16()
+		if start == nil || end == nil || bytes.Compare(start, end) > 0 {
+			return nil, fmt.Errorf("line %d: ip range is invalid", line)
+		}
+		ranges = append(ranges, ipRange{start, end, strings.ToUpper(strings.TrimSpace(record[2]))})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].start, ranges[j].start) < 0
+	})
+	return &csvReader{ranges}, nil
+}
+
+func (r *csvReader) Country(ip net.IP) (string, error) {
+	ip = ip.To16()
+	if ip == nil {
+		return "", fmt.Errorf("ip is invalid")
+	}
+	// startがip以下の最後の範囲
+	i := sort.Search(len(r.ranges), func(i int) bool {
+		return bytes.Compare(r.ranges[i].start, ip) > 0
+	}) - 1
+	if i < 0 || bytes.Compare(ip, r.ranges[i].end) > 0 {
+		return "", nil
+	}
+	// "ZZ"は未割り当てを表す
+	if r.ranges[i].country == "ZZ" {
+		return "", nil
+	}
+	return r.ranges[i].country, nil
+}
@@ -0,0 +1,68 @@
+package geoip
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+const testDatabase = `1.0.0.0,1.0.0.255,AU
+1.0.1.0,1.0.3.255,CN
+1.0.4.0,1.0.7.255,ZZ
+126.0.0.0,126.255.255.255,JP
+2001:200::,2001:200:ffff:ffff:ffff:ffff:ffff:ffff,jp
+`
+
+func TestCSVReader_Country(t *testing.T) {
+	r, err := ReadCSV(strings.NewReader(testDatabase))
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	tests := []struct {
+		name    string
+		ip      string
+		want    string
+		wantErr bool
+	}{
+		{name: "NORMAL: 範囲の先頭", ip: "1.0.0.0", want: "AU"},
+		{name: "NORMAL: 範囲の末尾", ip: "1.0.3.255", want: "CN"},
+		{name: "NORMAL: 範囲の途中", ip: "126.12.34.56", want: "JP"},
+		{name: "NORMAL: IPv6", ip: "2001:200:dead::beef", want: "JP"},
+		{name: "NORMAL: 未割り当ての場合", ip: "1.0.5.1", want: ""},
+		{name: "NORMAL: 範囲外の場合", ip: "8.8.8.8", want: ""},
+		{name: "NORMAL: 先頭より前の場合", ip: "0.0.0.1", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Country(net.ParseIP(tt.ip))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("csvReader.Country() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("csvReader.Country() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{name: "NORMAL: 正常な場合", data: testDatabase, wantErr: false},
+		{name: "NORMAL: 空の場合", data: "", wantErr: false},
+		{name: "ERROR: 列が足りない場合", data: "1.0.0.0,1.0.0.255\n", wantErr: true},
+		{name: "ERROR: IPが不正な場合", data: "1.0.0.x,1.0.0.255,AU\n", wantErr: true},
+		{name: "ERROR: 範囲が逆の場合", data: "1.0.0.255,1.0.0.0,AU\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadCSV(strings.NewReader(tt.data)); (err != nil) != tt.wantErr {
+				t.Errorf("ReadCSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
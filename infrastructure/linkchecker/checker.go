@@ -0,0 +1,148 @@
+package linkchecker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/netguard"
+)
+
+// Config is the configuration of the HTTP link checker
+type Config struct {
+	// Timeout is the timeout of each request including redirects
+	Timeout time.Duration
+	// HostInterval is the minimum interval between requests to the same host
+	HostInterval time.Duration
+	// MaxRetries is the max number of retries of transient failures
+	MaxRetries int
+	// BaseBackoff and MaxBackoff bound the exponential backoff per host after transient failures
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	UserAgent   string
+	// AllowPrivate allows requests to loopback and private addresses. テストとローカルの開発用
+	AllowPrivate bool
+}
+
+// DefaultConfig returns the configuration used by the API server
+func DefaultConfig() Config {
+	return Config{
+		Timeout:      10 * time.Second,
+		HostInterval: time.Second,
+		MaxRetries:   2,
+		BaseBackoff:  5 * time.Second,
+		MaxBackoff:   5 * time.Minute,
+		UserAgent:    "anony-linkchecker/1.0",
+	}
+}
+
+// GETで本文を読み捨てる上限
+const maxDiscardBody = 64 << 10
+
+type checker struct {
+	client *http.Client
+	config Config
+	hosts  *hostLimiter
+}
+
+// NewChecker creates a LinkChecker issuing HEAD requests, falling back to GET when HEAD is not allowed
+// リダイレクト先を含めて, 公開されていないアドレスには接続しない
+func NewChecker(c Config) service.LinkChecker {
+	return &checker{
+		client: netguard.NewClient(c.Timeout, c.AllowPrivate),
+		config: c,
+		hosts:  newHostLimiter(c.HostInterval, c.BaseBackoff, c.MaxBackoff),
+	}
+}
+
+func (c *checker) Check(ctx context.Context, destination string) (*model.LinkHealth, error) {
+	u, err := url.Parse(destination)
+	if err != nil || u.Host == "" {
+		// URLの検証はmodelで行うので, 解釈できない場合は接続できなかったものとする
+		return &model.LinkHealth{CheckedAt: time.Now()}, nil
+	}
+	// 内部のアドレスは再試行しても変わらないので, 接続できなかったものとしてすぐに返す
+	if !c.config.AllowPrivate {
+		if err := netguard.CheckHost(ctx, u.Hostname()); errors.Is(err, netguard.ErrNonPublicAddress) {
+			return &model.LinkHealth{CheckedAt: time.Now()}, nil
+		}
+	}
+	host := strings.ToLower(u.Host)
+	for attempt := 0; ; attempt++ {
+		if err := c.hosts.wait(ctx, host); err != nil {
+			return nil, err
+		}
+		h, retryAfter := c.request(ctx, destination)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !isTransient(h.StatusCode) {
+			c.hosts.succeed(host)
+			return h, nil
+		}
+		c.hosts.fail(host, retryAfter)
+		if attempt >= c.config.MaxRetries {
+			return h, nil
+		}
+	}
+}
+
+// request returns the result and the Retry-After of the response
+func (c *checker) request(ctx context.Context, destination string) (*model.LinkHealth, time.Duration) {
+	start := time.Now()
+	res, err := c.do(ctx, http.MethodHead, destination)
+	// HEADに対応していないサーバーにはGETで確認する
+	if err == nil && (res.StatusCode == http.StatusMethodNotAllowed || res.StatusCode == http.StatusNotImplemented) {
+		res.Body.Close()
+		start = time.Now()
+		res, err = c.do(ctx, http.MethodGet, destination)
+	}
+	h := &model.LinkHealth{Latency: time.Since(start), CheckedAt: start}
+	if err != nil {
+		return h, 0
+	}
+	defer res.Body.Close()
+	// コネクションを再利用できるように本文を読み捨てる
+	io.Copy(ioutil.Discard, io.LimitReader(res.Body, maxDiscardBody))
+	h.StatusCode = int64(res.StatusCode)
+	return h, parseRetryAfter(res.Header.Get("Retry-After"))
+}
+
+func (c *checker) do(ctx context.Context, method, destination string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, destination, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.config.UserAgent != "" {
+		req.Header.Set("User-Agent", c.config.UserAgent)
+	}
+	return c.client.Do(req)
+}
+
+// isTransient returns true if the status may change by retrying later
+// 接続できなかった場合, 429と502〜504は再試行する
+func isTransient(statusCode int64) bool {
+	switch statusCode {
+	case 0, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses the Retry-After header in seconds
+// HTTP-dateの形式は無視して, ホストごとのバックオフに任せる
+func parseRetryAfter(v string) time.Duration {
+	sec, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || sec < 0 {
+		return 0
+	}
+	return time.Duration(sec) * time.Second
+}
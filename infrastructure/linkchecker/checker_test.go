@@ -0,0 +1,262 @@
+package linkchecker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testConfig() Config {
+	return Config{
+		Timeout:      time.Second,
+		HostInterval: 0,
+		MaxRetries:   2,
+		BaseBackoff:  time.Millisecond,
+		MaxBackoff:   10 * time.Millisecond,
+		UserAgent:    "anony-test",
+		AllowPrivate: true,
+	}
+}
+
+// recorder records the methods of the requests and responds with the statuses in order
+type recorder struct {
+	mu       sync.Mutex
+	methods  []string
+	times    []time.Time
+	statuses []int
+	header   http.Header
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.methods = append(r.methods, req.Method)
+	r.times = append(r.times, time.Now())
+	for k, v := range r.header {
+		w.Header()[k] = v
+	}
+	status := r.statuses[0]
+	if len(r.statuses) > 1 {
+		r.statuses = r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func Test_checker_Check(t *testing.T) {
+	tests := []struct {
+		name        string
+		statuses    []int
+		want        int64
+		wantMethods []string
+	}{
+		{name: "NORMAL: HEADで確認する", statuses: []int{200}, want: 200, wantMethods: []string{"HEAD"}},
+		{name: "NORMAL: HEADに対応していない場合はGETで確認する", statuses: []int{405, 200}, want: 200, wantMethods: []string{"HEAD", "GET"}},
+		{name: "NORMAL: 404は再試行しない", statuses: []int{404}, want: 404, wantMethods: []string{"HEAD"}},
+		{name: "NORMAL: 一時的なエラーは再試行する", statuses: []int{503, 200}, want: 200, wantMethods: []string{"HEAD", "HEAD"}},
+		{name: "NORMAL: 再試行の上限を超えた場合は最後の結果を返す", statuses: []int{503}, want: 503, wantMethods: []string{"HEAD", "HEAD", "HEAD"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{statuses: tt.statuses}
+			ts := httptest.NewServer(r)
+			defer ts.Close()
+
+			got, err := NewChecker(testConfig()).Check(context.Background(), ts.URL+"/path")
+			if err != nil {
+				t.Fatalf("checker.Check() error = %v", err)
+			}
+			if got.StatusCode != tt.want {
+				t.Errorf("checker.Check() StatusCode = %v, want %v", got.StatusCode, tt.want)
+			}
+			if got.CheckedAt.IsZero() || got.Latency <= 0 {
+				t.Errorf("checker.Check() = %+v, want CheckedAt and Latency", got)
+			}
+			if !reflect.DeepEqual(r.methods, tt.wantMethods) {
+				t.Errorf("checker.Check() methods = %v, want %v", r.methods, tt.wantMethods)
+			}
+		})
+	}
+}
+
+func Test_checker_Check_Redirect(t *testing.T) {
+	final := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer final.Close()
+	ts := httptest.NewServer(http.RedirectHandler(final.URL, http.StatusFound))
+	defer ts.Close()
+
+	got, err := NewChecker(testConfig()).Check(context.Background(), ts.URL)
+	if err != nil {
+		t.Fatalf("checker.Check() error = %v", err)
+	}
+	// リダイレクトを辿った最終的なステータスを記録する
+	if got.StatusCode != http.StatusGone {
+		t.Errorf("checker.Check() StatusCode = %v, want %v", got.StatusCode, http.StatusGone)
+	}
+}
+
+func Test_checker_Check_Unreachable(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	u := ts.URL
+	ts.Close()
+
+	got, err := NewChecker(testConfig()).Check(context.Background(), u)
+	if err != nil {
+		t.Fatalf("checker.Check() error = %v", err)
+	}
+	if got.StatusCode != 0 || !got.IsBroken() {
+		t.Errorf("checker.Check() = %+v, want unreachable", got)
+	}
+}
+
+func Test_checker_Check_PrivateAddress(t *testing.T) {
+	r := &recorder{statuses: []int{200}}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	c := testConfig()
+	c.AllowPrivate = false
+	got, err := NewChecker(c).Check(context.Background(), ts.URL)
+	if err != nil {
+		t.Fatalf("checker.Check() error = %v", err)
+	}
+	// 内部のアドレスには接続せず, 再試行もしない
+	if got.StatusCode != 0 || len(r.times) != 0 {
+		t.Errorf("checker.Check() = %+v, requests = %d, want unreachable without requests", got, len(r.times))
+	}
+}
+
+func Test_checker_Check_HostInterval(t *testing.T) {
+	r := &recorder{statuses: []int{200}}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	c := testConfig()
+	c.HostInterval = 20 * time.Millisecond
+	checker := NewChecker(c)
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := checker.Check(context.Background(), ts.URL); err != nil {
+				t.Errorf("checker.Check() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(r.times) != 3 {
+		t.Fatalf("requests = %d, want 3", len(r.times))
+	}
+	// 同じホストへのリクエストはHostInterval以上の間隔を空ける
+	for i := 1; i < len(r.times); i++ {
+		if d := r.times[i].Sub(r.times[i-1]); d < c.HostInterval-2*time.Millisecond {
+			t.Errorf("interval of requests = %v, want >= %v", d, c.HostInterval)
+		}
+	}
+}
+
+func Test_checker_Check_RetryAfter(t *testing.T) {
+	r := &recorder{statuses: []int{429, 200}, header: http.Header{"Retry-After": []string{"1"}}}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	c := testConfig()
+	c.MaxBackoff = time.Minute
+	got, err := NewChecker(c).Check(context.Background(), ts.URL)
+	if err != nil {
+		t.Fatalf("checker.Check() error = %v", err)
+	}
+	if got.StatusCode != 200 || len(r.times) != 2 {
+		t.Fatalf("checker.Check() = %+v, requests = %d", got, len(r.times))
+	}
+	if d := r.times[1].Sub(r.times[0]); d < 900*time.Millisecond {
+		t.Errorf("retry after %v, want >= 1s", d)
+	}
+}
+
+func Test_checker_Check_Canceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := NewChecker(testConfig()).Check(ctx, ts.URL); err == nil {
+		t.Errorf("checker.Check() error = nil, want context error")
+	}
+}
+
+func Test_hostLimiter_fail(t *testing.T) {
+	l := newHostLimiter(0, time.Second, 5*time.Second)
+	tests := []struct {
+		name       string
+		retryAfter time.Duration
+		want       time.Duration
+	}{
+		{name: "NORMAL: 1回目", want: time.Second},
+		{name: "NORMAL: 2回目は2倍", want: 2 * time.Second},
+		{name: "NORMAL: Retry-Afterの方が長い場合", retryAfter: 4 * time.Second, want: 4 * time.Second},
+		{name: "NORMAL: 上限を超えない", want: 5 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := time.Now()
+			l.fail("example.com", tt.retryAfter)
+			got := l.hosts["example.com"].next.Sub(before)
+			if got < tt.want || got > tt.want+100*time.Millisecond {
+				t.Errorf("hostLimiter.fail() delays %v, want %v", got, tt.want)
+			}
+			// 次のケースで遅延が延びたことを確認できるように戻す
+			l.hosts["example.com"].next = time.Time{}
+		})
+	}
+	l.succeed("example.com")
+	if l.hosts["example.com"].failures != 0 {
+		t.Errorf("hostLimiter.succeed() failures = %v, want 0", l.hosts["example.com"].failures)
+	}
+}
+
+func Test_hostLimiter_sweep(t *testing.T) {
+	l := newHostLimiter(time.Second, time.Second, 5*time.Minute)
+	now := time.Now()
+	for _, host := range []string{"ok.example", "failed.example", "waiting.example"} {
+		if err := l.wait(context.Background(), host); err != nil {
+			t.Fatal(err)
+		}
+	}
+	l.fail("failed.example", 0)
+	l.hosts["waiting.example"].next = now.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want []string
+	}{
+		{name: "NORMAL: 前回から間隔が空いていない場合は削除しない", at: now.Add(10 * time.Second), want: []string{"failed.example", "ok.example", "waiting.example"}},
+		{name: "NORMAL: 間隔が過ぎたホストを削除する", at: now.Add(2 * time.Minute), want: []string{"failed.example", "waiting.example"}},
+		{name: "NORMAL: バックオフも過ぎた失敗したホストを削除する", at: now.Add(7 * time.Minute), want: []string{"waiting.example"}},
+	}
+	l.lastSweep = now
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l.sweep(tt.at)
+			got := []string{}
+			for k := range l.hosts {
+				got = append(got, k)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("hostLimiter.sweep() hosts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
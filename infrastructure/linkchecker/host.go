@@ -0,0 +1,122 @@
+package linkchecker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 間隔とバックオフが過ぎたホストを削除する間隔
+const hostSweepInterval = time.Minute
+
+// hostLimiter spaces requests to the same host and backs off hosts failing transiently
+type hostLimiter struct {
+	interval    time.Duration
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+
+	mu        sync.Mutex
+	hosts     map[string]*hostState
+	lastSweep time.Time
+}
+
+type hostState struct {
+	next     time.Time // 次のリクエストを送ってよい時刻
+	failures int       // 連続した一時的な失敗の回数
+}
+
+func newHostLimiter(interval, baseBackoff, maxBackoff time.Duration) *hostLimiter {
+	return &hostLimiter{
+		interval:    interval,
+		baseBackoff: baseBackoff,
+		maxBackoff:  maxBackoff,
+		hosts:       map[string]*hostState{},
+	}
+}
+
+// wait blocks until a request to the host is allowed, and reserves the slot
+func (l *hostLimiter) wait(ctx context.Context, host string) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.sweep(now)
+	st := l.state(host)
+	at := st.next
+	if at.Before(now) {
+		at = now
+	}
+	st.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	d := time.Until(at)
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// succeed resets the backoff of the host
+func (l *hostLimiter) succeed(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.state(host).failures = 0
+}
+
+// fail delays the next request to the host exponentially, or by retryAfter if it is longer
+func (l *hostLimiter) fail(host string, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	st := l.state(host)
+	st.failures++
+	d := l.backoff(st.failures)
+	if retryAfter > d {
+		d = retryAfter
+	}
+	if d > l.maxBackoff {
+		d = l.maxBackoff
+	}
+	if next := time.Now().Add(d); next.After(st.next) {
+		st.next = next
+	}
+}
+
+func (l *hostLimiter) backoff(failures int) time.Duration {
+	d := l.baseBackoff
+	for i := 1; i < failures && d < l.maxBackoff; i++ {
+		d *= 2
+	}
+	return d
+}
+
+// sweep deletes the hosts which are the same as new ones
+// 失敗したホストはバックオフを延ばせるように, さらにmaxBackoffの間は残す
+func (l *hostLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < hostSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for k, st := range l.hosts {
+		expiresAt := st.next
+		if st.failures > 0 {
+			expiresAt = expiresAt.Add(l.maxBackoff)
+		}
+		if !now.Before(expiresAt) {
+			delete(l.hosts, k)
+		}
+	}
+}
+
+func (l *hostLimiter) state(host string) *hostState {
+	st, ok := l.hosts[host]
+	if !ok {
+		st = &hostState{}
+		l.hosts[host] = st
+	}
+	return st
+}
@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/usecase/dto"
+	"github.com/Tatsuemon/anony/usecase/queryservice"
+)
+
+// AnonyURLSearcher is an in-memory queryservice.AnonyURLSearcher
+// 部分一致で検索する. Scoreは語が項目に現れた回数に重みを掛けた合計
+type AnonyURLSearcher struct {
+	mu   sync.RWMutex
+	docs map[string]*searchDocument
+}
+
+type searchDocument struct {
+	userID   string
+	anonyURL *model.AnonyURL
+	fields   []searchField
+}
+
+type searchField struct {
+	text   string
+	weight float64
+}
+
+var _ queryservice.AnonyURLSearcher = (*AnonyURLSearcher)(nil)
+
+// NewAnonyURLSearcher creates an empty AnonyURLSearcher
+func NewAnonyURLSearcher() *AnonyURLSearcher {
+	return &AnonyURLSearcher{docs: map[string]*searchDocument{}}
+}
+
+// Put indexes the AnonyURL of the user. an.Tagsの名前も検索対象にする
+func (s *AnonyURLSearcher) Put(userID string, an *model.AnonyURL) {
+	fields := []searchField{
+		{text: an.Short, weight: queryservice.SearchWeightShort},
+		{text: an.Title, weight: queryservice.SearchWeightTitle},
+		{text: an.Original, weight: queryservice.SearchWeightOriginal},
+		{text: an.Notes, weight: queryservice.SearchWeightNotes},
+	}
+	if an.Preview != nil {
+		fields = append(fields, searchField{text: an.Preview.Title, weight: queryservice.SearchWeightTitle})
+	}
+	for _, t := range an.Tags {
+		fields = append(fields, searchField{text: t.Name, weight: queryservice.SearchWeightTag})
+	}
+	for i := range fields {
+		fields[i].text = strings.ToLower(fields[i].text)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs[an.ID] = &searchDocument{userID: userID, anonyURL: an, fields: fields}
+}
+
+// Delete removes the AnonyURL from the index
+func (s *AnonyURLSearcher) Delete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.docs, id)
+}
+
+func (s *AnonyURLSearcher) SearchAnonyURLs(userID string, q dto.AnonyURLSearchQuery) ([]*dto.AnonyURLSearchResult, error) {
+	terms := make([]string, 0, len(q.Terms))
+	for _, t := range q.Terms {
+		if t = strings.ToLower(strings.TrimSpace(t)); t != "" {
+			terms = append(terms, t)
+		}
+	}
+	res := []*dto.AnonyURLSearchResult{}
+	if len(terms) == 0 {
+		return res, nil
+	}
+
+	s.mu.RLock()
+	for _, d := range s.docs {
+		if d.userID != userID {
+			continue
+		}
+		if score, ok := d.score(terms); ok {
+			res = append(res, &dto.AnonyURLSearchResult{AnonyURL: d.anonyURL, Score: score})
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i], res[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.AnonyURL.CreatedAt.Equal(b.AnonyURL.CreatedAt) {
+			return a.AnonyURL.CreatedAt.After(b.AnonyURL.CreatedAt)
+		}
+		return a.AnonyURL.ID < b.AnonyURL.ID
+	})
+
+	if q.Offset >= len(res) {
+		return []*dto.AnonyURLSearchResult{}, nil
+	}
+	res = res[q.Offset:]
+	if q.Limit > 0 && q.Limit < len(res) {
+		res = res[:q.Limit]
+	}
+	return res, nil
+}
+
+// score returns false if any of the terms is not in the fields
+func (d *searchDocument) score(terms []string) (float64, bool) {
+	total := 0.0
+	for _, t := range terms {
+		matched := false
+		for _, f := range d.fields {
+			if n := strings.Count(f.text, t); n > 0 {
+				matched = true
+				total += f.weight * float64(n)
+			}
+		}
+		if !matched {
+			return 0, false
+		}
+	}
+	return total, true
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/usecase/dto"
+)
+
+func TestAnonyURLSearcher_SearchAnonyURLs(t *testing.T) {
+	base := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	s := NewAnonyURLSearcher()
+	s.Put("user", &model.AnonyURL{ID: "go", Original: "https://golang.org/doc/", Short: "gofaq", CreatedAt: base,
+		Preview: &model.LinkPreview{Title: "Documentation - The Go Programming Language"}})
+	s.Put("user", &model.AnonyURL{ID: "blog", Original: "https://go.dev/blog/", Short: "abc123", CreatedAt: base.Add(time.Hour),
+		Tags: []*model.Tag{{Name: "Reading"}}})
+	s.Put("user", &model.AnonyURL{ID: "news", Original: "https://news.example.com/", Short: "news", CreatedAt: base.Add(2 * time.Hour),
+		Tags: []*model.Tag{{Name: "Reading"}}})
+	s.Put("other", &model.AnonyURL{ID: "others", Original: "https://go.dev/", Short: "go", CreatedAt: base})
+
+	tests := []struct {
+		name string
+		q    dto.AnonyURLSearchQuery
+		want []string
+	}{
+		{name: "NORMAL: 短縮コードの一致はURLの一致より上位", q: dto.AnonyURLSearchQuery{Terms: []string{"go"}}, want: []string{"go", "blog"}},
+		{name: "NORMAL: 大文字小文字を区別しない", q: dto.AnonyURLSearchQuery{Terms: []string{"PROGRAMMING"}}, want: []string{"go"}},
+		{name: "NORMAL: タグで検索する. 同じScoreは新しい順", q: dto.AnonyURLSearchQuery{Terms: []string{"reading"}}, want: []string{"news", "blog"}},
+		{name: "NORMAL: 全ての語を含む", q: dto.AnonyURLSearchQuery{Terms: []string{"reading", "go"}}, want: []string{"blog"}},
+		{name: "NORMAL: LimitとOffset", q: dto.AnonyURLSearchQuery{Terms: []string{"reading"}, Limit: 1, Offset: 1}, want: []string{"blog"}},
+		{name: "NORMAL: Offsetが件数を超える", q: dto.AnonyURLSearchQuery{Terms: []string{"reading"}, Offset: 2}, want: []string{}},
+		{name: "NORMAL: 語が空", q: dto.AnonyURLSearchQuery{Terms: []string{" "}}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.SearchAnonyURLs("user", tt.q)
+			if err != nil {
+				t.Fatalf("AnonyURLSearcher.SearchAnonyURLs() error = %v", err)
+			}
+			got := []string{}
+			for _, r := range res {
+				got = append(got, r.AnonyURL.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AnonyURLSearcher.SearchAnonyURLs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	s.Delete("blog")
+	res, _ := s.SearchAnonyURLs("user", dto.AnonyURLSearchQuery{Terms: []string{"reading"}})
+	if len(res) != 1 || res[0].AnonyURL.ID != "news" {
+		t.Errorf("AnonyURLSearcher.Delete() did not remove the AnonyURL")
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterTrailer is the trailer of the seconds to wait after ResourceExhausted by the rate limit
+const RetryAfterTrailer = "retry-after"
+
+// RateLimitKeyFunc returns the key of the client to rate-limit, or "" to skip rate limiting
+type RateLimitKeyFunc func(ctx context.Context) string
+
+// RateLimitKey keys authenticated requests by the user ID and others by the client IP address
+// APIキーを導入する場合は, 認証したAPIキーをここで優先する
+func RateLimitKey(ctx context.Context) string {
+	if userID, err := model.GetUserIDInContext(ctx); err == nil && userID != "" {
+		return "user:" + userID
+	}
+	if ip := model.GetRequestInfoInContext(ctx).ClientIP; ip != "" {
+		return "ip:" + ip
+	}
+	// RequestInfoInterceptorやRequestInfoStreamInterceptorを通らない場合は, 接続元を使う
+	if ip := peerIP(ctx); ip != "" {
+		return "ip:" + ip
+	}
+	return ""
+}
+
+// RateLimiter limits requests per client and method with token buckets in the store
+// ストアのエラーではリクエストを止めず, ログに残して通す
+type RateLimiter struct {
+	store  ratelimit.Store
+	limits ratelimit.Limits
+	key    RateLimitKeyFunc
+	now    func() time.Time
+}
+
+// NewRateLimiter creates a RateLimiter
+// 認証の後に置くと, ユーザーIDで制限できる
+func NewRateLimiter(s ratelimit.Store, ls ratelimit.Limits, key RateLimitKeyFunc) *RateLimiter {
+	return &RateLimiter{store: s, limits: ls, key: key, now: time.Now}
+}
+
+// UnaryServerInterceptor returns ResourceExhausted with the retry-after trailer when the limit is exceeded
+func (r *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := r.allow(ctx, info.FullMethod, func(md metadata.MD) { _ = grpc.SetTrailer(ctx, md) }); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor limits opening streams in the same way as UnaryServerInterceptor
+func (r *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := r.allow(ss.Context(), info.FullMethod, ss.SetTrailer); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (r *RateLimiter) allow(ctx context.Context, method string, setTrailer func(metadata.MD)) error {
+	bucket, l := r.limits.For(method)
+	if l.Unlimited() {
+		return nil
+	}
+	key := r.key(ctx)
+	if key == "" {
+		return nil
+	}
+	res, err := r.store.Take(ctx, bucket+"|"+key, l, r.now())
+	if err != nil {
+		log.Printf("failed to rate-limit %s: %s", method, err)
+		return nil
+	}
+	if res.Allowed {
+		return nil
+	}
+	seconds := int64(math.Ceil(res.RetryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	setTrailer(metadata.Pairs(RetryAfterTrailer, strconv.FormatInt(seconds, 10)))
+	return status.Errorf(codes.ResourceExhausted, "rate limit is exceeded, retry after %d seconds", seconds)
+}
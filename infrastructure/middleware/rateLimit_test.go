@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type storeFunc func(ctx context.Context, key string, l ratelimit.Limit, now time.Time) (ratelimit.Result, error)
+
+func (f storeFunc) Take(ctx context.Context, key string, l ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	return f(ctx, key, l, now)
+}
+
+// transportStreamStub records the header and the trailer set by unary interceptors
+type transportStreamStub struct {
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *transportStreamStub) Method() string { return "" }
+func (s *transportStreamStub) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+func (s *transportStreamStub) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+func (s *transportStreamStub) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+// serverStreamStub records the header and the trailer set by stream interceptors
+type serverStreamStub struct {
+	grpc.ServerStream
+	ctx     context.Context
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *serverStreamStub) Context() context.Context { return s.ctx }
+func (s *serverStreamStub) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+func (s *serverStreamStub) SetTrailer(md metadata.MD) { s.trailer = metadata.Join(s.trailer, md) }
+
+func TestRateLimiter_UnaryServerInterceptor(t *testing.T) {
+	limits := ratelimit.Limits{ratelimit.DefaultKey: {Rate: 1, Burst: 2}, "/anony.AnonyService/CreateAnonyURL": {Rate: 1, Burst: 1}}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	ctx := model.SetUserIDInContext(context.Background(), "user")
+	r := NewRateLimiter(ratelimit.NewMemoryStore(), limits, RateLimitKey)
+	call := func(ctx context.Context, method string) error {
+		_, err := r.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, ok)
+		return err
+	}
+
+	if err := call(ctx, "/anony.AnonyService/CreateAnonyURL"); err != nil {
+		t.Fatalf("RateLimiter.UnaryServerInterceptor() error = %v", err)
+	}
+	err := call(ctx, "/anony.AnonyService/CreateAnonyURL")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("RateLimiter.UnaryServerInterceptor() error = %v, want ResourceExhausted", err)
+	}
+	// メソッドごとの制限は他のメソッドのバケットと別
+	if err := call(ctx, "/anony.AnonyService/ListAnonyURLs"); err != nil {
+		t.Errorf("RateLimiter.UnaryServerInterceptor() of another method error = %v", err)
+	}
+	// ユーザーごとに別のバケット
+	if err := call(model.SetUserIDInContext(context.Background(), "other"), "/anony.AnonyService/CreateAnonyURL"); err != nil {
+		t.Errorf("RateLimiter.UnaryServerInterceptor() of another user error = %v", err)
+	}
+}
+
+func TestRateLimiter_RetryAfterTrailer(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	// 1回目で使い切り, 次のトークンまで2秒
+	limits := ratelimit.Limits{ratelimit.DefaultKey: {Rate: 0.5, Burst: 1}}
+	ctx := model.SetRequestInfoInContext(context.Background(), model.RequestInfo{ClientIP: "192.0.2.1"})
+	tests := []struct {
+		name string
+		call func(r *RateLimiter) (metadata.MD, error)
+	}{
+		{
+			name: "NORMAL: Unary",
+			call: func(r *RateLimiter) (metadata.MD, error) {
+				ts := &transportStreamStub{}
+				_, err := r.UnaryServerInterceptor()(grpc.NewContextWithServerTransportStream(ctx, ts), nil, &grpc.UnaryServerInfo{FullMethod: "/anony.UserService/LogInUser"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, nil
+				})
+				return ts.trailer, err
+			},
+		},
+		{
+			name: "NORMAL: ストリーム",
+			call: func(r *RateLimiter) (metadata.MD, error) {
+				ss := &serverStreamStub{ctx: ctx}
+				err := r.StreamServerInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: "/anony.AnonyService/Watch"}, func(srv interface{}, ss grpc.ServerStream) error {
+					return nil
+				})
+				return ss.trailer, err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRateLimiter(ratelimit.NewMemoryStore(), limits, RateLimitKey)
+			r.now = func() time.Time { return now }
+			if trailer, err := tt.call(r); err != nil || len(trailer) != 0 {
+				t.Fatalf("first call error = %v, trailer = %v", err, trailer)
+			}
+			trailer, err := tt.call(r)
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("second call error = %v, want ResourceExhausted", err)
+			}
+			if got := trailer.Get(RetryAfterTrailer); len(got) != 1 || got[0] != "2" {
+				t.Errorf("second call trailer %s = %v, want [2]", RetryAfterTrailer, got)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_UnaryServerInterceptor_StoreError(t *testing.T) {
+	// ストアが使えない場合は制限しない
+	s := storeFunc(func(ctx context.Context, key string, l ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+		return ratelimit.Result{}, errors.New("connection refused")
+	})
+	r := NewRateLimiter(s, ratelimit.Limits{ratelimit.DefaultKey: {Rate: 1, Burst: 1}}, RateLimitKey)
+	ctx := model.SetRequestInfoInContext(context.Background(), model.RequestInfo{ClientIP: "192.0.2.1"})
+	_, err := r.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/anony.UserService/LogInUser"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Errorf("RateLimiter.UnaryServerInterceptor() error = %v, want nil", err)
+	}
+}
+
+func TestRateLimitKey(t *testing.T) {
+	withIP := model.SetRequestInfoInContext(context.Background(), model.RequestInfo{ClientIP: "192.0.2.1"})
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "NORMAL: 認証済みの場合はユーザーID", ctx: model.SetUserIDInContext(withIP, "user"), want: "user:user"},
+		{name: "NORMAL: 未認証の場合はIPアドレス", ctx: withIP, want: "ip:192.0.2.1"},
+		{name: "NORMAL: どちらもない場合は制限しない", ctx: context.Background(), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RateLimitKey(tt.ctx); got != tt.want {
+				t.Errorf("RateLimitKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"net"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/clientip"
+	"github.com/google/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// RequestIDHeader is the metadata key of the request ID
+// クライアントが指定しない場合は生成し, 応答のヘッダで返す
+const RequestIDHeader = "x-request-id"
+
+// 受け付けるリクエストIDの長さの上限
+const maxRequestIDLength = 128
+
+// RequestInfoInterceptor sets the request ID and the client IP in the context for audit events
+// trustedHopsは前にある信頼できるプロキシの数. 0より大きい場合はX-Forwarded-Forからクライアントのアドレスを得る
+func RequestInfoInterceptor(trustedHops int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ri := requestInfo(ctx, trustedHops)
+		// ヘッダを返せなくても処理は続ける
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, ri.RequestID))
+		return handler(model.SetRequestInfoInContext(ctx, ri), req)
+	}
+}
+
+// RequestInfoStreamInterceptor sets the request info in the context of the stream in the same way as RequestInfoInterceptor
+func RequestInfoStreamInterceptor(trustedHops int) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ri := requestInfo(ss.Context(), trustedHops)
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, ri.RequestID))
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = model.SetRequestInfoInContext(ss.Context(), ri)
+		return handler(srv, wrapped)
+	}
+}
+
+func requestInfo(ctx context.Context, trustedHops int) model.RequestInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ri := model.RequestInfo{}
+	if v := md.Get(RequestIDHeader); len(v) > 0 && v[0] != "" && len(v[0]) <= maxRequestIDLength {
+		ri.RequestID = v[0]
+	} else {
+		ri.RequestID = uuid.New().String()
+	}
+	if ip := clientip.FromForwardedFor(md.Get("x-forwarded-for"), trustedHops); ip != nil {
+		ri.ClientIP = ip.String()
+		return ri
+	}
+	ri.ClientIP = peerIP(ctx)
+	return ri
+}
+
+// peerIP returns the IP address of the connected peer, or "" if it is unknown
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
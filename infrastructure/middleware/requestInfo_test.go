@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func Test_requestInfo(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 12345}
+	tests := []struct {
+		name          string
+		md            metadata.MD
+		trustedHops   int
+		wantRequestID string
+		wantClientIP  string
+	}{
+		{name: "NORMAL: 指定されたリクエストIDと接続元のIPアドレス", md: metadata.Pairs(RequestIDHeader, "req-1"), wantRequestID: "req-1", wantClientIP: "192.0.2.1"},
+		{name: "NORMAL: X-Forwarded-Forは信頼しない設定では使わない", md: metadata.Pairs("x-forwarded-for", "198.51.100.1"), wantClientIP: "192.0.2.1"},
+		{name: "NORMAL: X-Forwarded-Forの末尾を使う", md: metadata.Pairs("x-forwarded-for", "198.51.100.1"), trustedHops: 1, wantClientIP: "198.51.100.1"},
+		{name: "NORMAL: クライアントが偽装した先頭は使わない", md: metadata.Pairs("x-forwarded-for", "203.0.113.9, 198.51.100.1"), trustedHops: 1, wantClientIP: "198.51.100.1"},
+		{name: "NORMAL: 信頼できるプロキシの分だけ末尾から飛ばす", md: metadata.Pairs("x-forwarded-for", "203.0.113.9, 198.51.100.1, 10.0.0.1"), trustedHops: 2, wantClientIP: "198.51.100.1"},
+		{name: "NORMAL: X-Forwarded-Forが不正な場合は接続元", md: metadata.Pairs("x-forwarded-for", "unknown"), trustedHops: 1, wantClientIP: "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(metadata.NewIncomingContext(context.Background(), tt.md), &peer.Peer{Addr: addr})
+			got := requestInfo(ctx, tt.trustedHops)
+			if tt.wantRequestID != "" && got.RequestID != tt.wantRequestID || got.RequestID == "" {
+				t.Errorf("requestInfo() RequestID = %v, want %v", got.RequestID, tt.wantRequestID)
+			}
+			if got.ClientIP != tt.wantClientIP {
+				t.Errorf("requestInfo() ClientIP = %v, want %v", got.ClientIP, tt.wantClientIP)
+			}
+		})
+	}
+}
+
+func TestRequestInfoInterceptor(t *testing.T) {
+	var got model.RequestInfo
+	_, err := RequestInfoInterceptor(0)(context.Background(), nil, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = model.GetRequestInfoInContext(ctx)
+		return nil, nil
+	})
+	if err != nil || got.RequestID == "" {
+		t.Errorf("RequestInfoInterceptor() info = %+v, error = %v", got, err)
+	}
+}
+
+func TestRequestInfoStreamInterceptor(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 12345}
+	ctx := peer.NewContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "203.0.113.9, 198.51.100.1")), &peer.Peer{Addr: addr})
+	ss := &serverStreamStub{ctx: ctx}
+	var got model.RequestInfo
+	err := RequestInfoStreamInterceptor(1)(nil, ss, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		got = model.GetRequestInfoInContext(ss.Context())
+		return nil
+	})
+	if err != nil || got.RequestID == "" || got.ClientIP != "198.51.100.1" {
+		t.Errorf("RequestInfoStreamInterceptor() info = %+v, error = %v", got, err)
+	}
+	if v := ss.header.Get(RequestIDHeader); len(v) != 1 || v[0] != got.RequestID {
+		t.Errorf("RequestInfoStreamInterceptor() header = %v, want %v", v, got.RequestID)
+	}
+}
@@ -0,0 +1,146 @@
+// Package netguard keeps HTTP requests to user supplied URLs away from internal networks
+// 接続時に解決済みのアドレスを確認するので, DNSリバインディングでも内部のアドレスには接続しない
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when the host resolves to a loopback, private or reserved address
+var ErrNonPublicAddress = errors.New("destination is not a public address")
+
+// http.Clientのデフォルトと同じリダイレクトの上限
+const maxRedirects = 10
+
+// 公開されていない, または特別な用途のアドレス範囲
+var nonPublicNets = parseCIDRs(
+	"0.0.0.0/8",       // このネットワーク
+	"10.0.0.0/8",      // プライベート
+	"100.64.0.0/10",   // CGNAT
+	"127.0.0.0/8",     // ループバック
+	"169.254.0.0/16",  // リンクローカル. クラウドのメタデータサーバーを含む
+	"172.16.0.0/12",   // プライベート
+	"192.0.0.0/24",    // IETFプロトコル割り当て
+	"192.0.2.0/24",    // ドキュメント用
+	"192.168.0.0/16",  // プライベート
+	"198.18.0.0/15",   // ベンチマーク用
+	"198.51.100.0/24", // ドキュメント用
+	"203.0.113.0/24",  // ドキュメント用
+	"224.0.0.0/4",     // マルチキャスト
+	"240.0.0.0/4",     // 予約済み. ブロードキャストを含む
+	"::/128",          // 未指定
+	"::1/128",         // ループバック
+	"64:ff9b::/96",    // NAT64. IPv4のプライベートアドレスに変換される
+	"100::/64",        // 破棄用
+	"2001:db8::/32",   // ドキュメント用
+	"fc00::/7",        // ユニークローカル
+	"fe80::/10",       // リンクローカル
+	"ff00::/8",        // マルチキャスト
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	res := make([]*net.IPNet, len(cidrs))
+	for i, v := range cidrs {
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			panic(err)
+		}
+		res[i] = n
+	}
+	return res
+}
+
+// IsPublicIP reports whether the IP address is reachable on the internet
+// IPv4射影アドレスはIPv4として判定する
+func IsPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip == nil {
+		return false
+	}
+	for _, n := range nonPublicNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Control is a net.Dialer.Control rejecting connections to non-public addresses
+func Control(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if !IsPublicIP(net.ParseIP(host)) {
+		return fmt.Errorf("%s: %w", host, ErrNonPublicAddress)
+	}
+	return nil
+}
+
+// CheckHost resolves the host and returns an error if any of the addresses is not public
+// 登録時の確認とリダイレクト先の確認に使う. 接続時はControlで改めて確認する
+func CheckHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("%s: %w", host, ErrNonPublicAddress)
+		}
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	for _, a := range addrs {
+		if !IsPublicIP(a.IP) {
+			return fmt.Errorf("%s: %w", host, ErrNonPublicAddress)
+		}
+	}
+	return nil
+}
+
+// CheckURL returns an error if the URL is not http(s) or the host is not public
+func CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return fmt.Errorf("url must be a http or https URL with a host")
+	}
+	return CheckHost(ctx, u.Hostname())
+}
+
+// CheckRedirect is a http.Client.CheckRedirect rejecting redirects to non-public hosts
+func CheckRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	return CheckURL(req.Context(), req.URL.String())
+}
+
+// NewClient creates a http.Client connecting only to public addresses
+// allowPrivateがtrueの場合は制限しない. テストとローカルの開発用
+func NewClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	if allowPrivate {
+		return &http.Client{Timeout: timeout}
+	}
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: Control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシ経由ではControlで接続先を確認できないため, 環境変数のプロキシを使わない
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:       timeout,
+		Transport:     transport,
+		CheckRedirect: CheckRedirect,
+	}
+}
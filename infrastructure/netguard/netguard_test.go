@@ -0,0 +1,90 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		name string
+		ip   string
+		want bool
+	}{
+		{name: "NORMAL: 公開されたIPv4アドレス", ip: "93.184.216.34", want: true},
+		{name: "NORMAL: 公開されたIPv6アドレス", ip: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{name: "NORMAL: ループバック", ip: "127.0.0.1", want: false},
+		{name: "NORMAL: プライベート", ip: "10.1.2.3", want: false},
+		{name: "NORMAL: プライベート(172.16/12)", ip: "172.31.255.255", want: false},
+		{name: "NORMAL: メタデータサーバー", ip: "169.254.169.254", want: false},
+		{name: "NORMAL: 未指定", ip: "0.0.0.0", want: false},
+		{name: "NORMAL: IPv6のループバック", ip: "::1", want: false},
+		{name: "NORMAL: IPv4射影アドレスのループバック", ip: "::ffff:127.0.0.1", want: false},
+		{name: "NORMAL: IPv6のユニークローカル", ip: "fd00::1", want: false},
+		{name: "NORMAL: IPv6のリンクローカル", ip: "fe80::1", want: false},
+		{name: "NORMAL: 解釈できないアドレス", ip: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPublicIP(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("IsPublicIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "NORMAL: 公開されたIPアドレス", url: "https://93.184.216.34/hook", wantErr: false},
+		{name: "ERROR: ループバック", url: "http://127.0.0.1:8080/hook", wantErr: true},
+		{name: "ERROR: localhost", url: "http://localhost/hook", wantErr: true},
+		{name: "ERROR: IPv6のループバック", url: "http://[::1]/hook", wantErr: true},
+		{name: "ERROR: メタデータサーバー", url: "http://169.254.169.254/latest/meta-data/", wantErr: true},
+		{name: "ERROR: http(s)でない", url: "file:///etc/passwd", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckURL(context.Background(), tt.url); (err != nil) != tt.wantErr {
+				t.Errorf("CheckURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	// 接続時に解決済みのアドレスで拒否する
+	_, err := NewClient(time.Second, false).Get(ts.URL)
+	if !errors.Is(err, ErrNonPublicAddress) {
+		t.Errorf("Client.Get() error = %v, want %v", err, ErrNonPublicAddress)
+	}
+	res, err := NewClient(time.Second, true).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("Client.Get() error = %v, want nil when private addresses are allowed", err)
+	}
+	res.Body.Close()
+}
+
+func TestCheckRedirect(t *testing.T) {
+	via := []*http.Request{httptest.NewRequest(http.MethodGet, "https://93.184.216.34/", nil)}
+	if err := CheckRedirect(httptest.NewRequest(http.MethodGet, "https://93.184.216.34/next", nil), via); err != nil {
+		t.Errorf("CheckRedirect() error = %v, want nil", err)
+	}
+	if err := CheckRedirect(httptest.NewRequest(http.MethodGet, "http://10.0.0.1/admin", nil), via); !errors.Is(err, ErrNonPublicAddress) {
+		t.Errorf("CheckRedirect() error = %v, want %v", err, ErrNonPublicAddress)
+	}
+	if err := CheckRedirect(httptest.NewRequest(http.MethodGet, "https://93.184.216.34/next", nil), make([]*http.Request, maxRedirects)); err == nil {
+		t.Errorf("CheckRedirect() error = nil, want error after %d redirects", maxRedirects)
+	}
+}
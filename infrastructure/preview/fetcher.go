@@ -0,0 +1,100 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/netguard"
+	"golang.org/x/net/html/charset"
+)
+
+// Config is the configuration of the preview fetcher
+type Config struct {
+	// Timeout is the timeout of fetching a page including redirects
+	Timeout time.Duration
+	// MaxBodySize is the max number of bytes of a page to parse
+	MaxBodySize int64
+	UserAgent   string
+	// AllowPrivate allows requests to loopback and private addresses. テストとローカルの開発用
+	AllowPrivate bool
+}
+
+// DefaultConfig returns the configuration used by the API server
+func DefaultConfig() Config {
+	return Config{
+		Timeout:     10 * time.Second,
+		MaxBodySize: 1 << 20,
+		UserAgent:   "anony-preview/1.0",
+	}
+}
+
+type fetcher struct {
+	client *http.Client
+	config Config
+}
+
+// NewFetcher creates a PreviewFetcher parsing <title>, OpenGraph and favicons of HTML pages
+// リダイレクト先を含めて, 公開されていないアドレスには接続しない
+func NewFetcher(c Config) service.PreviewFetcher {
+	return &fetcher{
+		client: netguard.NewClient(c.Timeout, c.AllowPrivate),
+		config: c,
+	}
+}
+
+func (f *fetcher) Fetch(ctx context.Context, destination string) (*model.LinkPreview, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, destination, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	if f.config.UserAgent != "" {
+		req.Header.Set("User-Agent", f.config.UserAgent)
+	}
+	res, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("failed to fetch %s: status %d", destination, res.StatusCode)
+	}
+
+	// リダイレクトされた場合は最終的なURLを基準に相対URLを解決する
+	base := res.Request.URL
+	p := &model.LinkPreview{FetchedAt: time.Now()}
+	contentType := res.Header.Get("Content-Type")
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "text/html" || mediaType == "application/xhtml+xml" || contentType == "" {
+		// Content-Typeとmetaのcharsetに従ってUTF-8に変換する
+		r, err := charset.NewReader(io.LimitReader(res.Body, f.config.MaxBodySize), contentType)
+		if err != nil {
+			return nil, err
+		}
+		p, base = parse(r, base)
+		p.FetchedAt = time.Now()
+	}
+	if p.Favicon == "" {
+		p.Favicon = resolve(base, "/favicon.ico")
+	}
+	p.Truncate()
+	return p, nil
+}
+
+// resolve resolves the reference against the base, or returns "" if it is not a http(s) URL
+func resolve(base *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
@@ -0,0 +1,166 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/netguard"
+)
+
+func testConfig() Config {
+	return Config{Timeout: time.Second, MaxBodySize: 4096, UserAgent: "anony-test", AllowPrivate: true}
+}
+
+func Test_fetcher_Fetch(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        model.LinkPreview // {{host}}はテストサーバーのURLに置き換える
+		wantErr     bool
+	}{
+		{
+			name:        "NORMAL: OpenGraphを優先する",
+			contentType: "text/html; charset=utf-8",
+			body: `<!DOCTYPE html><html><head>
+				<title>Page Title</title>
+				<meta name="description" content="meta description">
+				<meta property="og:title" content="OG &amp; Title">
+				<meta property="og:description" content="og description">
+				<meta property="og:image" content="/images/og.png">
+				<link rel="shortcut icon" href="/static/favicon.png">
+				</head><body><meta property="og:title" content="ignored"></body></html>`,
+			want: model.LinkPreview{
+				Title:       "OG & Title",
+				Description: "og description",
+				Image:       "{{host}}/images/og.png",
+				Favicon:     "{{host}}/static/favicon.png",
+			},
+		},
+		{
+			name:        "NORMAL: OpenGraphが無い場合は<title>とmeta description",
+			contentType: "text/html",
+			body: `<html><head><title>
+				Example &lt;Domain&gt;
+				</title><meta name="Description" content="meta description">
+				<base href="https://cdn.example.com/assets/">
+				<link rel="apple-touch-icon" href="touch.png"></head></html>`,
+			want: model.LinkPreview{
+				Title:       "Example <Domain>",
+				Description: "meta description",
+				Favicon:     "https://cdn.example.com/assets/touch.png",
+			},
+		},
+		{
+			name:        "NORMAL: faviconが無い場合は/favicon.ico",
+			contentType: "text/html",
+			body:        `<html><head><title>t</title><link rel="icon" href="javascript:alert(1)"></head></html>`,
+			want:        model.LinkPreview{Title: "t", Favicon: "{{host}}/favicon.ico"},
+		},
+		{
+			name:        "NORMAL: サイズの上限以降は読まない",
+			contentType: "text/html",
+			body:        `<html><head><title>t</title>` + strings.Repeat(" ", 4096) + `<meta property="og:title" content="too late"></head></html>`,
+			want:        model.LinkPreview{Title: "t", Favicon: "{{host}}/favicon.ico"},
+		},
+		{
+			name:        "NORMAL: Shift_JISのページ",
+			contentType: "text/html; charset=Shift_JIS",
+			// 「日本語」のShift_JIS
+			body: "<html><head><title>\x93\xfa\x96\x7b\x8c\xea</title></head></html>",
+			want: model.LinkPreview{Title: "日本語", Favicon: "{{host}}/favicon.ico"},
+		},
+		{
+			name:        "NORMAL: HTMLでない場合はfaviconのみ",
+			contentType: "application/pdf",
+			body:        "%PDF-1.4",
+			want:        model.LinkPreview{Favicon: "{{host}}/favicon.ico"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.Write([]byte(tt.body))
+			}))
+			defer ts.Close()
+
+			got, err := NewFetcher(testConfig()).Fetch(context.Background(), ts.URL+"/page")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fetcher.Fetch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.FetchedAt.IsZero() {
+				t.Errorf("fetcher.Fetch() FetchedAt is zero")
+			}
+			want := tt.want
+			want.Image = strings.Replace(want.Image, "{{host}}", ts.URL, 1)
+			want.Favicon = strings.Replace(want.Favicon, "{{host}}", ts.URL, 1)
+			want.FetchedAt = got.FetchedAt
+			if *got != want {
+				t.Errorf("fetcher.Fetch() = %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func Test_fetcher_Fetch_Redirect(t *testing.T) {
+	final := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><meta property="og:image" content="og.png"></head></html>`))
+	}))
+	defer final.Close()
+	ts := httptest.NewServer(http.RedirectHandler(final.URL+"/articles/1", http.StatusFound))
+	defer ts.Close()
+
+	got, err := NewFetcher(testConfig()).Fetch(context.Background(), ts.URL)
+	if err != nil {
+		t.Fatalf("fetcher.Fetch() error = %v", err)
+	}
+	// リダイレクト先のURLを基準に解決する
+	if want := final.URL + "/articles/og.png"; got.Image != want {
+		t.Errorf("fetcher.Fetch() Image = %v, want %v", got.Image, want)
+	}
+}
+
+func Test_fetcher_Fetch_Error(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		http.NotFound(w, r)
+	}))
+	defer ts.Close()
+
+	if _, err := NewFetcher(testConfig()).Fetch(context.Background(), ts.URL+"/missing"); err == nil {
+		t.Errorf("fetcher.Fetch() error = nil, want error for 404")
+	}
+
+	c := testConfig()
+	c.Timeout = 50 * time.Millisecond
+	if _, err := NewFetcher(c).Fetch(context.Background(), ts.URL+"/slow"); err == nil {
+		t.Errorf("fetcher.Fetch() error = nil, want timeout")
+	}
+}
+
+func Test_fetcher_Fetch_PrivateAddress(t *testing.T) {
+	requested := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer ts.Close()
+
+	c := testConfig()
+	c.AllowPrivate = false
+	if _, err := NewFetcher(c).Fetch(context.Background(), ts.URL); !errors.Is(err, netguard.ErrNonPublicAddress) {
+		t.Errorf("fetcher.Fetch() error = %v, want %v", err, netguard.ErrNonPublicAddress)
+	}
+	if requested {
+		t.Errorf("fetcher.Fetch() requested the loopback address")
+	}
+}
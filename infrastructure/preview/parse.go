@@ -0,0 +1,122 @@
+package preview
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// head is the metadata found in <head>
+type head struct {
+	title        string
+	meta         map[string]string // propertyまたはnameの小文字をキーにする. 最初に現れた値を使う
+	icon         string
+	appleIcon    string
+	baseHref     string
+	inTitle      bool
+	titleDone    bool
+	titleBuilder strings.Builder
+}
+
+// parse parses the page until </head> and returns the preview and the base URL of relative references
+func parse(r io.Reader, base *url.URL) (*model.LinkPreview, *url.URL) {
+	h := &head{meta: map[string]string{}}
+	z := html.NewTokenizer(r)
+loop:
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// EOFまたはサイズの上限に達した場合は, それまでに見つかった値を使う
+			break loop
+		case html.TextToken:
+			if h.inTitle {
+				h.titleBuilder.Write(z.Text())
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				h.endTitle()
+			case atom.Head:
+				break loop
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Body {
+				break loop
+			}
+			attrs := map[string]string{}
+			for hasAttr {
+				var k, v []byte
+				k, v, hasAttr = z.TagAttr()
+				attrs[string(k)] = string(v)
+			}
+			h.startTag(a, attrs)
+		}
+	}
+	h.endTitle()
+
+	if h.baseHref != "" {
+		if u, err := base.Parse(h.baseHref); err == nil {
+			base = u
+		}
+	}
+	p := &model.LinkPreview{
+		Title:       firstNonEmpty(h.meta["og:title"], h.meta["twitter:title"], h.title),
+		Description: firstNonEmpty(h.meta["og:description"], h.meta["twitter:description"], h.meta["description"]),
+		Image:       resolve(base, firstNonEmpty(h.meta["og:image"], h.meta["og:image:url"], h.meta["twitter:image"])),
+		Favicon:     resolve(base, firstNonEmpty(h.icon, h.appleIcon)),
+	}
+	return p, base
+}
+
+func (h *head) startTag(a atom.Atom, attrs map[string]string) {
+	switch a {
+	case atom.Title:
+		if !h.titleDone {
+			h.inTitle = true
+		}
+	case atom.Meta:
+		key := strings.ToLower(firstNonEmpty(attrs["property"], attrs["name"]))
+		if _, ok := h.meta[key]; key != "" && !ok {
+			h.meta[key] = strings.TrimSpace(attrs["content"])
+		}
+	case atom.Link:
+		href := strings.TrimSpace(attrs["href"])
+		for _, rel := range strings.Fields(strings.ToLower(attrs["rel"])) {
+			switch {
+			case rel == "icon" && h.icon == "":
+				h.icon = href
+			case (rel == "apple-touch-icon" || rel == "apple-touch-icon-precomposed") && h.appleIcon == "":
+				h.appleIcon = href
+			}
+		}
+	case atom.Base:
+		if h.baseHref == "" {
+			h.baseHref = strings.TrimSpace(attrs["href"])
+		}
+	}
+}
+
+func (h *head) endTitle() {
+	if h.inTitle {
+		h.title = h.titleBuilder.String()
+		h.inTitle = false
+		h.titleDone = true
+	}
+}
+
+func firstNonEmpty(vs ...string) string {
+	for _, v := range vs {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,542 @@
+package qrcode
+
+import (
+	"bytes"
+	"image/png"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// specAlignmentPositions is the table of the centers of alignment patterns in the specification
+// テストで使うバージョンのみ
+var specAlignmentPositions = map[int][]int{
+	1:  nil,
+	2:  {6, 18},
+	3:  {6, 22},
+	4:  {6, 26},
+	7:  {6, 22, 38},
+	8:  {6, 24, 42},
+	13: {6, 34, 62},
+	40: {6, 30, 58, 86, 114, 142, 170},
+}
+
+// gfExp and gfLog are the tables of the powers of 2 in GF(2^8) and their logarithms
+// エンコーダのgfMultiplyとは別の方法で計算する
+var gfExp, gfLog = func() ([256]byte, [256]int) {
+	var exp [256]byte
+	var log [256]int
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		log[x] = i
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+	return exp, log
+}()
+
+func gfMul(x, y byte) byte {
+	if x == 0 || y == 0 {
+		return 0
+	}
+	return gfExp[(gfLog[x]+gfLog[y])%255]
+}
+
+// decode reads the text from the module grid. エンコーダとは独立に仕様から実装する
+func decode(t *testing.T, grid [][]bool) (string, Level, int) {
+	t.Helper()
+	size := len(grid)
+	if (size-17)%4 != 0 {
+		t.Fatalf("decode() size %d is invalid", size)
+	}
+	version := (size - 17) / 4
+
+	// 左上の形式情報 (ビット14から0の順)
+	coords := [15][2]int{
+		{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8},
+		{7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8},
+	}
+	format := 0
+	for i, c := range coords {
+		if grid[c[1]][c[0]] {
+			format |= 1 << uint(i)
+		}
+	}
+	// 右上と左下のコピーも一致すること
+	format2 := 0
+	for i := 0; i < 8; i++ {
+		if grid[8][size-1-i] {
+			format2 |= 1 << uint(i)
+		}
+	}
+	for i := 8; i < 15; i++ {
+		if grid[size-15+i][8] {
+			format2 |= 1 << uint(i)
+		}
+	}
+	if format != format2 {
+		t.Fatalf("decode() format %015b != %015b", format, format2)
+	}
+	format ^= 0x5412
+	// BCH(15,5)の検査
+	rem := format
+	for i := 14; i >= 10; i-- {
+		if rem&(1<<uint(i)) != 0 {
+			rem ^= 0x537 << uint(i-10)
+		}
+	}
+	if rem != 0 {
+		t.Fatalf("decode() format %015b has errors", format)
+	}
+	level := map[int]Level{1: LevelL, 0: LevelM, 3: LevelQ, 2: LevelH}[format>>13]
+	mask := format >> 10 & 7
+
+	// 機能パターンの領域
+	reserved := make([][]bool, size)
+	for i := range reserved {
+		reserved[i] = make([]bool, size)
+	}
+	fill := func(x0, y0, w, h int) {
+		for y := y0; y < y0+h; y++ {
+			for x := x0; x < x0+w; x++ {
+				reserved[y][x] = true
+			}
+		}
+	}
+	// ファインダパターン, 分離パターン, 形式情報
+	fill(0, 0, 9, 9)
+	fill(size-8, 0, 8, 9)
+	fill(0, size-8, 9, 8)
+	// タイミングパターン
+	fill(6, 0, 1, size)
+	fill(0, 6, size, 1)
+	pos, ok := specAlignmentPositions[version]
+	if !ok {
+		t.Fatalf("decode() version %d is not in the table of the test", version)
+	}
+	for _, y := range pos {
+		for _, x := range pos {
+			// ファインダパターンと重なる位置には無い
+			if (x < 9 && y < 9) || (x < 9 && y > size-9) || (x > size-9 && y < 9) {
+				continue
+			}
+			fill(x-2, y-2, 5, 5)
+		}
+	}
+	if version >= 7 {
+		fill(size-11, 0, 3, 6)
+		fill(0, size-11, 6, 3)
+	}
+
+	maskFuncs := [8]func(i, j int) bool{
+		func(i, j int) bool { return (i+j)%2 == 0 },
+		func(i, j int) bool { return i%2 == 0 },
+		func(i, j int) bool { return j%3 == 0 },
+		func(i, j int) bool { return (i+j)%3 == 0 },
+		func(i, j int) bool { return (i/2+j/3)%2 == 0 },
+		func(i, j int) bool { return (i*j)%2+(i*j)%3 == 0 },
+		func(i, j int) bool { return ((i*j)%2+(i*j)%3)%2 == 0 },
+		func(i, j int) bool { return ((i+j)%2+(i*j)%3)%2 == 0 },
+	}
+
+	// 右下から2列ずつ上下に往復して読む
+	var bits []bool
+	upward := true
+	for x := size - 1; x > 0; x -= 2 {
+		if x == 6 {
+			x--
+		}
+		for k := 0; k < size; k++ {
+			y := k
+			if upward {
+				y = size - 1 - k
+			}
+			for _, xx := range []int{x, x - 1} {
+				if reserved[y][xx] {
+					continue
+				}
+				bits = append(bits, grid[y][xx] != maskFuncs[mask](y, xx))
+			}
+		}
+		upward = !upward
+	}
+	codewords := make([]byte, len(bits)/8)
+	for i := range codewords {
+		for _, b := range bits[i*8 : i*8+8] {
+			codewords[i] <<= 1
+			if b {
+				codewords[i] |= 1
+			}
+		}
+	}
+
+	// デインターリーブ
+	numBlocks := numErrorCorrectionBlocks[level][version]
+	eccLen := eccCodewordsPerBlock[level][version]
+	numLong := len(codewords) % numBlocks
+	shortData := len(codewords)/numBlocks - eccLen
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	for i := 0; i < shortData+1; i++ {
+		for j := range blocks {
+			if i == shortData && j < numBlocks-numLong {
+				continue
+			}
+			blocks[j] = append(blocks[j], codewords[k])
+			k++
+		}
+	}
+	for i := 0; i < eccLen; i++ {
+		for j := range blocks {
+			blocks[j] = append(blocks[j], codewords[k])
+			k++
+		}
+	}
+
+	var data []byte
+	for j, block := range blocks {
+		// シンドロームが全て0なら誤りは無い
+		for i := 0; i < eccLen; i++ {
+			alpha := gfExp[i]
+			var s byte
+			for _, c := range block {
+				s = gfMul(s, alpha) ^ c
+			}
+			if s != 0 {
+				t.Fatalf("decode() block %d has a syndrome %d", j, s)
+			}
+		}
+		data = append(data, block[:len(block)-eccLen]...)
+	}
+
+	if data[0]>>4 != 0x4 {
+		t.Fatalf("decode() mode %x is not the byte mode", data[0]>>4)
+	}
+	countBits := 8
+	if version >= 10 {
+		countBits = 16
+	}
+	r := bitReader{data: data, pos: 4}
+	n := r.read(countBits)
+	text := make([]byte, n)
+	for i := range text {
+		text[i] = byte(r.read(8))
+	}
+	return string(text), level, mask
+}
+
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) read(n int) int {
+	v := 0
+	for i := 0; i < n; i++ {
+		v = v<<1 | int(r.data[r.pos>>3]>>uint(7-r.pos&7)&1)
+		r.pos++
+	}
+	return v
+}
+
+// gridFromPNG samples the modules from the centers in the PNG image
+func gridFromPNG(t *testing.T, b []byte) [][]bool {
+	t.Helper()
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	bounds := img.Bounds()
+	dark := func(x, y int) bool {
+		r, _, _, _ := img.At(x, y).RGBA()
+		return r < 0x8000
+	}
+	// 左上のファインダパターンの外枠の幅は7モジュール
+	left, top := -1, -1
+	for y := bounds.Min.Y; y < bounds.Max.Y && left < 0; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if dark(x, y) {
+				left, top = x, y
+				break
+			}
+		}
+	}
+	if left < 0 {
+		t.Fatalf("gridFromPNG() image has no dark pixels")
+	}
+	right := left
+	for dark(right, top) {
+		right++
+	}
+	scale := (right - left) / 7
+	// 右上のファインダパターンの右端までがシンボルの幅
+	end := bounds.Max.X - 1
+	for !dark(end, top) {
+		end--
+	}
+	size := (end - left + 1) / scale
+	grid := make([][]bool, size)
+	for y := range grid {
+		grid[y] = make([]bool, size)
+		for x := range grid[y] {
+			grid[y][x] = dark(left+x*scale+scale/2, top+y*scale+scale/2)
+		}
+	}
+	return grid
+}
+
+var (
+	svgViewBox = regexp.MustCompile(`viewBox="0 0 (\d+) (\d+)"`)
+	svgModule  = regexp.MustCompile(`M(\d+),(\d+)h1v1h-1z`)
+)
+
+// gridFromSVG reads the modules from the path in the SVG image
+func gridFromSVG(t *testing.T, b []byte, margin int) [][]bool {
+	t.Helper()
+	m := svgViewBox.FindSubmatch(b)
+	if m == nil {
+		t.Fatalf("gridFromSVG() viewBox is not found")
+	}
+	n, _ := strconv.Atoi(string(m[1]))
+	size := n - margin*2
+	grid := make([][]bool, size)
+	for y := range grid {
+		grid[y] = make([]bool, size)
+	}
+	for _, m := range svgModule.FindAllSubmatch(b, -1) {
+		x, _ := strconv.Atoi(string(m[1]))
+		y, _ := strconv.Atoi(string(m[2]))
+		grid[y-margin][x-margin] = true
+	}
+	return grid
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		level       Level
+		wantVersion int
+	}{
+		{name: "NORMAL: 短いURL", text: "https://anony.example/abc", level: LevelM, wantVersion: 2},
+		{name: "NORMAL: レベルL", text: "https://anony.example/abc", level: LevelL, wantVersion: 2},
+		{name: "NORMAL: レベルHは大きくなる", text: "https://anony.example/abc", level: LevelH, wantVersion: 4},
+		{name: "NORMAL: バージョン1の上限", text: strings.Repeat("a", 17), level: LevelL, wantVersion: 1},
+		{name: "NORMAL: バージョン1に収まらない", text: strings.Repeat("a", 18), level: LevelL, wantVersion: 2},
+		{name: "NORMAL: 複数ブロック", text: strings.Repeat("0123456789", 10), level: LevelQ, wantVersion: 8},
+		{name: "NORMAL: 文字数が16bit", text: strings.Repeat("x", 300), level: LevelM, wantVersion: 13},
+		{name: "NORMAL: UTF-8", text: "https://anony.example/日本語", level: LevelM, wantVersion: 3},
+		{name: "NORMAL: バージョン40の上限", text: strings.Repeat("z", 2953), level: LevelL, wantVersion: 40},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Encode(tt.text, tt.level)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if c.Version != tt.wantVersion {
+				t.Errorf("Encode() version = %v, want %v", c.Version, tt.wantVersion)
+			}
+			if c.Size() != tt.wantVersion*4+17 {
+				t.Errorf("Encode() size = %v", c.Size())
+			}
+
+			b, err := c.PNG(c.Size()*3+8*3, 4)
+			if err != nil {
+				t.Fatalf("Code.PNG() error = %v", err)
+			}
+			text, level, mask := decode(t, gridFromPNG(t, b))
+			if text != tt.text {
+				t.Errorf("decode(PNG) text = %q, want %q", text, tt.text)
+			}
+			if level != tt.level || mask != c.Mask {
+				t.Errorf("decode(PNG) level, mask = %v, %v, want %v, %v", level, mask, tt.level, c.Mask)
+			}
+
+			b, err = c.SVG(256, 2)
+			if err != nil {
+				t.Fatalf("Code.SVG() error = %v", err)
+			}
+			if text, _, _ := decode(t, gridFromSVG(t, b, 2)); text != tt.text {
+				t.Errorf("decode(SVG) text = %q, want %q", text, tt.text)
+			}
+		})
+	}
+}
+
+// goldenMatrix is "https://anony.example/abc" at the level M (version 2, mask 3)
+// 別に実装したエンコーダで作成した値. #が暗いモジュール
+const goldenMatrix = `
+#######.#..#.#..#.#######
+#.....#.#.#.....#.#.....#
+#.###.#..#..###.#.#.###.#
+#.###.#.###.####..#.###.#
+#.###.#........##.#.###.#
+#.....#..###.#..#.#.....#
+#######.#.#.#.#.#.#######
+........#.....#..........
+#.##.###...##.##..#..#.##
+.#..#..#....##..#..#...#.
+.#...####.#.#...#.###....
+##..##.##...##....##.##..
+##....#.##..####.##.#.###
+..#.....##.##.#######...#
+.#.#####...#.##.###.#.##.
+#..#...#.###..#.##.##...#
+..##..##..#..############
+........###.....#...#.#.#
+#######.##...##.#.#.#.###
+#.....#.###.....#...#....
+#.###.#.......#.######..#
+#.###.#.#..#.....##.#####
+#.###.#.####..#.###.#.##.
+#.....#..#.#.###.##.#.#..
+#######.###.###....######
+`
+
+func TestEncode_Golden(t *testing.T) {
+	c, err := Encode("https://anony.example/abc", LevelM)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	want := strings.Split(strings.TrimSpace(goldenMatrix), "\n")
+	if c.Size() != len(want) || c.Mask != 3 {
+		t.Fatalf("Encode() size, mask = %v, %v, want %v, 3", c.Size(), c.Mask, len(want))
+	}
+	for y, row := range want {
+		got := make([]byte, c.Size())
+		for x := range got {
+			got[x] = '.'
+			if c.Dark(x, y) {
+				got[x] = '#'
+			}
+		}
+		if string(got) != row {
+			t.Errorf("Encode() row %d = %s, want %s", y, got, row)
+		}
+	}
+}
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// "HELLO WORLD"の1-Mのデータコード語と誤り訂正コード語. QRコードの解説で広く使われている例
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := reedSolomonRemainder(data, reedSolomonDivisor(len(want))); !reflect.DeepEqual(got, want) {
+		t.Errorf("reedSolomonRemainder() = %v, want %v", got, want)
+	}
+}
+
+func TestEncode_Error(t *testing.T) {
+	if _, err := Encode(strings.Repeat("z", 2954), LevelL); err != ErrTooLong {
+		t.Errorf("Encode() error = %v, want %v", err, ErrTooLong)
+	}
+	if _, err := Encode("abc", Level(4)); err == nil {
+		t.Errorf("Encode() error = nil, want error")
+	}
+}
+
+func TestCode_PNG(t *testing.T) {
+	c, err := Encode("https://anony.example/abc", LevelM)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	b, err := c.PNG(256, 4)
+	if err != nil {
+		t.Fatalf("Code.PNG() error = %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if got := img.Bounds().Size(); got.X != 256 || got.Y != 256 {
+		t.Errorf("Code.PNG() size = %v", got)
+	}
+	// 25+8=33モジュールを7pxずつ描き, 余りの25pxを両側に分ける
+	if r, _, _, _ := img.At(39, 40).RGBA(); r == 0 {
+		t.Errorf("Code.PNG() quiet zone is dark")
+	}
+	if r, _, _, _ := img.At(40, 40).RGBA(); r != 0 {
+		t.Errorf("Code.PNG() finder pattern is not dark")
+	}
+	if _, err := c.PNG(32, 4); err != ErrTooSmall {
+		t.Errorf("Code.PNG() error = %v, want %v", err, ErrTooSmall)
+	}
+	if _, err := c.PNG(256, -1); err == nil {
+		t.Errorf("Code.PNG() error = nil, want error")
+	}
+}
+
+func TestFormatInfo(t *testing.T) {
+	// 仕様書の表の値
+	tests := []struct {
+		level Level
+		mask  int
+		want  int
+	}{
+		{LevelL, 0, 0x77C4},
+		{LevelM, 0, 0x5412},
+		{LevelQ, 0, 0x355F},
+		{LevelH, 7, 0x083B},
+	}
+	for _, tt := range tests {
+		if got := formatInfo(tt.level, tt.mask); got != tt.want {
+			t.Errorf("formatInfo(%v, %v) = %015b, want %015b", tt.level, tt.mask, got, tt.want)
+		}
+	}
+}
+
+func TestNumDataCodewords(t *testing.T) {
+	tests := []struct {
+		version int
+		level   Level
+		want    int
+	}{
+		{1, LevelL, 19},
+		{1, LevelM, 16},
+		{1, LevelH, 9},
+		{5, LevelQ, 62},
+		{7, LevelH, 66},
+		{10, LevelM, 216},
+		{40, LevelL, 2956},
+		{40, LevelH, 1276},
+	}
+	for _, tt := range tests {
+		if got := numDataCodewords(tt.version, tt.level); got != tt.want {
+			t.Errorf("numDataCodewords(%v, %v) = %v, want %v", tt.version, tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestAlignmentPositions(t *testing.T) {
+	tests := []struct {
+		version int
+		want    []int
+	}{
+		{1, nil},
+		{2, []int{6, 18}},
+		{7, []int{6, 22, 38}},
+		{32, []int{6, 34, 60, 86, 112, 138}},
+		{36, []int{6, 24, 50, 76, 102, 128, 154}},
+	}
+	for _, tt := range tests {
+		if got := alignmentPositions(tt.version); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("alignmentPositions(%v) = %v, want %v", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for s, want := range map[string]Level{"": LevelM, "l": LevelL, "M": LevelM, "q": LevelQ, "H": LevelH} {
+		if got, err := ParseLevel(s); err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("X"); err == nil {
+		t.Errorf("ParseLevel() error = nil, want error")
+	}
+}
@@ -0,0 +1,43 @@
+package qrcode
+
+// gfMultiply multiplies the elements of GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>uint(i))&1) * int(x)
+	}
+	return byte(z)
+}
+
+// reedSolomonDivisor returns the coefficients of the generator polynomial of the degree
+// 最高次の係数(常に1)は省略し, 次数の高い順に並べる
+func reedSolomonDivisor(degree int) []byte {
+	res := make([]byte, degree)
+	res[degree-1] = 1
+	var root byte = 1
+	for i := 0; i < degree; i++ {
+		for j := range res {
+			res[j] = gfMultiply(res[j], root)
+			if j+1 < len(res) {
+				res[j] ^= res[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return res
+}
+
+// reedSolomonRemainder returns the error correction codewords of the data
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	res := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ res[0]
+		copy(res, res[1:])
+		res[len(res)-1] = 0
+		for i := range res {
+			res[i] ^= gfMultiply(divisor[i], factor)
+		}
+	}
+	return res
+}
@@ -0,0 +1,82 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// ErrTooSmall is returned when the image cannot draw each module with one pixel or more
+var ErrTooSmall = errors.New("image size is too small for the QR code")
+
+// scale returns the pixels of a module and the offset to center the symbol
+func (c *Code) scale(size, margin int) (int, int, error) {
+	if margin < 0 {
+		return 0, 0, fmt.Errorf("margin %d is invalid", margin)
+	}
+	n := c.size + margin*2
+	scale := size / n
+	if scale < 1 {
+		return 0, 0, ErrTooSmall
+	}
+	return scale, (size - scale*c.size) / 2, nil
+}
+
+// PNG renders the code into a PNG image of size x size pixels with the quiet zone of margin modules
+func (c *Code) PNG(size, margin int) ([]byte, error) {
+	scale, offset, err := c.scale(size, margin)
+	if err != nil {
+		return nil, err
+	}
+	// 白黒2色のパレット画像にする
+	img := image.NewPaletted(image.Rect(0, 0, size, size), color.Palette{color.White, color.Black})
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if !c.modules[y][x] {
+				continue
+			}
+			for py := 0; py < scale; py++ {
+				row := img.Pix[(offset+y*scale+py)*img.Stride:]
+				for px := 0; px < scale; px++ {
+					row[offset+x*scale+px] = 1
+				}
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SVG renders the code into an SVG image of size x size pixels with the quiet zone of margin modules
+func (c *Code) SVG(size, margin int) ([]byte, error) {
+	if size < 1 {
+		return nil, ErrTooSmall
+	}
+	if margin < 0 {
+		return nil, fmt.Errorf("margin %d is invalid", margin)
+	}
+	// viewBoxはモジュール単位なので, 拡大してもぼやけない
+	var path strings.Builder
+	for y := 0; y < c.size; y++ {
+		for x := 0; x < c.size; x++ {
+			if c.modules[y][x] {
+				fmt.Fprintf(&path, "M%d,%dh1v1h-1z", x+margin, y+margin)
+			}
+		}
+	}
+	n := c.size + margin*2
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+"\n", size, size, n, n)
+	buf.WriteString(`<rect width="100%" height="100%" fill="#FFFFFF"/>` + "\n")
+	fmt.Fprintf(&buf, `<path d="%s" fill="#000000"/>`+"\n", path.String())
+	buf.WriteString("</svg>\n")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BanList bans clients by the IP addresses and CIDR ranges listed in a local file
+// ファイルは1行に1つのIPアドレスかCIDRで, 空行と#から始まる行は読み飛ばす
+type BanList struct {
+	mu      sync.RWMutex
+	path    string
+	modTime time.Time
+	nets    []*net.IPNet
+}
+
+// NewBanList loads a ban list from the file
+func NewBanList(path string) (*BanList, error) {
+	l := &BanList{path: path}
+	if _, err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload reloads the file if it is modified
+// 不正な行がある場合は, 直前に読み込んだリストを使い続ける
+func (l *BanList) Reload() (bool, error) {
+	fi, err := os.Stat(l.path)
+	if err != nil {
+		return false, err
+	}
+	l.mu.RLock()
+	modified := !fi.ModTime().Equal(l.modTime)
+	l.mu.RUnlock()
+	if !modified {
+		return false, nil
+	}
+	file, err := os.Open(l.path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	nets := []*net.IPNet{}
+	sc := bufio.NewScanner(file)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		n, err := parseIPNet(line)
+		if err != nil {
+			return false, fmt.Errorf("%s: %s", l.path, err)
+		}
+		nets = append(nets, n)
+	}
+	if err := sc.Err(); err != nil {
+		return false, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.nets = nets
+	l.modTime = fi.ModTime()
+	return true, nil
+}
+
+// Watch reloads the file every interval until ctx is done
+// 読み込めない場合はログに残し, 直前に読み込んだリストを使い続ける
+func (l *BanList) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := l.Reload(); err != nil {
+				log.Printf("failed to reload the ban list: %v", err)
+			}
+		}
+	}
+}
+
+// Banned reports whether the IP address is in the list
+func (l *BanList) Banned(ip net.IP) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, n := range l.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIPNet parses an IP address as a single address range, or a CIDR
+func parseIPNet(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("IP address %q is invalid", s)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("CIDR %q is invalid", s)
+	}
+	return n, nil
+}
@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeBanList(t *testing.T, path, data string, modTime time.Time) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBanList_Banned(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banlist.txt")
+	writeBanList(t, path, "# scanners\n192.0.2.1\n198.51.100.0/24\n\n2001:db8::/32\n", time.Now())
+	l, err := NewBanList(path)
+	if err != nil {
+		t.Fatalf("NewBanList() error = %v", err)
+	}
+	tests := []struct {
+		name string
+		ip   string
+		want bool
+	}{
+		{name: "NORMAL: 一致するIPアドレス", ip: "192.0.2.1", want: true},
+		{name: "NORMAL: 隣のIPアドレス", ip: "192.0.2.2", want: false},
+		{name: "NORMAL: CIDRに含まれるIPアドレス", ip: "198.51.100.200", want: true},
+		{name: "NORMAL: IPv6のCIDR", ip: "2001:db8::1", want: true},
+		{name: "NORMAL: IPv4射影アドレス", ip: "::ffff:192.0.2.1", want: true},
+		{name: "NORMAL: 含まれないIPv6アドレス", ip: "2001:db9::1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := l.Banned(net.ParseIP(tt.ip)); got != tt.want {
+				t.Errorf("BanList.Banned() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBanList_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banlist.txt")
+	now := time.Now()
+	writeBanList(t, path, "192.0.2.1\n", now.Add(-time.Minute))
+	l, err := NewBanList(path)
+	if err != nil {
+		t.Fatalf("NewBanList() error = %v", err)
+	}
+
+	if changed, err := l.Reload(); changed || err != nil {
+		t.Errorf("BanList.Reload() = %v, %v, want false when the file is not modified", changed, err)
+	}
+
+	// 不正な行がある場合は直前のリストを使い続ける
+	writeBanList(t, path, "192.0.2.2\nscanner\n", now)
+	if _, err := l.Reload(); err == nil {
+		t.Errorf("BanList.Reload() error = nil, want error")
+	}
+	if !l.Banned(net.ParseIP("192.0.2.1")) || l.Banned(net.ParseIP("192.0.2.2")) {
+		t.Errorf("BanList.Banned() uses the invalid list")
+	}
+
+	writeBanList(t, path, "192.0.2.2\n", now.Add(time.Minute))
+	if changed, err := l.Reload(); !changed || err != nil {
+		t.Fatalf("BanList.Reload() = %v, %v, want true", changed, err)
+	}
+	if l.Banned(net.ParseIP("192.0.2.1")) || !l.Banned(net.ParseIP("192.0.2.2")) {
+		t.Errorf("BanList.Banned() does not use the reloaded list")
+	}
+}
+
+func TestBanList_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banlist.txt")
+	now := time.Now()
+	writeBanList(t, path, "192.0.2.1\n", now.Add(-time.Minute))
+	l, err := NewBanList(path)
+	if err != nil {
+		t.Fatalf("NewBanList() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		l.Watch(ctx, time.Millisecond)
+		close(done)
+	}()
+
+	writeBanList(t, path, "192.0.2.2\n", now)
+	deadline := time.Now().Add(time.Second)
+	for !l.Banned(net.ParseIP("192.0.2.2")) {
+		if time.Now().After(deadline) {
+			t.Fatalf("BanList.Watch() did not reload the list")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if l.Banned(net.ParseIP("192.0.2.1")) {
+		t.Errorf("BanList.Banned() uses the old list")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("BanList.Watch() did not return after ctx is done")
+	}
+}
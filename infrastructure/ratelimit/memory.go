@@ -0,0 +1,67 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// 満タンに戻ったバケットを削除する間隔
+const memorySweepInterval = time.Minute
+
+// MemoryStore is a Store holding the buckets in the process
+// 複数のプロセスで動かす場合は, プロセスごとに制限される
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+}
+
+type memoryBucket struct {
+	bucket *Bucket
+	limit  Limit
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]*memoryBucket{}}
+}
+
+// Take takes a token from the bucket of the key
+func (s *MemoryStore) Take(ctx context.Context, key string, l Limit, now time.Time) (Result, error) {
+	if l.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sweep(now)
+	var b *Bucket
+	if v, ok := s.buckets[key]; ok {
+		b = v.bucket
+	}
+	b, res := take(b, l, now)
+	s.buckets[key] = &memoryBucket{bucket: b, limit: l}
+	return res, nil
+}
+
+// Len returns the number of the buckets
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
+
+// sweep deletes the buckets refilled since the last update, which are the same as new ones
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for k, v := range s.buckets {
+		if !now.Before(v.bucket.UpdatedAt.Add(fullAfter(v.bucket, v.limit))) {
+			delete(s.buckets, k)
+		}
+	}
+}
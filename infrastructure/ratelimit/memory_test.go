@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	l := Limit{Rate: 1, Burst: 2}
+	s := NewMemoryStore()
+	want := []bool{true, true, false}
+	for i, w := range want {
+		got, err := s.Take(context.Background(), "ip:192.0.2.1", l, now)
+		if err != nil || got.Allowed != w {
+			t.Fatalf("MemoryStore.Take() #%d = %+v, %v, want allowed %v", i, got, err, w)
+		}
+	}
+	// キーごとに別のバケット
+	if got, _ := s.Take(context.Background(), "ip:192.0.2.2", l, now); !got.Allowed {
+		t.Errorf("MemoryStore.Take() of another key = %+v, want allowed", got)
+	}
+	if got, _ := s.Take(context.Background(), "ip:192.0.2.1", l, now.Add(time.Second)); !got.Allowed {
+		t.Errorf("MemoryStore.Take() after refill = %+v, want allowed", got)
+	}
+	// 満タンに戻ったバケットは削除する
+	s.Take(context.Background(), "ip:192.0.2.3", l, now.Add(time.Hour))
+	if s.Len() != 1 {
+		t.Errorf("MemoryStore.Len() = %v, want 1", s.Len())
+	}
+}
+
+func TestMemoryStore_Take_Unlimited(t *testing.T) {
+	s := NewMemoryStore()
+	for i := 0; i < 100; i++ {
+		if got, _ := s.Take(context.Background(), "key", Limit{}, time.Now()); !got.Allowed {
+			t.Fatalf("MemoryStore.Take() = %+v, want allowed", got)
+		}
+	}
+	if s.Len() != 0 {
+		t.Errorf("MemoryStore.Len() = %v, want 0", s.Len())
+	}
+}
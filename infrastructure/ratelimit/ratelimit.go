@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token bucket refilled Rate tokens per second up to Burst tokens
+type Limit struct {
+	Rate  float64
+	Burst int64
+}
+
+// Unlimited reports whether the limit does not restrict requests
+func (l Limit) Unlimited() bool {
+	return l.Rate <= 0
+}
+
+// Result is the result of taking a token
+type Result struct {
+	Allowed bool
+	// 残りのトークン数
+	Remaining int64
+	// 拒否した場合に, 次のトークンが貯まるまでの時間
+	RetryAfter time.Duration
+}
+
+// Store is a backend holding the token buckets
+// 複数のプロセスで制限を共有する場合はSharedStoreを使う
+type Store interface {
+	// Take takes a token from the bucket of the key
+	Take(ctx context.Context, key string, l Limit, now time.Time) (Result, error)
+}
+
+// Bucket is the state of a token bucket
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// take refills the bucket since the last update and takes a token
+// nilのバケットは満タンとして扱う
+func take(b *Bucket, l Limit, now time.Time) (*Bucket, Result) {
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	tokens := burst
+	if b != nil {
+		tokens = b.Tokens
+		if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+			tokens = math.Min(burst, tokens+elapsed*l.Rate)
+		}
+	}
+	if tokens < 1 {
+		wait := time.Duration((1 - tokens) / l.Rate * float64(time.Second))
+		return &Bucket{Tokens: tokens, UpdatedAt: now}, Result{Allowed: false, RetryAfter: wait}
+	}
+	tokens--
+	return &Bucket{Tokens: tokens, UpdatedAt: now}, Result{Allowed: true, Remaining: int64(tokens)}
+}
+
+// fullAfter returns the duration until the bucket is refilled, after which it is the same as a new bucket
+func fullAfter(b *Bucket, l Limit) time.Duration {
+	burst := math.Max(float64(l.Burst), 1)
+	return time.Duration((burst - b.Tokens) / l.Rate * float64(time.Second))
+}
+
+// DefaultKey is the key of the limit applied to the methods without their own limit
+const DefaultKey = "*"
+
+// Limits is the limits for each key such as gRPC method names and DefaultKey
+type Limits map[string]Limit
+
+// For returns the limit of the name and the key of its bucket
+// 個別の制限がない名前はDefaultKeyの制限とバケットを共有する
+func (ls Limits) For(name string) (string, Limit) {
+	if l, ok := ls[name]; ok {
+		return name, l
+	}
+	return DefaultKey, ls[DefaultKey]
+}
+
+// ParseLimits parses limits in the form of "<key>=<rate per second>:<burst>,..."
+// 例: "*=10:20,/anony.AnonyService/CreateAnonyURL=0.5:5". 空文字の場合は制限なし
+func ParseLimits(s string) (Limits, error) {
+	ls := Limits{}
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("limit %q is not <key>=<rate>:<burst>", v)
+		}
+		rb := strings.SplitN(kv[1], ":", 2)
+		if len(rb) != 2 {
+			return nil, fmt.Errorf("limit %q is not <key>=<rate>:<burst>", v)
+		}
+		rate, err := strconv.ParseFloat(rb[0], 64)
+		if err != nil || rate < 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+			return nil, fmt.Errorf("rate of %q is invalid", v)
+		}
+		burst, err := strconv.ParseInt(rb[1], 10, 64)
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("burst of %q is invalid", v)
+		}
+		ls[strings.TrimSpace(kv[0])] = Limit{Rate: rate, Burst: burst}
+	}
+	return ls, nil
+}
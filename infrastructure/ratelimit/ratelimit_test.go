@@ -0,0 +1,72 @@
+package ratelimit
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_take(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	l := Limit{Rate: 2, Burst: 3}
+	tests := []struct {
+		name string
+		b    *Bucket
+		want Result
+	}{
+		{name: "NORMAL: 新しいバケットは満タン", b: nil, want: Result{Allowed: true, Remaining: 2}},
+		{name: "NORMAL: 経過時間の分だけ補充する", b: &Bucket{Tokens: 0, UpdatedAt: now.Add(-time.Second)}, want: Result{Allowed: true, Remaining: 1}},
+		{name: "NORMAL: Burstより多くは貯まらない", b: &Bucket{Tokens: 0, UpdatedAt: now.Add(-time.Hour)}, want: Result{Allowed: true, Remaining: 2}},
+		{name: "ERROR: トークンがない場合は次のトークンまでの時間", b: &Bucket{Tokens: 0.5, UpdatedAt: now}, want: Result{Allowed: false, RetryAfter: 250 * time.Millisecond}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := take(tt.b, l, now); got != tt.want {
+				t.Errorf("take() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Limits
+		wantErr bool
+	}{
+		{name: "NORMAL: 空文字は制限なし", s: "", want: Limits{}},
+		{
+			name: "NORMAL: デフォルトとメソッドごとの制限",
+			s:    "*=10:20, /anony.AnonyService/CreateAnonyURL=0.5:5",
+			want: Limits{DefaultKey: {Rate: 10, Burst: 20}, "/anony.AnonyService/CreateAnonyURL": {Rate: 0.5, Burst: 5}},
+		},
+		{name: "ERROR: 形式が不正", s: "*=10", wantErr: true},
+		{name: "ERROR: rateが負", s: "*=-1:10", wantErr: true},
+		{name: "ERROR: burstが0", s: "*=1:0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLimits(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLimits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLimits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimits_For(t *testing.T) {
+	ls := Limits{DefaultKey: {Rate: 10, Burst: 20}, "/a": {Rate: 1, Burst: 1}}
+	if key, l := ls.For("/a"); key != "/a" || l != ls["/a"] {
+		t.Errorf("Limits.For() = %v, %v, want the limit of the method", key, l)
+	}
+	if key, l := ls.For("/b"); key != DefaultKey || l != ls[DefaultKey] {
+		t.Errorf("Limits.For() = %v, %v, want the default limit", key, l)
+	}
+	if _, l := (Limits{}).For("/b"); !l.Unlimited() {
+		t.Errorf("Limits.For() = %v, want unlimited", l)
+	}
+}
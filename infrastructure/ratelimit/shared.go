@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// 他のプロセスと競合した場合に読み直す回数
+const maxSharedRetries = 5
+
+// StateStore is a key-value store shared between processes such as Redis or memcached
+// Redisの場合はWATCH/MULTIやLuaスクリプトでCompareAndSwapを実装する
+type StateStore interface {
+	// Get returns the bucket of the key, or nil if it does not exist or is expired
+	Get(ctx context.Context, key string) (*Bucket, error)
+	// CompareAndSwap stores the new bucket only if the stored one is still old (nil means not stored)
+	// ttlを過ぎたバケットは削除してよい
+	CompareAndSwap(ctx context.Context, key string, old, new *Bucket, ttl time.Duration) (bool, error)
+}
+
+// SharedStore is a Store coordinating the buckets between processes through the StateStore
+// プロセス間で時刻がずれている場合は, その分だけ補充が早くなったり遅くなったりする
+type SharedStore struct {
+	state  StateStore
+	prefix string
+}
+
+var _ Store = (*SharedStore)(nil)
+
+// NewSharedStore creates a SharedStore, prefix is added to the keys of the StateStore
+func NewSharedStore(s StateStore, prefix string) *SharedStore {
+	return &SharedStore{state: s, prefix: prefix}
+}
+
+// Take takes a token from the bucket of the key
+func (s *SharedStore) Take(ctx context.Context, key string, l Limit, now time.Time) (Result, error) {
+	if l.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+	key = s.prefix + key
+	for i := 0; i < maxSharedRetries; i++ {
+		old, err := s.state.Get(ctx, key)
+		if err != nil {
+			return Result{}, err
+		}
+		b, res := take(old, l, now)
+		ok, err := s.state.CompareAndSwap(ctx, key, old, b, fullAfter(b, l))
+		if err != nil {
+			return Result{}, err
+		}
+		if ok {
+			return res, nil
+		}
+	}
+	return Result{}, fmt.Errorf("failed to take a token of %s: too many conflicts", key)
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryStateStore is a StateStore in memory, conflict is called once before each CompareAndSwap
+type memoryStateStore struct {
+	mu       sync.Mutex
+	buckets  map[string]Bucket
+	conflict func(s *memoryStateStore, key string)
+}
+
+func (s *memoryStateStore) Get(ctx context.Context, key string) (*Bucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.buckets[key]
+	if !ok {
+		return nil, nil
+	}
+	return &b, nil
+}
+
+func (s *memoryStateStore) CompareAndSwap(ctx context.Context, key string, old, new *Bucket, ttl time.Duration) (bool, error) {
+	if f := s.conflict; f != nil {
+		s.conflict = nil
+		f(s, key)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.buckets[key]
+	if ok != (old != nil) || ok && cur != *old {
+		return false, nil
+	}
+	s.buckets[key] = *new
+	return true, nil
+}
+
+func TestSharedStore_Take(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	l := Limit{Rate: 1, Burst: 2}
+	state := &memoryStateStore{buckets: map[string]Bucket{}}
+	// 別のプロセスが同時にトークンを取った場合は読み直す
+	state.conflict = func(s *memoryStateStore, key string) {
+		s.buckets[key] = Bucket{Tokens: 1, UpdatedAt: now}
+	}
+	a := NewSharedStore(state, "api:")
+	b := NewSharedStore(state, "api:")
+
+	got, err := a.Take(context.Background(), "user:1", l, now)
+	if err != nil || !got.Allowed || got.Remaining != 0 {
+		t.Fatalf("SharedStore.Take() = %+v, %v, want allowed with no remaining", got, err)
+	}
+	got, err = b.Take(context.Background(), "user:1", l, now)
+	if err != nil || got.Allowed || got.RetryAfter != time.Second {
+		t.Errorf("SharedStore.Take() = %+v, %v, want denied for 1s", got, err)
+	}
+	if _, ok := state.buckets["api:user:1"]; !ok {
+		t.Errorf("SharedStore.Take() did not store the bucket with the prefix: %v", state.buckets)
+	}
+}
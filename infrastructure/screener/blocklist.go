@@ -0,0 +1,72 @@
+package screener
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/idna"
+)
+
+// DomainBlocklist blocks destinations on the domains listed in a local file
+// ファイルは1行に1ドメインで, 指定したドメインのサブドメインもブロックする
+type DomainBlocklist struct {
+	mu      sync.RWMutex
+	file    watchedFile
+	domains map[string]struct{}
+}
+
+// NewDomainBlocklist loads a domain blocklist from the file
+func NewDomainBlocklist(path string) (*DomainBlocklist, error) {
+	l := &DomainBlocklist{file: watchedFile{path: path}}
+	if _, err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload reloads the file if it is modified
+func (l *DomainBlocklist) Reload() (bool, error) {
+	lines, modTime, modified, err := l.file.read()
+	if err != nil || !modified {
+		return false, err
+	}
+	domains := make(map[string]struct{}, len(lines))
+	for _, line := range lines {
+		// "*.example.com"は"example.com"と同じ扱いにする
+		d := strings.TrimSuffix(strings.TrimPrefix(line, "*."), ".")
+		d, err := idna.Lookup.ToASCII(d)
+		if err != nil || d == "" {
+			return false, fmt.Errorf("%s: domain %q is invalid", l.file.path, line)
+		}
+		domains[d] = struct{}{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.domains = domains
+	l.file.modTime = modTime
+	return true, nil
+}
+
+// Screen blocks the destination if the host or its parent domain is listed
+func (l *DomainBlocklist) Screen(ctx context.Context, destination string) (string, error) {
+	u, ok := parseDestination(destination)
+	if !ok {
+		return "", nil
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for h := u.Hostname(); h != ""; {
+		if _, ok := l.domains[h]; ok {
+			return fmt.Sprintf("domain %s is in the blocklist", h), nil
+		}
+		i := strings.Index(h, ".")
+		if i < 0 {
+			break
+		}
+		h = h[i+1:]
+	}
+	return "", nil
+}
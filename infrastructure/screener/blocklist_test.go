@@ -0,0 +1,78 @@
+package screener
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDomainBlocklist_Screen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	writeList(t, path, "# phishing\nevil.example\n*.Phishing.Example.\n\n日本語.example\n", time.Now())
+	l, err := NewDomainBlocklist(path)
+	if err != nil {
+		t.Fatalf("NewDomainBlocklist() error = %v", err)
+	}
+	tests := []struct {
+		name        string
+		destination string
+		wantBlocked bool
+	}{
+		{name: "NORMAL: 一致するドメイン", destination: "https://evil.example/login", wantBlocked: true},
+		{name: "NORMAL: サブドメイン", destination: "https://a.b.evil.example/", wantBlocked: true},
+		{name: "NORMAL: 大文字やワイルドカードで書かれたドメイン", destination: "HTTPS://LOGIN.PHISHING.EXAMPLE", wantBlocked: true},
+		{name: "NORMAL: IDNのドメイン", destination: "https://xn--wgv71a119e.example/", wantBlocked: true},
+		{name: "NORMAL: 名前の一部が一致するだけのドメイン", destination: "https://notevil.example/", wantBlocked: false},
+		{name: "NORMAL: パスに含まれるだけの場合", destination: "https://example.com/evil.example", wantBlocked: false},
+		{name: "NORMAL: 解釈できないURLは照合しない", destination: "evil.example", wantBlocked: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.Screen(context.Background(), tt.destination)
+			if err != nil {
+				t.Fatalf("DomainBlocklist.Screen() error = %v", err)
+			}
+			if (got != "") != tt.wantBlocked {
+				t.Errorf("DomainBlocklist.Screen() = %q, wantBlocked %v", got, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestDomainBlocklist_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	now := time.Now()
+	writeList(t, path, "evil.example\n", now.Add(-time.Minute))
+	l, err := NewDomainBlocklist(path)
+	if err != nil {
+		t.Fatalf("NewDomainBlocklist() error = %v", err)
+	}
+
+	if changed, err := l.Reload(); changed || err != nil {
+		t.Errorf("DomainBlocklist.Reload() = %v, %v, want false when the file is not modified", changed, err)
+	}
+
+	// 不正な行がある場合は直前のリストを使い続ける
+	writeList(t, path, "evil.example\nin valid\n", now)
+	if _, err := l.Reload(); err == nil {
+		t.Errorf("DomainBlocklist.Reload() error = nil, want error")
+	}
+	if got, _ := l.Screen(context.Background(), "https://evil.example/"); got == "" {
+		t.Errorf("DomainBlocklist.Screen() = %q, want the previous list is used", got)
+	}
+
+	writeList(t, path, "other.example\n", now.Add(time.Minute))
+	if changed, err := l.Reload(); !changed || err != nil {
+		t.Errorf("DomainBlocklist.Reload() = %v, %v, want true", changed, err)
+	}
+	if got, _ := l.Screen(context.Background(), "https://evil.example/"); got != "" {
+		t.Errorf("DomainBlocklist.Screen() = %q, want allowed after reload", got)
+	}
+}
+
+func TestNewDomainBlocklist_NotFound(t *testing.T) {
+	if _, err := NewDomainBlocklist(filepath.Join(t.TempDir(), "not-found.txt")); err == nil {
+		t.Errorf("NewDomainBlocklist() error = nil, want error")
+	}
+}
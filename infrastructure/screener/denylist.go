@@ -0,0 +1,64 @@
+package screener
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// RegexDenylist blocks destinations matching any of the regular expressions listed in a local file
+// ファイルは1行に1つの正規表現で, 正規化したURL全体と照合する
+type RegexDenylist struct {
+	mu       sync.RWMutex
+	file     watchedFile
+	patterns []*regexp.Regexp
+}
+
+// NewRegexDenylist loads a regex denylist from the file
+func NewRegexDenylist(path string) (*RegexDenylist, error) {
+	l := &RegexDenylist{file: watchedFile{path: path}}
+	if _, err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload reloads the file if it is modified
+func (l *RegexDenylist) Reload() (bool, error) {
+	lines, modTime, modified, err := l.file.read()
+	if err != nil || !modified {
+		return false, err
+	}
+	patterns := make([]*regexp.Regexp, len(lines))
+	for i, line := range lines {
+		re, err := regexp.Compile(line)
+		if err != nil {
+			return false, fmt.Errorf("%s: pattern %q is invalid: %w", l.file.path, line, err)
+		}
+		patterns[i] = re
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.patterns = patterns
+	l.file.modTime = modTime
+	return true, nil
+}
+
+// Screen blocks the destination if it matches any of the patterns
+func (l *RegexDenylist) Screen(ctx context.Context, destination string) (string, error) {
+	u, ok := parseDestination(destination)
+	if !ok {
+		return "", nil
+	}
+	s := u.String()
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, re := range l.patterns {
+		if re.MatchString(s) {
+			return fmt.Sprintf("url matches the denylist pattern %s", re), nil
+		}
+	}
+	return "", nil
+}
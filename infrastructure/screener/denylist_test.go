@@ -0,0 +1,46 @@
+package screener
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRegexDenylist_Screen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	writeList(t, path, "# 偽のログインページ\n^https://[^/]+/(paypal|apple)-login\n\\.exe$\n", time.Now())
+	l, err := NewRegexDenylist(path)
+	if err != nil {
+		t.Fatalf("NewRegexDenylist() error = %v", err)
+	}
+	tests := []struct {
+		name        string
+		destination string
+		wantBlocked bool
+	}{
+		{name: "NORMAL: パターンに一致する場合", destination: "https://example.com/paypal-login?next=/", wantBlocked: true},
+		{name: "NORMAL: 正規化したURLで照合する", destination: "HTTPS://Example.com:443/apple-login", wantBlocked: true},
+		{name: "NORMAL: 別のパターンに一致する場合", destination: "http://example.com/setup.exe", wantBlocked: true},
+		{name: "NORMAL: 一致しない場合", destination: "https://example.com/login", wantBlocked: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.Screen(context.Background(), tt.destination)
+			if err != nil {
+				t.Fatalf("RegexDenylist.Screen() error = %v", err)
+			}
+			if (got != "") != tt.wantBlocked {
+				t.Errorf("RegexDenylist.Screen() = %q, wantBlocked %v", got, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestNewRegexDenylist_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "denylist.txt")
+	writeList(t, path, "(unclosed\n", time.Now())
+	if _, err := NewRegexDenylist(path); err == nil {
+		t.Errorf("NewRegexDenylist() error = nil, want error")
+	}
+}
@@ -0,0 +1,148 @@
+package screener
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Safe Browsingのハッシュプレフィックスの長さ(バイト)
+const (
+	minHashPrefixLength = 4
+	maxHashPrefixLength = sha256.Size
+)
+
+// HashPrefixList blocks destinations whose SHA-256 hash of a host suffix and path prefix expression
+// starts with any of the prefixes listed in a local file, in the same way as Safe Browsing Update API
+// ファイルは1行に1つの16進数のプレフィックス(4〜32バイト)
+// 完全なハッシュをAPIへ問い合わせることはしないため, プレフィックスが一致した時点でブロックする
+type HashPrefixList struct {
+	mu       sync.RWMutex
+	file     watchedFile
+	prefixes map[string]struct{}
+	lengths  []int
+}
+
+// NewHashPrefixList loads a hash prefix list from the file
+func NewHashPrefixList(path string) (*HashPrefixList, error) {
+	l := &HashPrefixList{file: watchedFile{path: path}}
+	if _, err := l.Reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload reloads the file if it is modified
+func (l *HashPrefixList) Reload() (bool, error) {
+	lines, modTime, modified, err := l.file.read()
+	if err != nil || !modified {
+		return false, err
+	}
+	prefixes := make(map[string]struct{}, len(lines))
+	lengths := map[int]struct{}{}
+	for _, line := range lines {
+		b, err := hex.DecodeString(line)
+		if err != nil || len(b) < minHashPrefixLength || len(b) > maxHashPrefixLength {
+			return false, fmt.Errorf("%s: hash prefix %q is invalid", l.file.path, line)
+		}
+		prefixes[string(b)] = struct{}{}
+		lengths[len(b)] = struct{}{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.prefixes = prefixes
+	l.lengths = make([]int, 0, len(lengths))
+	for n := range lengths {
+		l.lengths = append(l.lengths, n)
+	}
+	sort.Ints(l.lengths)
+	l.file.modTime = modTime
+	return true, nil
+}
+
+// Screen blocks the destination if the hash of any of its expressions matches a prefix
+func (l *HashPrefixList) Screen(ctx context.Context, destination string) (string, error) {
+	u, ok := parseDestination(destination)
+	if !ok {
+		return "", nil
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, expr := range hashExpressions(u) {
+		sum := sha256.Sum256([]byte(expr))
+		for _, n := range l.lengths {
+			if _, ok := l.prefixes[string(sum[:n])]; ok {
+				return fmt.Sprintf("%s matches the hash prefix list", expr), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// hashExpressions returns the combinations of host suffixes and path prefixes of the URL
+func hashExpressions(u *url.URL) []string {
+	exprs := []string{}
+	for _, h := range hostSuffixes(u.Hostname()) {
+		for _, p := range pathPrefixes(u) {
+			exprs = append(exprs, h+p)
+		}
+	}
+	return exprs
+}
+
+// hostSuffixes returns the host and up to 4 suffixes formed from the last 5 components
+// トップレベルドメインのみのサフィックスは含まない
+func hostSuffixes(host string) []string {
+	if net.ParseIP(host) != nil {
+		return []string{host}
+	}
+	res := []string{host}
+	parts := strings.Split(host, ".")
+	start := len(parts) - 5
+	if start < 1 {
+		start = 1
+	}
+	for i := start; i <= len(parts)-2; i++ {
+		res = append(res, strings.Join(parts[i:], "."))
+	}
+	return res
+}
+
+// pathPrefixes returns the path with and without the query, and up to 4 directory prefixes
+func pathPrefixes(u *url.URL) []string {
+	path := u.EscapedPath()
+	res := []string{}
+	seen := map[string]struct{}{}
+	add := func(p string) {
+		if _, ok := seen[p]; !ok {
+			seen[p] = struct{}{}
+			res = append(res, p)
+		}
+	}
+	if u.RawQuery != "" {
+		add(path + "?" + u.RawQuery)
+	}
+	add(path)
+
+	p := "/"
+	add(p)
+	dir := strings.Trim(path[:strings.LastIndex(path, "/")+1], "/")
+	if dir == "" {
+		return res
+	}
+	for i, c := range strings.Split(dir, "/") {
+		if i >= 3 {
+			break
+		}
+		p += c + "/"
+		add(p)
+	}
+	return res
+}
@@ -0,0 +1,107 @@
+package screener
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func hashPrefix(expr string, n int) string {
+	sum := sha256.Sum256([]byte(expr))
+	return hex.EncodeToString(sum[:n])
+}
+
+func TestHashPrefixList_Screen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prefixes.txt")
+	data := hashPrefix("evil.example/", 4) + "\n" +
+		hashPrefix("example.com/phishing/", 8) + "\n" +
+		hashPrefix("example.net/malware.html", 32) + "\n"
+	writeList(t, path, data, time.Now())
+	l, err := NewHashPrefixList(path)
+	if err != nil {
+		t.Fatalf("NewHashPrefixList() error = %v", err)
+	}
+	tests := []struct {
+		name        string
+		destination string
+		wantBlocked bool
+	}{
+		{name: "NORMAL: ホスト全体がブロックされている場合", destination: "https://evil.example/any/path?q=1", wantBlocked: true},
+		{name: "NORMAL: 親ドメインがブロックされている場合", destination: "https://a.b.evil.example/", wantBlocked: true},
+		{name: "NORMAL: パスのプレフィックスがブロックされている場合", destination: "https://www.example.com/phishing/login.html", wantBlocked: true},
+		{name: "NORMAL: 完全なハッシュが一致する場合", destination: "http://example.net/malware.html?id=1", wantBlocked: true},
+		{name: "NORMAL: 別のパスの場合", destination: "https://example.com/safe/login.html", wantBlocked: false},
+		{name: "NORMAL: 一致しない場合", destination: "https://example.org/", wantBlocked: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := l.Screen(context.Background(), tt.destination)
+			if err != nil {
+				t.Fatalf("HashPrefixList.Screen() error = %v", err)
+			}
+			if (got != "") != tt.wantBlocked {
+				t.Errorf("HashPrefixList.Screen() = %q, wantBlocked %v", got, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestNewHashPrefixList_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "ERROR: 16進数でない場合", data: "zzzzzzzz\n"},
+		{name: "ERROR: 4バイトより短い場合", data: "abcdef\n"},
+		{name: "ERROR: 32バイトより長い場合", data: hashPrefix("a", 32) + "00\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "prefixes.txt")
+			writeList(t, path, tt.data, time.Now())
+			if _, err := NewHashPrefixList(path); err == nil {
+				t.Errorf("NewHashPrefixList() error = nil, want error")
+			}
+		})
+	}
+}
+
+func Test_hashExpressions(t *testing.T) {
+	// Safe Browsingのドキュメントの例
+	u, _ := url.Parse("http://a.b.c/1/2.html?param=1")
+	want := []string{
+		"a.b.c/1/2.html?param=1",
+		"a.b.c/1/2.html",
+		"a.b.c/",
+		"a.b.c/1/",
+		"b.c/1/2.html?param=1",
+		"b.c/1/2.html",
+		"b.c/",
+		"b.c/1/",
+	}
+	if got := hashExpressions(u); !reflect.DeepEqual(got, want) {
+		t.Errorf("hashExpressions() = %v, want %v", got, want)
+	}
+
+	u, _ = url.Parse("http://a.b.c.d.e.f.g/1.html")
+	want = []string{
+		"a.b.c.d.e.f.g/1.html",
+		"a.b.c.d.e.f.g/",
+		"c.d.e.f.g/1.html",
+		"c.d.e.f.g/",
+		"d.e.f.g/1.html",
+		"d.e.f.g/",
+		"e.f.g/1.html",
+		"e.f.g/",
+		"f.g/1.html",
+		"f.g/",
+	}
+	if got := hashExpressions(u); !reflect.DeepEqual(got, want) {
+		t.Errorf("hashExpressions() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+package screener
+
+import (
+	"bufio"
+	"context"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/service"
+)
+
+type nopScreener struct{}
+
+// NewNopScreener creates a DestinationScreener which allows every destination
+func NewNopScreener() service.DestinationScreener {
+	return nopScreener{}
+}
+
+func (nopScreener) Screen(ctx context.Context, destination string) (string, error) {
+	return "", nil
+}
+
+type multiScreener []service.DestinationScreener
+
+// NewMultiScreener creates a DestinationScreener which blocks destinations blocked by any of the screeners
+func NewMultiScreener(ss ...service.DestinationScreener) service.DestinationScreener {
+	return multiScreener(ss)
+}
+
+func (m multiScreener) Screen(ctx context.Context, destination string) (string, error) {
+	for _, s := range m {
+		reason, err := s.Screen(ctx, destination)
+		if err != nil || reason != "" {
+			return reason, err
+		}
+	}
+	return "", nil
+}
+
+// Reloader reloads a list from the file when it is modified
+type Reloader interface {
+	// Reload returns true if the list is reloaded
+	Reload() (bool, error)
+}
+
+// Watch reloads the lists at the interval and calls onChange when any of them is reloaded
+// 読み込みに失敗した場合は, 直前に読み込んだリストを使い続ける
+func Watch(ctx context.Context, interval time.Duration, onChange func(), rs ...Reloader) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed := false
+			for _, r := range rs {
+				ok, err := r.Reload()
+				if err != nil {
+					log.Printf("failed to reload the screening list: %v", err)
+					continue
+				}
+				changed = changed || ok
+			}
+			if changed {
+				onChange()
+			}
+		}
+	}
+}
+
+// watchedFile tracks the modification time of a list file
+type watchedFile struct {
+	path    string
+	modTime time.Time
+}
+
+// read returns the lines of the file and the modification time if it is modified since the last load
+// 空行と#から始まる行は読み飛ばす
+func (f *watchedFile) read() ([]string, time.Time, bool, error) {
+	fi, err := os.Stat(f.path)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	if fi.ModTime().Equal(f.modTime) {
+		return nil, time.Time{}, false, nil
+	}
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	defer file.Close()
+
+	lines := []string{}
+	sc := bufio.NewScanner(file)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, time.Time{}, false, err
+	}
+	return lines, fi.ModTime(), true, nil
+}
+
+// parseDestination parses the normalized form of the destination
+// リストとの照合は正規化したURLで行う. URLの検証はmodelで行うため, 解釈できないURLは照合しない
+func parseDestination(destination string) (*url.URL, bool) {
+	n, err := model.NormalizeURL(destination)
+	if err != nil {
+		return nil, false
+	}
+	u, err := url.Parse(n)
+	if err != nil {
+		return nil, false
+	}
+	return u, true
+}
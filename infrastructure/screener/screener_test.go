@@ -0,0 +1,82 @@
+package screener
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeList writes the list file and sets the modification time to be detected as modified
+func writeList(t *testing.T, path, data string, modTime time.Time) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMultiScreener_Screen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	writeList(t, path, "evil.example\n", time.Now())
+	blocklist, err := NewDomainBlocklist(path)
+	if err != nil {
+		t.Fatalf("NewDomainBlocklist() error = %v", err)
+	}
+	s := NewMultiScreener(NewNopScreener(), blocklist)
+
+	tests := []struct {
+		name        string
+		destination string
+		wantBlocked bool
+	}{
+		{name: "NORMAL: どれにもブロックされない場合", destination: "https://example.com/", wantBlocked: false},
+		{name: "NORMAL: いずれかにブロックされる場合", destination: "https://evil.example/", wantBlocked: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Screen(context.Background(), tt.destination)
+			if err != nil {
+				t.Fatalf("multiScreener.Screen() error = %v", err)
+			}
+			if (got != "") != tt.wantBlocked {
+				t.Errorf("multiScreener.Screen() = %q, wantBlocked %v", got, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestWatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	now := time.Now()
+	writeList(t, path, "evil.example\n", now.Add(-time.Minute))
+	l, err := NewDomainBlocklist(path)
+	if err != nil {
+		t.Fatalf("NewDomainBlocklist() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changed := make(chan struct{}, 1)
+	go Watch(ctx, 10*time.Millisecond, func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}, l)
+
+	writeList(t, path, "evil.example\nphishing.example\n", now)
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("Watch() did not reload the modified list")
+	}
+	got, err := l.Screen(ctx, "https://login.phishing.example/")
+	if err != nil || got == "" {
+		t.Errorf("DomainBlocklist.Screen() = %q, %v, want blocked after reload", got, err)
+	}
+}
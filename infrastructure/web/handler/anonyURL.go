@@ -2,11 +2,19 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"strings"
 
+	"github.com/Tatsuemon/anony/config"
 	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/qrcode"
 	"github.com/Tatsuemon/anony/rpc"
 	"github.com/Tatsuemon/anony/usecase"
+	"github.com/Tatsuemon/anony/usecase/dto"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -14,11 +22,15 @@ import (
 type AnonyURLHandler struct {
 	usecase         usecase.AnonyURLUseCase
 	usecaseWithUser usecase.AnonyURLWithUserUseCase
+	domainUseCase   usecase.DomainUseCase
+	previewUseCase  usecase.PreviewUseCase
+	tagUseCase      usecase.TagUseCase
+	searchUseCase   usecase.SearchUseCase
 }
 
 // NewAnonyURLHandler creates a new UserHandler
-func NewAnonyURLHandler(u usecase.AnonyURLUseCase, uu usecase.AnonyURLWithUserUseCase) *AnonyURLHandler {
-	return &AnonyURLHandler{u, uu}
+func NewAnonyURLHandler(u usecase.AnonyURLUseCase, uu usecase.AnonyURLWithUserUseCase, du usecase.DomainUseCase, pu usecase.PreviewUseCase, tu usecase.TagUseCase, su usecase.SearchUseCase) *AnonyURLHandler {
+	return &AnonyURLHandler{u, uu, du, pu, tu, su}
 }
 
 // CreateAnonyURL creates anonyURL
@@ -28,7 +40,25 @@ func (a *AnonyURLHandler) CreateAnonyURL(ctx context.Context, in *rpc.CreateAnon
 		return nil, err
 	}
 
+	domainID := ""
+	if name := in.GetDomain(); name != "" {
+		d, err := a.domainUseCase.GetUsableDomain(ctx, strings.ToLower(name), userID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to use domain \n: %s", err)
+		}
+		domainID = d.ID
+	}
+
 	ori := in.GetOriginalUrl()
+	if in.GetCollapseRedirectChain() {
+		ori, err = a.usecase.ResolveRedirectChain(ctx, ori, nil)
+		if err != nil {
+			if s := destinationError(err); s != nil {
+				return nil, s
+			}
+			return nil, err
+		}
+	}
 	isActive := in.GetIsActive()
 	var status int64
 	if isActive {
@@ -36,26 +66,189 @@ func (a *AnonyURLHandler) CreateAnonyURL(ctx context.Context, in *rpc.CreateAnon
 	} else {
 		status = 2
 	}
-	su, err := a.usecase.CreateAnonyURL(ctx, userID)
+	su, err := a.usecase.CreateAnonyURL(ctx, domainID)
 	if err != nil {
 		return nil, err
 	}
 	an := model.NewAnonyURL(uuid.New().String(), ori, su, status)
-	_, err = a.usecase.SaveAnonyURL(ctx, an, userID)
+	an.DomainID = domainID
+	setRedirectMode(an, in.GetRedirectMode())
+	// rpc.QueryModeの値はmodelのQueryModeと同じ
+	an.QueryMode = int64(in.GetQueryMode())
+	an.ForwardPath = in.GetForwardPath()
+	an.UTM = toModelUTM(in.GetUtm())
+	an.Variants = toModelVariants(in.GetVariants())
+	an.ActiveFrom = toTimePtr(in.GetActiveFrom())
+	an.ActiveUntil = toTimePtr(in.GetActiveUntil())
+	an.Fallback = in.GetFallbackUrl()
+	an.SetAnnotation(in.GetTitle(), in.GetNotes(), in.GetMetadata())
+	// 既に登録されているOriginalの場合は, 登録済みのAnonyURLが返る
+	// ドメインなどの指定が登録済みのAnonyURLと異なる場合はAlreadyExists
+	saved, err := a.usecase.SaveAnonyURL(ctx, an, userID)
+	if err != nil {
+		if s := destinationError(err); s != nil {
+			return nil, s
+		}
+		return nil, err
+	}
+	// 登録済みのAnonyURLが返った場合は, 取得済みのプレビューを使う
+	if saved.Preview == nil {
+		a.previewUseCase.FetchPreviewsAsync(saved)
+	}
+	hosts, err := a.domainHosts(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	res := &rpc.CreateAnonyURLResponse{
-		AnonyUrls: &rpc.AnonyURL{
-			OriginalUrl: an.Original,
-			ShortUrl:    an.Short,
-			IsActive:    an.Status == 1,
-		},
+		AnonyUrls: toRPCAnonyURL(saved, hosts[saved.DomainID]),
+	}
+	return res, nil
+}
+
+// CreateCampaign creates anonyURLs of the same original for each channel
+func (a *AnonyURLHandler) CreateCampaign(ctx context.Context, in *rpc.CreateCampaignRequest) (*rpc.CreateCampaignResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainID := ""
+	if name := in.GetDomain(); name != "" {
+		d, err := a.domainUseCase.GetUsableDomain(ctx, strings.ToLower(name), userID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to use domain \n: %s", err)
+		}
+		domainID = d.ID
+	}
+
+	original := in.GetOriginalUrl()
+	if in.GetCollapseRedirectChain() {
+		original, err = a.usecase.ResolveRedirectChain(ctx, original, nil)
+		if err != nil {
+			if s := destinationError(err); s != nil {
+				return nil, s
+			}
+			return nil, err
+		}
+	}
+	var anStatus int64 = 2
+	if in.GetIsActive() {
+		anStatus = 1
+	}
+	ans := make([]*model.AnonyURL, len(in.GetChannels()))
+	for i, v := range in.GetChannels() {
+		su, err := a.usecase.CreateAnonyURL(ctx, domainID)
+		if err != nil {
+			return nil, err
+		}
+		an := model.NewAnonyURL(uuid.New().String(), original, su, anStatus)
+		an.DomainID = domainID
+		setRedirectMode(an, in.GetRedirectMode())
+		an.QueryMode = int64(in.GetQueryMode())
+		an.ForwardPath = in.GetForwardPath()
+		an.UTM = toModelUTM(v)
+		if an.UTM.Campaign == "" {
+			an.UTM.Campaign = in.GetCampaign()
+		}
+		ans[i] = an
+	}
+	saved, err := a.usecase.SaveCampaign(ctx, ans, userID)
+	if err != nil {
+		if s := destinationError(err); s != nil {
+			return nil, s
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create campaign \n: %s", err)
+	}
+	a.previewUseCase.FetchPreviewsAsync(saved...)
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpc.CreateCampaignResponse{}
+	res.AnonyUrls = make([]*rpc.AnonyURL, len(saved))
+	for i, v := range saved {
+		res.AnonyUrls[i] = toRPCAnonyURL(v, hosts[v.DomainID])
+	}
+	return res, nil
+}
+
+// SetAnonyURLVariants replaces the destinations of A/B split
+func (a *AnonyURLHandler) SetAnonyURLVariants(ctx context.Context, in *rpc.SetAnonyURLVariantsRequest) (*rpc.SetAnonyURLVariantsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	an, err := a.usecase.SetVariants(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID, toModelVariants(in.GetVariants()))
+	if err != nil {
+		if s := destinationError(err); s != nil {
+			return nil, s
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to set variants \n: %s", err)
+	}
+	return &rpc.SetAnonyURLVariantsResponse{Variants: toRPCVariants(an.Variants)}, nil
+}
+
+// GetAnonyURLStats returns the click counts of the AnonyURL and its variants
+func (a *AnonyURLHandler) GetAnonyURLStats(ctx context.Context, in *rpc.GetAnonyURLStatsRequest) (*rpc.GetAnonyURLStatsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	an, err := a.usecase.GetAnonyURLStats(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to get stats \n: %s", err)
+	}
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := &rpc.GetAnonyURLStatsResponse{
+		AnonyUrl: toRPCAnonyURL(an, hosts[an.DomainID]),
+		Clicks:   an.Clicks,
+		Variants: toRPCVariants(an.Variants),
 	}
 	return res, nil
 }
 
+// SetAnonyURLSchedule sets the activation window of the AnonyURL
+func (a *AnonyURLHandler) SetAnonyURLSchedule(ctx context.Context, in *rpc.SetAnonyURLScheduleRequest) (*rpc.SetAnonyURLScheduleResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	an, err := a.usecase.SetSchedule(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID, toTimePtr(in.GetActiveFrom()), toTimePtr(in.GetActiveUntil()), in.GetFallbackUrl())
+	if err != nil {
+		if s := destinationError(err); s != nil {
+			return nil, s
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to set schedule \n: %s", err)
+	}
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SetAnonyURLScheduleResponse{AnonyUrl: toRPCAnonyURL(an, hosts[an.DomainID])}, nil
+}
+
+// UpdateAnonyURLMetadata replaces the title, the notes and the metadata of the AnonyURL
+func (a *AnonyURLHandler) UpdateAnonyURLMetadata(ctx context.Context, in *rpc.UpdateAnonyURLMetadataRequest) (*rpc.UpdateAnonyURLMetadataResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	an, err := a.usecase.UpdateAnnotation(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID, in.GetTitle(), in.GetNotes(), in.GetMetadata())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update metadata \n: %s", err)
+	}
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.UpdateAnonyURLMetadataResponse{AnonyUrl: toRPCAnonyURL(an, hosts[an.DomainID])}, nil
+}
+
 // ListAnonyURLs lists user's Anony URLs
 func (a *AnonyURLHandler) ListAnonyURLs(ctx context.Context, in *rpc.ListAnonyURLsRequest) (*rpc.ListAnonyURLsResponse, error) {
 	userID, err := model.GetUserIDInContext(ctx)
@@ -73,7 +266,23 @@ func (a *AnonyURLHandler) ListAnonyURLs(ctx context.Context, in *rpc.ListAnonyUR
 		status = 1
 	}
 
-	ans, err := a.usecase.ListAnonyURLs(ctx, userID, status)
+	var ans []*model.AnonyURL
+	if len(in.GetTagIds()) > 0 {
+		ans, err = a.tagUseCase.ListAnonyURLsInTags(ctx, userID, status, in.GetTagIds())
+		if err != nil {
+			return nil, tagError("failed to list anonyURLs", err)
+		}
+	} else {
+		ans, err = a.usecase.ListAnonyURLs(ctx, userID, status)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := a.tagUseCase.AttachTags(ctx, userID, ans); err != nil {
+		return nil, err
+	}
+
+	hosts, err := a.domainHosts(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -81,22 +290,129 @@ func (a *AnonyURLHandler) ListAnonyURLs(ctx context.Context, in *rpc.ListAnonyUR
 	res := &rpc.ListAnonyURLsResponse{}
 	res.AnonyUrls = make([]*rpc.AnonyURL, len(ans))
 	for i, v := range ans {
-		res.AnonyUrls[i] = &rpc.AnonyURL{
-			OriginalUrl: v.Original,
-			ShortUrl:    v.Short,
-			IsActive:    v.Status == 1,
+		res.AnonyUrls[i] = toRPCAnonyURL(v, hosts[v.DomainID])
+	}
+	return res, nil
+}
+
+// RefreshAnonyURLMetadata fetches the preview of the destination page again
+func (a *AnonyURLHandler) RefreshAnonyURLMetadata(ctx context.Context, in *rpc.RefreshAnonyURLMetadataRequest) (*rpc.RefreshAnonyURLMetadataResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	an, err := a.previewUseCase.RefreshPreview(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrPreviewUnavailable) {
+			return nil, status.Errorf(codes.Unavailable, "failed to refresh metadata \n: %s", err)
+		}
+		return nil, status.Errorf(codes.NotFound, "failed to refresh metadata \n: %s", err)
+	}
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.RefreshAnonyURLMetadataResponse{AnonyUrl: toRPCAnonyURL(an, hosts[an.DomainID])}, nil
+}
+
+// GetAnonyURLQRCode returns the QR code image of the short URL
+func (a *AnonyURLHandler) GetAnonyURLQRCode(ctx context.Context, in *rpc.GetAnonyURLQRCodeRequest) (*rpc.GetAnonyURLQRCodeResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	an, err := a.usecase.GetAnonyURL(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to get anonyURL \n: %s", err)
+	}
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	shortURL := an.ShortURL(hosts[an.DomainID])
+	image, contentType, err := renderQRCode(shortURL, toQRCodeOptions(in))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate QR code \n: %s", err)
+	}
+	return &rpc.GetAnonyURLQRCodeResponse{Image: image, ContentType: contentType, ShortUrl: shortURL}, nil
+}
+
+// ListBrokenAnonyURLs lists user's Anony URLs whose destination was broken at the last health check
+func (a *AnonyURLHandler) ListBrokenAnonyURLs(ctx context.Context, in *emptypb.Empty) (*rpc.ListBrokenAnonyURLsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ans, err := a.usecase.ListBrokenAnonyURLs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := &rpc.ListBrokenAnonyURLsResponse{}
+	res.AnonyUrls = make([]*rpc.AnonyURL, len(ans))
+	for i, v := range ans {
+		res.AnonyUrls[i] = toRPCAnonyURL(v, hosts[v.DomainID])
+	}
+	return res, nil
+}
+
+// SearchAnonyURLs searches user's anony urls by the words
+func (a *AnonyURLHandler) SearchAnonyURLs(ctx context.Context, in *rpc.SearchAnonyURLsRequest) (*rpc.SearchAnonyURLsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := a.searchUseCase.SearchAnonyURLs(ctx, userID, in.GetQuery(), int(in.GetLimit()), int(in.GetOffset()))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to search anonyURLs \n: %s", err)
+	}
+	ans := make([]*model.AnonyURL, len(results))
+	for i, v := range results {
+		ans[i] = v.AnonyURL
+	}
+	if err := a.tagUseCase.AttachTags(ctx, userID, ans); err != nil {
+		return nil, err
+	}
+	hosts, err := a.domainHosts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := &rpc.SearchAnonyURLsResponse{}
+	res.Results = make([]*rpc.AnonyURLSearchResult, len(results))
+	for i, v := range results {
+		res.Results[i] = &rpc.AnonyURLSearchResult{
+			AnonyUrl: toRPCAnonyURL(v.AnonyURL, hosts[v.AnonyURL.DomainID]),
+			Score:    v.Score,
 		}
 	}
 	return res, nil
 }
 
 // CountAnonyURLs count user's anony urls
-func (a *AnonyURLHandler) CountAnonyURLs(ctx context.Context, in *emptypb.Empty) (*rpc.CountAnonyURLsResponse, error) {
+func (a *AnonyURLHandler) CountAnonyURLs(ctx context.Context, in *rpc.CountAnonyURLsRequest) (*rpc.CountAnonyURLsResponse, error) {
 	userID, err := model.GetUserIDInContext(ctx)
 	if err != nil {
 		return nil, err
 	}
-	ans, err := a.usecaseWithUser.CountByUser(ctx, userID)
+	var ans *dto.AnonyURLCountByUser
+	if len(in.GetTagIds()) > 0 {
+		ans, err = a.tagUseCase.CountAnonyURLsInTags(ctx, userID, in.GetTagIds())
+		if err != nil {
+			return nil, tagError("failed to count anonyURLs", err)
+		}
+	} else {
+		ans, err = a.usecaseWithUser.CountByUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ans == nil {
+		return nil, status.Errorf(codes.NotFound, "failed to count anonyURLs \n: user is not found")
+	}
+	q, usage, err := a.usecase.GetQuota(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +421,7 @@ func (a *AnonyURLHandler) CountAnonyURLs(ctx context.Context, in *emptypb.Empty)
 		Email:       ans.Email,
 		CountAll:    ans.CntURLs,
 		CountActive: ans.CntActiveURLs,
+		Quota:       toRPCQuota(q, usage),
 	}
 	return res, nil
 }
@@ -123,16 +440,204 @@ func (a *AnonyURLHandler) UpdateAnonyURLStatus(ctx context.Context, in *rpc.Upda
 	} else {
 		status = 2
 	}
-	ans, err := a.usecase.UpdateAnonyURLStatus(ctx, ori, userID, status)
+	ans, err := a.usecase.UpdateAnonyURLStatus(ctx, ori, toModelUTM(in.GetUtm()), userID, status)
+	if err != nil {
+		if s := destinationError(err); s != nil {
+			return nil, s
+		}
+		return nil, err
+	}
+	hosts, err := a.domainHosts(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 	res := &rpc.UpdateAnonyURLStatusResponse{
-		AnonyUrl: &rpc.AnonyURL{
-			OriginalUrl: ans.Original,
-			ShortUrl:    ans.Short,
-			IsActive:    ans.Status == 1,
-		},
+		AnonyUrl: toRPCAnonyURL(ans, hosts[ans.DomainID]),
 	}
 	return res, nil
 }
+
+// domainHosts returns hosts of the user's domains keyed by domain id
+// 空文字はデフォルトのホスト
+func (a *AnonyURLHandler) domainHosts(ctx context.Context, userID string) (map[string]string, error) {
+	ds, err := a.domainUseCase.ListDomains(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	hosts := map[string]string{"": config.ServerHost()}
+	for _, d := range ds {
+		hosts[d.ID] = d.URL()
+	}
+	return hosts, nil
+}
+
+// destinationError converts errors of the destination policy and the quota to the status, or returns nil for other errors
+// ブロックされたリダイレクト先はPermissionDenied, 短縮URLのループ等はInvalidArgument, クォータの超過はResourceExhausted
+func destinationError(err error) error {
+	var blocked *service.BlockedDestinationError
+	if errors.As(err, &blocked) {
+		return status.Errorf(codes.PermissionDenied, "%s", blocked)
+	}
+	var exceeded *service.QuotaExceededError
+	if errors.As(err, &exceeded) {
+		return status.Errorf(codes.ResourceExhausted, "%s", exceeded)
+	}
+	if errors.Is(err, usecase.ErrAnonyURLConflict) {
+		return status.Errorf(codes.AlreadyExists, "%s", err)
+	}
+	if errors.Is(err, service.ErrRedirectLoop) || errors.Is(err, service.ErrRedirectChainTooLong) || errors.Is(err, service.ErrSelfReference) {
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return nil
+}
+
+func toRPCQuota(q *model.Quota, u *model.QuotaUsage) *rpc.Quota {
+	return &rpc.Quota{
+		MaxAnonyUrls:        q.MaxAnonyURLs,
+		MaxActiveAnonyUrls:  q.MaxActiveAnonyURLs,
+		MaxCreationsPerDay:  q.MaxCreationsPerDay,
+		CountAll:            u.AnonyURLs,
+		CountActive:         u.ActiveAnonyURLs,
+		CountCreatedLastDay: u.CreationsLastDay,
+	}
+}
+
+// rpc.RedirectModeとmodelのRedirectModeの対応
+// INTERSTITIALはmodelではInterstitialで表す
+var redirectModes = map[rpc.RedirectMode]int64{
+	rpc.RedirectMode_MOVED_PERMANENTLY:  model.RedirectModeMovedPermanently,
+	rpc.RedirectMode_FOUND:              model.RedirectModeFound,
+	rpc.RedirectMode_TEMPORARY_REDIRECT: model.RedirectModeTemporaryRedirect,
+	rpc.RedirectMode_PERMANENT_REDIRECT: model.RedirectModePermanentRedirect,
+}
+
+// setRedirectMode sets the redirect mode of the request to the AnonyURL
+// 未指定の場合はデフォルトのまま
+func setRedirectMode(an *model.AnonyURL, mode rpc.RedirectMode) {
+	switch mode {
+	case rpc.RedirectMode_REDIRECT_MODE_UNSPECIFIED:
+	case rpc.RedirectMode_INTERSTITIAL:
+		an.Interstitial = true
+	default:
+		an.RedirectMode = redirectModes[mode]
+	}
+}
+
+func toRPCRedirectMode(an *model.AnonyURL) rpc.RedirectMode {
+	if an.Interstitial {
+		return rpc.RedirectMode_INTERSTITIAL
+	}
+	mode := an.GetRedirectMode()
+	for k, v := range redirectModes {
+		if v == mode {
+			return k
+		}
+	}
+	return rpc.RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+// toRPCAnonyURL converts model.AnonyURL to rpc.AnonyURL
+// DBにはコードのみを保存しているので, ここでホストと結合する
+func toRPCAnonyURL(an *model.AnonyURL, host string) *rpc.AnonyURL {
+	return &rpc.AnonyURL{
+		OriginalUrl:    an.Original,
+		ShortUrl:       an.ShortURL(host),
+		IsActive:       an.Status == 1,
+		RedirectMode:   toRPCRedirectMode(an),
+		QueryMode:      rpc.QueryMode(an.QueryMode),
+		ForwardPath:    an.ForwardPath,
+		Utm:            toRPCUTM(an.UTM),
+		ActiveFrom:     toTimestamp(an.ActiveFrom),
+		ActiveUntil:    toTimestamp(an.ActiveUntil),
+		FallbackUrl:    an.Fallback,
+		BlockedReason:  an.BlockedReason,
+		LastStatusCode: an.LastStatusCode,
+		LastLatencyMs:  an.LastLatencyMS,
+		LastCheckedAt:  toTimestamp(an.LastCheckedAt),
+		Preview:        toRPCLinkPreview(an.Preview),
+		Tags:           toRPCTags(an.Tags),
+		Title:          an.Title,
+		Notes:          an.Notes,
+		Metadata:       an.Metadata,
+	}
+}
+
+// toQRCodeOptions converts the request to qrCodeOptions. 0の項目はデフォルト値にする
+func toQRCodeOptions(in *rpc.GetAnonyURLQRCodeRequest) qrCodeOptions {
+	o := defaultQRCodeOptions()
+	o.svg = in.GetFormat() == rpc.QRCodeFormat_SVG
+	if v := in.GetImageSize(); v != 0 {
+		o.size = int(v)
+	}
+	switch v := in.GetMargin(); {
+	case v < 0:
+		o.margin = 0
+	case v > 0:
+		o.margin = int(v)
+	}
+	switch in.GetErrorCorrection() {
+	case rpc.ErrorCorrectionLevel_LOW:
+		o.level = qrcode.LevelL
+	case rpc.ErrorCorrectionLevel_QUARTILE:
+		o.level = qrcode.LevelQ
+	case rpc.ErrorCorrectionLevel_HIGH:
+		o.level = qrcode.LevelH
+	}
+	return o
+}
+
+func toRPCLinkPreview(p *model.LinkPreview) *rpc.LinkPreview {
+	if p == nil {
+		return nil
+	}
+	return &rpc.LinkPreview{
+		Title:       p.Title,
+		Description: p.Description,
+		ImageUrl:    p.Image,
+		FaviconUrl:  p.Favicon,
+		FetchedAt:   toTimestamp(&p.FetchedAt),
+	}
+}
+
+func toModelVariants(vs []*rpc.Variant) []*model.Variant {
+	res := make([]*model.Variant, len(vs))
+	for i, v := range vs {
+		res[i] = model.NewVariant(uuid.New().String(), v.GetDestination(), v.GetWeight())
+	}
+	return res
+}
+
+func toRPCVariants(vs []*model.Variant) []*rpc.Variant {
+	res := make([]*rpc.Variant, len(vs))
+	for i, v := range vs {
+		res[i] = &rpc.Variant{
+			Destination: v.Destination,
+			Weight:      v.Weight,
+			Clicks:      v.Clicks,
+		}
+	}
+	return res
+}
+
+func toModelUTM(utm *rpc.UTM) model.UTM {
+	return model.UTM{
+		Source:   utm.GetSource(),
+		Medium:   utm.GetMedium(),
+		Campaign: utm.GetCampaign(),
+		Term:     utm.GetTerm(),
+		Content:  utm.GetContent(),
+	}
+}
+
+func toRPCUTM(utm model.UTM) *rpc.UTM {
+	if utm.IsZero() {
+		return nil
+	}
+	return &rpc.UTM{
+		Source:   utm.Source,
+		Medium:   utm.Medium,
+		Campaign: utm.Campaign,
+		Term:     utm.Term,
+		Content:  utm.Content,
+	}
+}
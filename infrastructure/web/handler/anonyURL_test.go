@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_destinationError(t *testing.T) {
+	blocked := &service.BlockedDestinationError{Destination: "https://evil.example/", Reason: "domain evil.example is in the blocklist"}
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{name: "NORMAL: ブロックされた場合", err: blocked, want: codes.PermissionDenied},
+		{name: "NORMAL: ラップされている場合", err: fmt.Errorf("rules[0]: %w", blocked), want: codes.PermissionDenied},
+		{name: "NORMAL: ループしている場合", err: fmt.Errorf("https://localhost-test/abc: %w", service.ErrRedirectLoop), want: codes.InvalidArgument},
+		{name: "NORMAL: クォータを超えた場合", err: &service.QuotaExceededError{Kind: "anony_urls", Max: 10}, want: codes.ResourceExhausted},
+		{name: "NORMAL: 登録済みのリンクと設定が異なる場合", err: fmt.Errorf("%w: domain", usecase.ErrAnonyURLConflict), want: codes.AlreadyExists},
+		{name: "NORMAL: それ以外のエラーの場合", err: fmt.Errorf("error"), want: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := destinationError(tt.err)
+			if status.Code(got) != tt.want {
+				t.Errorf("destinationError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
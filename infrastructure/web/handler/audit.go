@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/rpc"
+	"github.com/Tatsuemon/anony/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditHandler implements rpc.AuditServiceServer interface
+type AuditHandler struct {
+	usecase usecase.AuditUseCase
+}
+
+// NewAuditHandler creates a new AuditHandler
+func NewAuditHandler(u usecase.AuditUseCase) *AuditHandler {
+	return &AuditHandler{u}
+}
+
+// ListAuditEvents lists audit events in order of created_at desc
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, in *rpc.ListAuditEventsRequest) (*rpc.ListAuditEventsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f := model.AuditEventFilter{
+		ActorID:    in.GetActorId(),
+		Action:     in.GetAction(),
+		TargetType: in.GetTargetType(),
+		TargetID:   in.GetTargetId(),
+		Since:      toTimePtr(in.GetSince()),
+		Until:      toTimePtr(in.GetUntil()),
+		Limit:      int(in.GetLimit()),
+		Offset:     int(in.GetOffset()),
+	}
+	es, err := h.usecase.ListAuditEvents(userID, f)
+	if err != nil {
+		if errors.Is(err, usecase.ErrAuditPermissionDenied) {
+			return nil, status.Errorf(codes.PermissionDenied, "failed to list audit events \n: %s", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to list audit events \n: %s", err)
+	}
+	res := make([]*rpc.AuditEvent, len(es))
+	for i, e := range es {
+		res[i] = toRPCAuditEvent(e)
+	}
+	return &rpc.ListAuditEventsResponse{Events: res}, nil
+}
+
+func toRPCAuditEvent(e *model.AuditEvent) *rpc.AuditEvent {
+	return &rpc.AuditEvent{
+		Id:         e.ID,
+		ActorId:    e.ActorID,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetId:   e.TargetID,
+		Before:     string(e.Before),
+		After:      string(e.After),
+		RequestId:  e.RequestID,
+		ClientIp:   e.ClientIP,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+}
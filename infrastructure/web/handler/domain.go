@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/rpc"
+	"github.com/Tatsuemon/anony/usecase"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// DomainHandler implements rpc.DomainServiceServer interface
+type DomainHandler struct {
+	usecase usecase.DomainUseCase
+}
+
+// NewDomainHandler creates a new DomainHandler
+func NewDomainHandler(u usecase.DomainUseCase) *DomainHandler {
+	return &DomainHandler{u}
+}
+
+// RegisterDomain registers a branded domain of the user
+func (d *DomainHandler) RegisterDomain(ctx context.Context, in *rpc.RegisterDomainRequest) (*rpc.RegisterDomainResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token := strings.ReplaceAll(uuid.New().String(), "-", "")
+	domain := model.NewDomain(uuid.New().String(), in.GetName(), userID, token)
+
+	domain, err = d.usecase.RegisterDomain(ctx, domain)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to register domain \n: %s", err)
+	}
+	return &rpc.RegisterDomainResponse{Domain: toRPCDomain(domain)}, nil
+}
+
+// VerifyDomain verifies the ownership of the domain by DNS TXT record
+func (d *DomainHandler) VerifyDomain(ctx context.Context, in *rpc.VerifyDomainRequest) (*rpc.VerifyDomainResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := d.usecase.VerifyDomain(ctx, strings.ToLower(in.GetName()), userID)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to verify domain \n: %s", err)
+	}
+	return &rpc.VerifyDomainResponse{Domain: toRPCDomain(domain)}, nil
+}
+
+// ListDomains lists user's domains
+func (d *DomainHandler) ListDomains(ctx context.Context, in *emptypb.Empty) (*rpc.ListDomainsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := d.usecase.ListDomains(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := &rpc.ListDomainsResponse{}
+	res.Domains = make([]*rpc.Domain, len(ds))
+	for i, v := range ds {
+		res.Domains[i] = toRPCDomain(v)
+	}
+	return res, nil
+}
+
+func toRPCDomain(d *model.Domain) *rpc.Domain {
+	return &rpc.Domain{
+		Name:                    d.Name,
+		Verified:                d.Verified,
+		VerificationRecordName:  d.VerificationRecordName(),
+		VerificationRecordValue: d.VerificationRecordValue(),
+	}
+}
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tatsuemon/anony/infrastructure/clientip"
+	"github.com/Tatsuemon/anony/infrastructure/ratelimit"
+)
+
+// NotFoundLimitKey is the key of the limit of requests answered 404 per client
+// 上限を超えたクライアントは, トークンが貯まるまで全てのリクエストが429になる
+const NotFoundLimitKey = "not-found"
+
+// 404のキャッシュとペナルティ中のクライアントの件数の上限
+const maxGuardEntries = 100000
+
+// IPBanList reports whether the client IP address is banned
+type IPBanList interface {
+	Banned(ip net.IP) bool
+}
+
+// RequestGuard protects the redirect server and the database from clients enumerating short URLs
+// ストアのエラーではリクエストを止めず, ログに残して通す
+type RequestGuard struct {
+	pages       PageRenderer
+	store       ratelimit.Store
+	limits      ratelimit.Limits
+	bans        IPBanList
+	notFoundTTL time.Duration
+	notFound    *expiringSet
+	penalized   *expiringSet
+	trustedHops int
+	now         func() time.Time
+}
+
+// NewRequestGuard creates a RequestGuard
+// bansがnilの場合はバンしない. notFoundTTLが0の場合は404をキャッシュしない
+// trustedHopsは前にある信頼できるプロキシの数. 0の場合はX-Forwarded-Forを使わない
+func NewRequestGuard(pages PageRenderer, s ratelimit.Store, ls ratelimit.Limits, bans IPBanList, notFoundTTL time.Duration, trustedHops int) *RequestGuard {
+	return &RequestGuard{
+		pages:       pages,
+		store:       s,
+		limits:      ls,
+		bans:        bans,
+		notFoundTTL: notFoundTTL,
+		notFound:    newExpiringSet(maxGuardEntries),
+		penalized:   newExpiringSet(maxGuardEntries),
+		trustedHops: trustedHops,
+		now:         time.Now,
+	}
+}
+
+// Middleware answers 403 to banned clients, 429 to clients exceeding the limits and 404 to cached unknown short URLs
+// mux.Router.Useで全てのリクエストに適用する
+func (g *RequestGuard) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := requestClientIP(r, g.trustedHops)
+		if ip == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := r.Context()
+		now := g.now()
+		client := "ip:" + ip.String()
+		if g.bans != nil && g.bans.Banned(ip) {
+			writeErrorPage(w, g.pages, http.StatusForbidden)
+			return
+		}
+		if until, ok := g.penalized.Get(client, now); ok {
+			writeTooManyRequests(w, g.pages, until.Sub(now))
+			return
+		}
+		if res, ok := g.take(ctx, ratelimit.DefaultKey, client, now); !ok {
+			writeTooManyRequests(w, g.pages, res.RetryAfter)
+			return
+		}
+
+		// パスの大文字と小文字は別のコードなので, ホストのみ小文字にする
+		target := strings.ToLower(r.Host) + r.URL.EscapedPath()
+		if _, ok := g.notFound.Get(target, now); ok {
+			writeErrorPage(w, g.pages, http.StatusNotFound)
+			g.countNotFound(ctx, client, now)
+			return
+		}
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		if rec.status != http.StatusNotFound {
+			return
+		}
+		if g.notFoundTTL > 0 {
+			g.notFound.Add(target, now.Add(g.notFoundTTL), now)
+		}
+		g.countNotFound(ctx, client, now)
+	})
+}
+
+// countNotFound takes a token of the not-found limit, and penalizes the client if there is none
+func (g *RequestGuard) countNotFound(ctx context.Context, client string, now time.Time) {
+	if res, ok := g.take(ctx, NotFoundLimitKey, client, now); !ok {
+		g.penalized.Add(client, now.Add(res.RetryAfter), now)
+	}
+}
+
+// take takes a token from the bucket of the limit and the client
+// 制限がない場合やストアのエラーではtrueを返す
+func (g *RequestGuard) take(ctx context.Context, name, client string, now time.Time) (ratelimit.Result, bool) {
+	l, ok := g.limits[name]
+	if !ok || l.Unlimited() {
+		return ratelimit.Result{Allowed: true}, true
+	}
+	res, err := g.store.Take(ctx, name+"|"+client, l, now)
+	if err != nil {
+		log.Printf("failed to rate-limit %s: %s", client, err)
+		return ratelimit.Result{Allowed: true}, true
+	}
+	return res, res.Allowed
+}
+
+// writeTooManyRequests writes the error page of 429 with Retry-After in seconds
+func writeTooManyRequests(w http.ResponseWriter, pages PageRenderer, retryAfter time.Duration) {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	writeErrorPage(w, pages, http.StatusTooManyRequests)
+}
+
+// requestClientIP returns the IP address of the client
+// X-Forwarded-Forの先頭はクライアントが偽装できるため, 信頼できるプロキシが追加した値だけを使う
+func requestClientIP(r *http.Request, trustedHops int) net.IP {
+	if ip := clientip.FromForwardedFor(r.Header.Values("X-Forwarded-For"), trustedHops); ip != nil {
+		return ip
+	}
+	return clientIP(r)
+}
+
+// statusRecorder records the status written by the handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// expiringSet is a set of keys expiring at their own time, holding at most max keys
+type expiringSet struct {
+	mu      sync.Mutex
+	max     int
+	entries map[string]time.Time
+}
+
+func newExpiringSet(max int) *expiringSet {
+	return &expiringSet{max: max, entries: map[string]time.Time{}}
+}
+
+// Get returns the expiry of the key if it is not expired
+func (s *expiringSet) Get(key string, now time.Time) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	until, ok := s.entries[key]
+	if !ok {
+		return time.Time{}, false
+	}
+	if !now.Before(until) {
+		delete(s.entries, key)
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// Add adds the key expiring at until
+// 上限に達した場合は期限切れのキーを削除し, それでも多い場合は任意のキーを1割削除する
+func (s *expiringSet) Add(key string, until, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries[key]; !ok && len(s.entries) >= s.max {
+		for k, v := range s.entries {
+			if !now.Before(v) {
+				delete(s.entries, k)
+			}
+		}
+		for k := range s.entries {
+			if len(s.entries) < s.max-s.max/10 {
+				break
+			}
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = until
+}
+
+// Len returns the number of the keys including expired ones
+func (s *expiringSet) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
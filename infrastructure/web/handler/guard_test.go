@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/infrastructure/ratelimit"
+)
+
+type banListStub map[string]bool
+
+func (b banListStub) Banned(ip net.IP) bool {
+	return b[ip.String()]
+}
+
+type rateLimitStoreStub struct{}
+
+func (rateLimitStoreStub) Take(ctx context.Context, key string, l ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("connection refused")
+}
+
+// guardTarget answers 404 except "/abc" and counts the requests
+type guardTarget struct {
+	calls int
+}
+
+func (h *guardTarget) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.calls++
+	if r.URL.Path != "/abc" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusFound)
+}
+
+func serveGuard(g *RequestGuard, next http.Handler, target, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	req.RemoteAddr = remoteAddr
+	w := httptest.NewRecorder()
+	g.Middleware(next).ServeHTTP(w, req)
+	return w
+}
+
+func TestRequestGuard_Middleware_RateLimit(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	g := NewRequestGuard(&pageRendererStub{}, ratelimit.NewMemoryStore(), ratelimit.Limits{ratelimit.DefaultKey: {Rate: 1, Burst: 2}}, nil, 0, 0)
+	g.now = func() time.Time { return now }
+	next := &guardTarget{}
+
+	for i := 0; i < 2; i++ {
+		if w := serveGuard(g, next, "http://anony.example/abc", "192.0.2.1:1234"); w.Code != http.StatusFound {
+			t.Fatalf("RequestGuard.Middleware() #%d code = %v, want %v", i, w.Code, http.StatusFound)
+		}
+	}
+	w := serveGuard(g, next, "http://anony.example/abc", "192.0.2.1:1234")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "1" {
+		t.Errorf("RequestGuard.Middleware() code = %v, Retry-After = %q, want 429 after 1s", w.Code, w.Header().Get("Retry-After"))
+	}
+	if next.calls != 2 {
+		t.Errorf("RequestGuard.Middleware() called the handler %d times, want 2", next.calls)
+	}
+	// クライアントごとに別のバケット
+	if w := serveGuard(g, next, "http://anony.example/abc", "192.0.2.2:1234"); w.Code != http.StatusFound {
+		t.Errorf("RequestGuard.Middleware() of another client code = %v, want %v", w.Code, http.StatusFound)
+	}
+}
+
+func TestRequestGuard_Middleware_NotFound(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	g := NewRequestGuard(&pageRendererStub{}, ratelimit.NewMemoryStore(), ratelimit.Limits{NotFoundLimitKey: {Rate: 0.1, Burst: 2}}, nil, time.Minute, 0)
+	g.now = func() time.Time { return now }
+	next := &guardTarget{}
+
+	// 存在しないコードは2回目以降データベースを引かずに404を返す
+	for i := 0; i < 2; i++ {
+		if w := serveGuard(g, next, "http://Anony.example/unknown", "192.0.2.1:1234"); w.Code != http.StatusNotFound {
+			t.Fatalf("RequestGuard.Middleware() #%d code = %v, want %v", i, w.Code, http.StatusNotFound)
+		}
+	}
+	if next.calls != 1 {
+		t.Errorf("RequestGuard.Middleware() called the handler %d times, want 1", next.calls)
+	}
+	// 404の上限を超えたクライアントは, 以降は存在するコードも429になる
+	if w := serveGuard(g, next, "http://anony.example/unknown", "192.0.2.1:1234"); w.Code != http.StatusNotFound {
+		t.Errorf("RequestGuard.Middleware() code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+	w := serveGuard(g, next, "http://anony.example/abc", "192.0.2.1:1234")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "10" {
+		t.Errorf("RequestGuard.Middleware() code = %v, Retry-After = %q, want 429 after 10s", w.Code, w.Header().Get("Retry-After"))
+	}
+	// 他のクライアントは制限されない
+	if w := serveGuard(g, next, "http://anony.example/abc", "192.0.2.2:1234"); w.Code != http.StatusFound {
+		t.Errorf("RequestGuard.Middleware() of another client code = %v, want %v", w.Code, http.StatusFound)
+	}
+	// キャッシュの期限が切れた後はデータベースを引き直す
+	now = now.Add(time.Minute)
+	if w := serveGuard(g, next, "http://anony.example/unknown", "192.0.2.2:1234"); w.Code != http.StatusNotFound || next.calls != 3 {
+		t.Errorf("RequestGuard.Middleware() code = %v, calls = %v, want 404 from the handler", w.Code, next.calls)
+	}
+}
+
+func TestRequestGuard_Middleware_Client(t *testing.T) {
+	limits := ratelimit.Limits{ratelimit.DefaultKey: {Rate: 1, Burst: 1}}
+	tests := []struct {
+		name         string
+		store        ratelimit.Store
+		trustedHops  int
+		forwardedFor string
+		wantCode     int
+	}{
+		{name: "NORMAL: バンされたクライアント", store: ratelimit.NewMemoryStore(), wantCode: http.StatusForbidden},
+		{name: "NORMAL: X-Forwarded-Forを信頼する場合はプロキシが追加した末尾のクライアント", store: ratelimit.NewMemoryStore(), trustedHops: 1, forwardedFor: "192.0.2.1, 198.51.100.1", wantCode: http.StatusFound},
+		{name: "NORMAL: バンされたクライアントが先頭を偽装しても末尾で判定する", store: ratelimit.NewMemoryStore(), trustedHops: 1, forwardedFor: "198.51.100.1, 203.0.113.9", wantCode: http.StatusForbidden},
+		{name: "NORMAL: 信頼できるプロキシの分だけ末尾から飛ばす", store: ratelimit.NewMemoryStore(), trustedHops: 2, forwardedFor: "198.51.100.1, 203.0.113.9, 10.0.0.1", wantCode: http.StatusForbidden},
+		{name: "NORMAL: X-Forwarded-Forを信頼しない場合は接続元", store: ratelimit.NewMemoryStore(), forwardedFor: "198.51.100.1", wantCode: http.StatusForbidden},
+		{name: "NORMAL: ストアのエラーでは制限しない", store: rateLimitStoreStub{}, trustedHops: 1, forwardedFor: "198.51.100.1", wantCode: http.StatusFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewRequestGuard(&pageRendererStub{}, tt.store, limits, banListStub{"192.0.2.1": true, "203.0.113.9": true}, 0, tt.trustedHops)
+			req := httptest.NewRequest(http.MethodGet, "http://anony.example/abc", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			w := httptest.NewRecorder()
+			g.Middleware(&guardTarget{}).ServeHTTP(w, req)
+			if w.Code != tt.wantCode {
+				t.Errorf("RequestGuard.Middleware() code = %v, want %v", w.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func Test_expiringSet_Add(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	s := newExpiringSet(10)
+	for i := 0; i < 10; i++ {
+		until := now.Add(time.Minute)
+		if i < 5 {
+			until = now
+		}
+		s.Add(string(rune('a'+i)), until, now)
+	}
+	// 上限に達した場合は期限切れのキーを削除する
+	s.Add("k", now.Add(time.Minute), now)
+	if s.Len() != 6 {
+		t.Errorf("expiringSet.Len() = %v, want 6", s.Len())
+	}
+	if _, ok := s.Get("a", now); ok {
+		t.Errorf("expiringSet.Get() of the expired key = true, want false")
+	}
+	for i := 0; i < 10; i++ {
+		s.Add(string(rune('A'+i)), now.Add(time.Minute), now)
+	}
+	if s.Len() > 10 {
+		t.Errorf("expiringSet.Len() = %v, want at most 10", s.Len())
+	}
+	if _, ok := s.Get("J", now); !ok {
+		t.Errorf("expiringSet.Get() of the added key = false, want true")
+	}
+}
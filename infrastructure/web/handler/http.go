@@ -2,9 +2,17 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"log"
+	"net"
 	"net/http"
-	"os"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/geoip"
 	"github.com/Tatsuemon/anony/usecase"
 )
 
@@ -14,29 +22,232 @@ type HttpHandler interface {
 
 type httpHandler struct {
 	usecase.AnonyURLUseCase
+	domains             service.VerifiedDomainFinder
+	redirectRuleUseCase usecase.RedirectRuleUseCase
+	geoIP               geoip.Reader
+	pages               PageRenderer
+	hosts               map[string]struct{}
 }
 
-func NewHttpHandler(u usecase.AnonyURLUseCase) HttpHandler {
-	return &httpHandler{u}
+// NewHttpHandler creates a handler redirecting short URLs served on hosts and verified domains
+// リクエストのたびにデータベースを引かないように, 確認済みのドメインはメモリに持ったものを使う
+func NewHttpHandler(u usecase.AnonyURLUseCase, vd service.VerifiedDomainFinder, ru usecase.RedirectRuleUseCase, geo geoip.Reader, pages PageRenderer, hosts []string) HttpHandler {
+	h := &httpHandler{u, vd, ru, geo, pages, map[string]struct{}{}}
+	for _, v := range hosts {
+		parsed, err := url.Parse(v)
+		if err != nil || parsed.Host == "" {
+			continue
+		}
+		h.hosts[strings.ToLower(parsed.Host)] = struct{}{}
+	}
+	return h
 }
 
 func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	host := os.Getenv("SERVER_HOST")
-	anURL := host + r.URL.String()
+	ctx := context.Background()
+	domainID, ok, err := h.resolveDomainID(r.Host)
+	if err != nil || !ok {
+		writeErrorPage(w, h.pages, http.StatusNotFound)
+		return
+	}
+	if h.servePreview(ctx, w, r, domainID) {
+		return
+	}
+	if h.serveQRCode(ctx, w, r, domainID) {
+		return
+	}
 
-	original, err := h.AnonyURLUseCase.GetOriginalByAnonyURL(context.Background(), anURL)
+	an, extraPath, err := h.findAnonyURL(ctx, domainID, r.URL.EscapedPath())
 	if err != nil {
-		http.NotFound(w, r)
+		writeErrorPage(w, h.pages, http.StatusNotFound)
 		return
 	}
-	if original == "" {
-		http.NotFound(w, r)
+	if an == nil {
+		writeErrorPage(w, h.pages, http.StatusNotFound)
 		return
 	}
 
-	w.Header().Set("Content-Type", "text/html")
-	w.Header().Set("location", original)
-	// 301 Moved Permanently
-	w.WriteHeader(http.StatusMovedPermanently)
-	return
+	// 条件に一致するルールがある場合は, ルールのリダイレクト先を元のURLとして扱う
+	// 有効期間外の場合はFallbackへリダイレクトするので, ルールは評価しない
+	var rule *model.RedirectRule
+	if an.InSchedule(time.Now()) {
+		rule, err = h.redirectRuleUseCase.MatchRedirectRule(ctx, an.ID, h.ruleContext(r))
+		if err != nil {
+			writeErrorPage(w, h.pages, http.StatusInternalServerError)
+			return
+		}
+	}
+	variantID := ""
+	if rule != nil {
+		matched := *an
+		matched.Original = rule.Destination
+		an = &matched
+	} else if v := pickVariant(w, r, an); v != nil {
+		matched := *an
+		matched.Original = v.Destination
+		an = &matched
+		variantID = v.ID
+	}
+
+	dest, err := an.Destination(extraPath, r.URL.Query())
+	if err != nil {
+		writeErrorPage(w, h.pages, http.StatusNotFound)
+		return
+	}
+	// 登録後に追加されたドメイン等でループした場合に, ブラウザがリダイレクトを繰り返さないようにする
+	// それ以外のエラーはリダイレクト先で処理されるので無視する
+	if _, err := h.AnonyURLUseCase.ResolveRedirectChain(ctx, dest, an); errors.Is(err, service.ErrRedirectLoop) || errors.Is(err, service.ErrRedirectChainTooLong) {
+		writeErrorPage(w, h.pages, http.StatusLoopDetected)
+		return
+	}
+	if err := writeRedirect(w, r, dest, an.GetRedirectMode(), an.Interstitial); err != nil {
+		log.Printf("failed to redirect %s: %s", an.ID, err)
+		if errors.Is(err, errUnsafeDestination) {
+			writeErrorPage(w, h.pages, http.StatusNotFound)
+		} else {
+			writeErrorPage(w, h.pages, http.StatusInternalServerError)
+		}
+		return
+	}
+	// リダイレクトできた場合のみクリック数を記録する. 記録の失敗はログに残すだけ
+	if err := h.AnonyURLUseCase.RecordClick(ctx, an, variantID); err != nil {
+		log.Printf("failed to record click of %s: %s", an.ID, err)
+	}
+}
+
+// servePreview shows the destination of "/code+" or "/preview/code" instead of redirecting
+// プレビューのパスでない場合や, "/preview/code"が移行前の2セグメントのコードの場合はfalseを返す
+func (h *httpHandler) servePreview(ctx context.Context, w http.ResponseWriter, r *http.Request, domainID string) bool {
+	escapedPath := r.URL.EscapedPath()
+	plus := strings.HasSuffix(escapedPath, "+")
+	if plus {
+		escapedPath = strings.TrimSuffix(escapedPath, "+")
+	} else if strings.HasPrefix(escapedPath, "/preview/") {
+		escapedPath = strings.TrimPrefix(escapedPath, "/preview")
+	} else {
+		return false
+	}
+
+	an, extraPath, err := h.findAnonyURL(ctx, domainID, escapedPath)
+	if err != nil || an == nil || extraPath != "" {
+		if !plus {
+			return false
+		}
+		writeErrorPage(w, h.pages, http.StatusNotFound)
+		return true
+	}
+
+	data := PreviewPageData{
+		ShortURL:    an.ShortURL(requestOrigin(r)),
+		Destination: an.Original,
+		CreatedAt:   an.CreatedAt,
+	}
+	if u, err := url.Parse(an.Original); err == nil {
+		data.Host = u.Hostname()
+	}
+	// 所有者が付けたタイトルを優先する
+	data.Title = an.DisplayTitle()
+	if an.Preview != nil {
+		data.Description = an.Preview.Description
+		data.Image = an.Preview.Image
+	}
+	// プレビューはクリック数に含めない
+	w.Header().Set("Cache-Control", temporaryCacheControl)
+	w.Header().Set("X-Robots-Tag", "noindex")
+	if err := h.pages.Render(w, http.StatusOK, PagePreview, data); err != nil {
+		log.Printf("failed to render the preview page: %s", err)
+		writeErrorPage(w, h.pages, http.StatusInternalServerError)
+	}
+	return true
+}
+
+// serveQRCode returns the QR code image of the short URL for "/code.qr"
+// ".qr"で終わるコードが登録されている場合はリダイレクトできるようにfalseを返す
+func (h *httpHandler) serveQRCode(ctx context.Context, w http.ResponseWriter, r *http.Request, domainID string) bool {
+	escapedPath := r.URL.EscapedPath()
+	if !strings.HasSuffix(escapedPath, qrCodeSuffix) {
+		return false
+	}
+	an, extraPath, err := h.findAnonyURL(ctx, domainID, strings.TrimSuffix(escapedPath, qrCodeSuffix))
+	if err != nil || an == nil || extraPath != "" {
+		return false
+	}
+
+	o, err := parseQRCodeQuery(r.URL.Query())
+	if err != nil {
+		writeErrorPage(w, h.pages, http.StatusBadRequest)
+		return true
+	}
+	image, contentType, err := renderQRCode(an.ShortURL(requestOrigin(r)), o)
+	if err != nil {
+		writeErrorPage(w, h.pages, http.StatusBadRequest)
+		return true
+	}
+	// 短縮URLは変わらないので, リンクの状態によらずキャッシュできる
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", qrCodeCacheControl)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(image); err != nil {
+		log.Printf("failed to write the QR code of %s: %s", an.ID, err)
+	}
+	return true
+}
+
+// requestOrigin returns the scheme and the host the request was sent to
+func requestOrigin(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+// findAnonyURL finds the anonyURL by the code at the head of the escaped path
+// 戻り値の2つ目はコード以降のパス
+func (h *httpHandler) findAnonyURL(ctx context.Context, domainID, escapedPath string) (*model.AnonyURL, string, error) {
+	return service.FindAnonyURLByPath(escapedPath, func(code string) (*model.AnonyURL, error) {
+		return h.AnonyURLUseCase.GetOriginalByAnonyURL(ctx, domainID, code)
+	})
+}
+
+// ruleContext creates the attributes of the request evaluated by redirect rules
+func (h *httpHandler) ruleContext(r *http.Request) model.RuleContext {
+	country := ""
+	if ip := clientIP(r); ip != nil {
+		// GeoIPで判定できない場合は国の条件に一致しないだけなので, エラーは無視する
+		country, _ = h.geoIP.Country(ip)
+	}
+	return model.NewRuleContext(r.UserAgent(), r.Header.Get("Accept-Language"), country, time.Now())
+}
+
+// clientIP returns the IP address of the client
+func clientIP(r *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return net.ParseIP(host)
+}
+
+// resolveDomainID returns the domain id of the request Host
+// デフォルトのホストの場合は空文字, 確認済みのドメインでない場合はfalseを返す
+func (h *httpHandler) resolveDomainID(host string) (string, bool, error) {
+	host = strings.ToLower(host)
+	if _, ok := h.hosts[host]; ok {
+		return "", true, nil
+	}
+	name := host
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		name = hostname
+	}
+	d, err := h.domains.FindVerifiedByName(name)
+	if err != nil {
+		return "", false, err
+	}
+	if d == nil {
+		// ホストが設定されていない場合は, 全てのHostをデフォルトとして受け付ける
+		return "", len(h.hosts) == 0, nil
+	}
+	return d.ID, true, nil
 }
@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/geoip"
+	"github.com/Tatsuemon/anony/usecase"
+)
+
+// anonyURLUseCaseStub serves AnonyURLs by the code and records clicks
+type anonyURLUseCaseStub struct {
+	usecase.AnonyURLUseCase
+	ans    map[string]*model.AnonyURL
+	clicks []string
+}
+
+func (s *anonyURLUseCaseStub) GetOriginalByAnonyURL(ctx context.Context, domainID, anonyURL string) (*model.AnonyURL, error) {
+	return s.ans[anonyURL], nil
+}
+
+func (s *anonyURLUseCaseStub) ResolveRedirectChain(ctx context.Context, destination string, self *model.AnonyURL) (string, error) {
+	return destination, nil
+}
+
+func (s *anonyURLUseCaseStub) RecordClick(ctx context.Context, an *model.AnonyURL, variantID string) error {
+	s.clicks = append(s.clicks, an.ID)
+	return nil
+}
+
+type redirectRuleUseCaseStub struct {
+	usecase.RedirectRuleUseCase
+}
+
+func (redirectRuleUseCaseStub) MatchRedirectRule(ctx context.Context, anonyURLID string, c model.RuleContext) (*model.RedirectRule, error) {
+	return nil, nil
+}
+
+// pageRendererStub records the rendered page
+type pageRendererStub struct {
+	name string
+	data interface{}
+}
+
+func (p *pageRendererStub) Render(w http.ResponseWriter, status int, name string, data interface{}) error {
+	p.name = name
+	p.data = data
+	w.WriteHeader(status)
+	return nil
+}
+
+func Test_httpHandler_ServeHTTP_Preview(t *testing.T) {
+	createdAt := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	ans := map[string]*model.AnonyURL{
+		"abc": {
+			ID: "id1", Short: "abc", Original: "https://example.com/page", Status: 1, CreatedAt: createdAt,
+			Preview: &model.LinkPreview{Title: "Example", Description: "desc"},
+		},
+		"titled": {
+			ID: "id3", Short: "titled", Original: "https://example.com/page", Status: 1, CreatedAt: createdAt, Title: "My link",
+			Preview: &model.LinkPreview{Title: "Example", Description: "desc"},
+		},
+		// 移行前の2セグメントのコード
+		"preview/legacy": {ID: "id2", Short: "preview/legacy", Original: "https://example.org/", Status: 1},
+	}
+	tests := []struct {
+		name       string
+		target     string
+		wantCode   int
+		wantPage   string
+		wantData   interface{}
+		wantClicks int
+	}{
+		{
+			name:     "NORMAL: +を付けるとプレビューを表示する",
+			target:   "http://anony.example/abc+",
+			wantCode: http.StatusOK,
+			wantPage: PagePreview,
+			wantData: PreviewPageData{
+				ShortURL:    "http://anony.example/abc",
+				Destination: "https://example.com/page",
+				Host:        "example.com",
+				Title:       "Example",
+				Description: "desc",
+				CreatedAt:   createdAt,
+			},
+		},
+		{
+			name:     "NORMAL: /preview/codeでプレビューを表示する",
+			target:   "http://anony.example/preview/abc",
+			wantCode: http.StatusOK,
+			wantPage: PagePreview,
+			wantData: PreviewPageData{
+				ShortURL:    "http://anony.example/abc",
+				Destination: "https://example.com/page",
+				Host:        "example.com",
+				Title:       "Example",
+				Description: "desc",
+				CreatedAt:   createdAt,
+			},
+		},
+		{
+			name:     "NORMAL: 所有者が付けたタイトルを優先する",
+			target:   "http://anony.example/titled+",
+			wantCode: http.StatusOK,
+			wantPage: PagePreview,
+			wantData: PreviewPageData{
+				ShortURL:    "http://anony.example/titled",
+				Destination: "https://example.com/page",
+				Host:        "example.com",
+				Title:       "My link",
+				Description: "desc",
+				CreatedAt:   createdAt,
+			},
+		},
+		{
+			name:       "NORMAL: /preview/から始まる移行前のコードはリダイレクトする",
+			target:     "http://anony.example/preview/legacy",
+			wantCode:   http.StatusFound,
+			wantClicks: 1,
+		},
+		{
+			name:     "NORMAL: 存在しないコードのプレビューは404",
+			target:   "http://anony.example/unknown+",
+			wantCode: http.StatusNotFound,
+			wantPage: PageError,
+			wantData: ErrorPageData{Status: http.StatusNotFound, Title: "Not Found", Message: errorMessages[http.StatusNotFound]},
+		},
+		{
+			name:       "NORMAL: +が無い場合はリダイレクトする",
+			target:     "http://anony.example/abc",
+			wantCode:   http.StatusFound,
+			wantClicks: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &anonyURLUseCaseStub{ans: ans}
+			pages := &pageRendererStub{}
+			h := NewHttpHandler(u, nil, redirectRuleUseCaseStub{}, geoip.NewNopReader(), pages, []string{"http://anony.example"})
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if w.Code != tt.wantCode {
+				t.Errorf("httpHandler.ServeHTTP() code = %v, want %v", w.Code, tt.wantCode)
+			}
+			if pages.name != tt.wantPage {
+				t.Errorf("httpHandler.ServeHTTP() page = %v, want %v", pages.name, tt.wantPage)
+			}
+			if tt.wantData != nil && pages.data != tt.wantData {
+				t.Errorf("httpHandler.ServeHTTP() data = %+v, want %+v", pages.data, tt.wantData)
+			}
+			if len(u.clicks) != tt.wantClicks {
+				t.Errorf("httpHandler.ServeHTTP() clicks = %v, want %v", len(u.clicks), tt.wantClicks)
+			}
+		})
+	}
+}
+
+func Test_httpHandler_ServeHTTP_QRCode(t *testing.T) {
+	ans := map[string]*model.AnonyURL{
+		"abc": {ID: "id1", Short: "abc", Original: "https://example.com/page", Status: 1},
+		// ".qr"で終わるコード
+		"x.qr": {ID: "id2", Short: "x.qr", Original: "https://example.org/", Status: 1},
+	}
+	tests := []struct {
+		name            string
+		target          string
+		wantCode        int
+		wantContentType string
+		wantPage        string
+		wantClicks      int
+	}{
+		{
+			name:            "NORMAL: PNGを返す",
+			target:          "http://anony.example/abc.qr",
+			wantCode:        http.StatusOK,
+			wantContentType: contentTypePNG,
+		},
+		{
+			name:            "NORMAL: オプションを指定してSVGを返す",
+			target:          "http://anony.example/abc.qr?format=svg&size=512&ec=H&margin=0",
+			wantCode:        http.StatusOK,
+			wantContentType: contentTypeSVG,
+		},
+		{
+			name:       "NORMAL: .qrで終わるコードはリダイレクトする",
+			target:     "http://anony.example/x.qr",
+			wantCode:   http.StatusFound,
+			wantClicks: 1,
+		},
+		{
+			name:     "NORMAL: 存在しないコードは404",
+			target:   "http://anony.example/unknown.qr",
+			wantCode: http.StatusNotFound,
+			wantPage: PageError,
+		},
+		{
+			name:     "ERROR: 誤り訂正レベルが不正",
+			target:   "http://anony.example/abc.qr?ec=X",
+			wantCode: http.StatusBadRequest,
+			wantPage: PageError,
+		},
+		{
+			name:     "ERROR: サイズが上限を超える",
+			target:   "http://anony.example/abc.qr?size=4096",
+			wantCode: http.StatusBadRequest,
+			wantPage: PageError,
+		},
+		{
+			name:     "ERROR: サイズが小さすぎる",
+			target:   "http://anony.example/abc.qr?size=10",
+			wantCode: http.StatusBadRequest,
+			wantPage: PageError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &anonyURLUseCaseStub{ans: ans}
+			pages := &pageRendererStub{}
+			h := NewHttpHandler(u, nil, redirectRuleUseCaseStub{}, geoip.NewNopReader(), pages, []string{"http://anony.example"})
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if w.Code != tt.wantCode {
+				t.Errorf("httpHandler.ServeHTTP() code = %v, want %v", w.Code, tt.wantCode)
+			}
+			if pages.name != tt.wantPage {
+				t.Errorf("httpHandler.ServeHTTP() page = %v, want %v", pages.name, tt.wantPage)
+			}
+			if tt.wantContentType != "" {
+				if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+					t.Errorf("httpHandler.ServeHTTP() Content-Type = %v, want %v", got, tt.wantContentType)
+				}
+				if w.Body.Len() == 0 {
+					t.Errorf("httpHandler.ServeHTTP() body is empty")
+				}
+			}
+			if len(u.clicks) != tt.wantClicks {
+				t.Errorf("httpHandler.ServeHTTP() clicks = %v, want %v", len(u.clicks), tt.wantClicks)
+			}
+		})
+	}
+}
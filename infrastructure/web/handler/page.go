@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// リダイレクトサーバーのHTMLページの名前. テンプレートはcmd/http/pageにある
+const (
+	PagePreview = "preview"
+	PageError   = "error"
+)
+
+// PageRenderer renders HTML pages of the redirect server
+type PageRenderer interface {
+	// Render writes the page with the status, or returns an error without writing anything
+	Render(w http.ResponseWriter, status int, name string, data interface{}) error
+}
+
+// PreviewPageData is shown instead of redirecting when "+" is appended to the short URL
+type PreviewPageData struct {
+	ShortURL    string // 続行した場合は短縮URLへ遷移し, 通常どおりリダイレクトする
+	Destination string
+	Host        string
+	Title       string
+	Description string
+	Image       string
+	CreatedAt   time.Time
+}
+
+// ErrorPageData is shown when the short URL can not be redirected
+type ErrorPageData struct {
+	Status  int
+	Title   string
+	Message string
+}
+
+// errorMessages are the messages of the error pages by the status
+var errorMessages = map[int]string{
+	http.StatusBadRequest:          "The request is invalid.",
+	http.StatusForbidden:           "Access from your network is blocked.",
+	http.StatusNotFound:            "This short URL does not exist or is no longer active.",
+	http.StatusTooManyRequests:     "Too many requests. Please wait a moment and try again.",
+	http.StatusLoopDetected:        "This short URL redirects back to itself.",
+	http.StatusInternalServerError: "Something went wrong. Please try again later.",
+}
+
+// writeErrorPage writes the error page of the status
+// テンプレートの描画に失敗した場合はテキストで返す
+func writeErrorPage(w http.ResponseWriter, pages PageRenderer, status int) {
+	w.Header().Set("Cache-Control", temporaryCacheControl)
+	data := ErrorPageData{Status: status, Title: http.StatusText(status), Message: errorMessages[status]}
+	if err := pages.Render(w, status, PageError, data); err != nil {
+		log.Printf("failed to render the error page: %s", err)
+		http.Error(w, http.StatusText(status), status)
+	}
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/Tatsuemon/anony/infrastructure/qrcode"
+)
+
+// QRコードの画像のデフォルトと上限
+const (
+	defaultQRCodeSize   = 256
+	maxQRCodeSize       = 2048
+	defaultQRCodeMargin = 4
+	maxQRCodeMargin     = 16
+)
+
+// qrCodeSuffix is appended to the short URL to get its QR code on the redirect server
+const qrCodeSuffix = ".qr"
+
+// 短縮URLが変わらない限り画像も変わらない
+const qrCodeCacheControl = "public, max-age=86400"
+
+const (
+	contentTypePNG = "image/png"
+	contentTypeSVG = "image/svg+xml"
+)
+
+// qrCodeOptions is the appearance of the QR code image
+type qrCodeOptions struct {
+	svg    bool
+	size   int
+	level  qrcode.Level
+	margin int
+}
+
+func defaultQRCodeOptions() qrCodeOptions {
+	return qrCodeOptions{size: defaultQRCodeSize, level: qrcode.LevelM, margin: defaultQRCodeMargin}
+}
+
+// renderQRCode encodes the short URL into the image and returns it with the content type
+func renderQRCode(shortURL string, o qrCodeOptions) ([]byte, string, error) {
+	if o.size < 1 || o.size > maxQRCodeSize {
+		return nil, "", fmt.Errorf("size must be between 1 and %d", maxQRCodeSize)
+	}
+	if o.margin < 0 || o.margin > maxQRCodeMargin {
+		return nil, "", fmt.Errorf("margin must be between 0 and %d", maxQRCodeMargin)
+	}
+	c, err := qrcode.Encode(shortURL, o.level)
+	if err != nil {
+		return nil, "", err
+	}
+	if o.svg {
+		b, err := c.SVG(o.size, o.margin)
+		return b, contentTypeSVG, err
+	}
+	b, err := c.PNG(o.size, o.margin)
+	return b, contentTypePNG, err
+}
+
+// parseQRCodeQuery reads the options from the query parameters: size, ec, margin and format
+func parseQRCodeQuery(q url.Values) (qrCodeOptions, error) {
+	o := defaultQRCodeOptions()
+	var err error
+	if v := q.Get("size"); v != "" {
+		if o.size, err = strconv.Atoi(v); err != nil {
+			return o, fmt.Errorf("size %q is invalid", v)
+		}
+	}
+	if v := q.Get("margin"); v != "" {
+		if o.margin, err = strconv.Atoi(v); err != nil {
+			return o, fmt.Errorf("margin %q is invalid", v)
+		}
+	}
+	if o.level, err = qrcode.ParseLevel(q.Get("ec")); err != nil {
+		return o, err
+	}
+	switch v := q.Get("format"); v {
+	case "", "png":
+	case "svg":
+		o.svg = true
+	default:
+		return o, fmt.Errorf("format %q is invalid", v)
+	}
+	return o, nil
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+const (
+	// 恒久的なリダイレクトでも, 無効化が反映されるようにキャッシュ期間を制限する
+	permanentCacheControl = "public, max-age=86400"
+	temporaryCacheControl = "private, no-cache, no-store, max-age=0"
+)
+
+var interstitialTemplate = template.Must(template.New("interstitial").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="referrer" content="no-referrer">
+<meta http-equiv="refresh" content="0;url={{.}}">
+<title>Redirecting...</title>
+</head>
+<body>
+<p>Redirecting to <a href="{{.}}" rel="noreferrer">{{.}}</a></p>
+<script>window.location.replace({{.}});</script>
+</body>
+</html>
+`))
+
+// errUnsafeDestination is returned when the destination is not a http(s) URL
+var errUnsafeDestination = errors.New("destination is not a http or https URL")
+
+// writeRedirect writes the response redirecting to original by the redirect mode, or the interstitial page
+// javascript:などのURLへはリダイレクトしない
+func writeRedirect(w http.ResponseWriter, r *http.Request, original string, mode int64, interstitial bool) error {
+	u, err := url.Parse(original)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errUnsafeDestination
+	}
+	if interstitial {
+		// 途中で失敗した場合にエラーページを返せるように, 書き込む前に描画する
+		var buf bytes.Buffer
+		if err := interstitialTemplate.Execute(&buf, original); err != nil {
+			return err
+		}
+		w.Header().Set("Cache-Control", temporaryCacheControl)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, err := buf.WriteTo(w)
+		return err
+	}
+	switch mode {
+	case model.RedirectModeMovedPermanently, model.RedirectModePermanentRedirect:
+		w.Header().Set("Cache-Control", permanentCacheControl)
+		http.Redirect(w, r, original, int(mode))
+	case model.RedirectModeFound, model.RedirectModeTemporaryRedirect:
+		w.Header().Set("Cache-Control", temporaryCacheControl)
+		http.Redirect(w, r, original, int(mode))
+	default:
+		w.Header().Set("Cache-Control", temporaryCacheControl)
+		http.Redirect(w, r, original, int(model.DefaultRedirectMode))
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/rpc"
+	"github.com/Tatsuemon/anony/usecase"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RedirectRuleHandler implements rpc.RedirectRuleServiceServer interface
+type RedirectRuleHandler struct {
+	usecase usecase.RedirectRuleUseCase
+}
+
+// NewRedirectRuleHandler creates a new RedirectRuleHandler
+func NewRedirectRuleHandler(u usecase.RedirectRuleUseCase) *RedirectRuleHandler {
+	return &RedirectRuleHandler{u}
+}
+
+// ListRedirectRules lists the rules of the user's AnonyURL in order of evaluation
+func (h *RedirectRuleHandler) ListRedirectRules(ctx context.Context, in *rpc.ListRedirectRulesRequest) (*rpc.ListRedirectRulesResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := h.usecase.ListRedirectRules(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to list redirect rules \n: %s", err)
+	}
+	return &rpc.ListRedirectRulesResponse{Rules: toRPCRedirectRules(rules)}, nil
+}
+
+// SetRedirectRules replaces the rules of the user's AnonyURL
+func (h *RedirectRuleHandler) SetRedirectRules(ctx context.Context, in *rpc.SetRedirectRulesRequest) (*rpc.SetRedirectRulesResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]*model.RedirectRule, len(in.GetRules()))
+	for i, v := range in.GetRules() {
+		rule := model.NewRedirectRule(uuid.New().String(), "", int64(i), v.GetDestination())
+		rule.Platform = platforms[v.GetPlatform()]
+		rule.Language = strings.ToLower(v.GetLanguage())
+		rule.Country = strings.ToUpper(v.GetCountry())
+		rule.ActiveFrom = toTimePtr(v.GetActiveFrom())
+		rule.ActiveUntil = toTimePtr(v.GetActiveUntil())
+		rules[i] = rule
+	}
+	rules, err = h.usecase.SetRedirectRules(ctx, in.GetOriginalUrl(), toModelUTM(in.GetUtm()), userID, rules)
+	if err != nil {
+		if s := destinationError(err); s != nil {
+			return nil, s
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to set redirect rules \n: %s", err)
+	}
+	return &rpc.SetRedirectRulesResponse{Rules: toRPCRedirectRules(rules)}, nil
+}
+
+// rpc.Platformとmodelのプラットフォームの対応
+var platforms = map[rpc.Platform]string{
+	rpc.Platform_PLATFORM_ANY: "",
+	rpc.Platform_IOS:          model.PlatformIOS,
+	rpc.Platform_ANDROID:      model.PlatformAndroid,
+	rpc.Platform_WINDOWS:      model.PlatformWindows,
+	rpc.Platform_MACOS:        model.PlatformMacOS,
+	rpc.Platform_LINUX:        model.PlatformLinux,
+	rpc.Platform_OTHER:        model.PlatformOther,
+}
+
+func toRPCPlatform(platform string) rpc.Platform {
+	for k, v := range platforms {
+		if v == platform {
+			return k
+		}
+	}
+	return rpc.Platform_PLATFORM_ANY
+}
+
+func toTimePtr(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toRPCRedirectRules(rules []*model.RedirectRule) []*rpc.RedirectRule {
+	res := make([]*rpc.RedirectRule, len(rules))
+	for i, v := range rules {
+		res[i] = &rpc.RedirectRule{
+			Platform:    toRPCPlatform(v.Platform),
+			Language:    v.Language,
+			Country:     v.Country,
+			ActiveFrom:  toTimestamp(v.ActiveFrom),
+			ActiveUntil: toTimestamp(v.ActiveUntil),
+			Destination: v.Destination,
+		}
+	}
+	return res
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+func Test_writeRedirect(t *testing.T) {
+	original := "https://example.com/path?q=1&r=2"
+	tests := []struct {
+		name             string
+		original         string
+		mode             int64
+		interstitial     bool
+		wantCode         int
+		wantLocation     string
+		wantCacheControl string
+		wantBody         string
+		wantErr          bool
+	}{
+		{
+			name:             "NORMAL: 301の場合はキャッシュ期間を制限する",
+			mode:             model.RedirectModeMovedPermanently,
+			wantCode:         http.StatusMovedPermanently,
+			wantLocation:     original,
+			wantCacheControl: permanentCacheControl,
+		},
+		{
+			name:             "NORMAL: 302の場合はキャッシュさせない",
+			mode:             model.RedirectModeFound,
+			wantCode:         http.StatusFound,
+			wantLocation:     original,
+			wantCacheControl: temporaryCacheControl,
+		},
+		{
+			name:             "NORMAL: 307の場合はキャッシュさせない",
+			mode:             model.RedirectModeTemporaryRedirect,
+			wantCode:         http.StatusTemporaryRedirect,
+			wantLocation:     original,
+			wantCacheControl: temporaryCacheControl,
+		},
+		{
+			name:             "NORMAL: 308の場合はキャッシュ期間を制限する",
+			mode:             model.RedirectModePermanentRedirect,
+			wantCode:         http.StatusPermanentRedirect,
+			wantLocation:     original,
+			wantCacheControl: permanentCacheControl,
+		},
+		{
+			name:             "NORMAL: 中間ページの場合は200でHTMLを返す",
+			mode:             model.RedirectModeMovedPermanently,
+			interstitial:     true,
+			wantCode:         http.StatusOK,
+			wantLocation:     "",
+			wantCacheControl: temporaryCacheControl,
+			wantBody:         `content="0;url=https://example.com/path?q=1&amp;r=2"`,
+		},
+		{
+			name:             "NORMAL: 不明な場合はデフォルトでリダイレクトする",
+			mode:             0,
+			wantCode:         http.StatusFound,
+			wantLocation:     original,
+			wantCacheControl: temporaryCacheControl,
+		},
+		{
+			name:         "ERROR: javascript:のURLの中間ページは返さない",
+			original:     "javascript:alert(document.cookie)",
+			interstitial: true,
+			wantCode:     http.StatusOK,
+			wantErr:      true,
+		},
+		{
+			name:     "ERROR: ホストのないURLへはリダイレクトしない",
+			original: "https:///path",
+			mode:     model.RedirectModeFound,
+			wantCode: http.StatusOK,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "http://localhost-test/abcd1234", nil)
+			dest := tt.original
+			if dest == "" {
+				dest = original
+			}
+			err := writeRedirect(w, r, dest, tt.mode, tt.interstitial)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("writeRedirect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && w.Body.Len() > 0 {
+				t.Errorf("writeRedirect() body = %v, want empty", w.Body.String())
+			}
+			if w.Code != tt.wantCode {
+				t.Errorf("writeRedirect() code = %v, want %v", w.Code, tt.wantCode)
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("writeRedirect() Location = %v, want %v", got, tt.wantLocation)
+			}
+			if got := w.Header().Get("Cache-Control"); got != tt.wantCacheControl {
+				t.Errorf("writeRedirect() Cache-Control = %v, want %v", got, tt.wantCacheControl)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("writeRedirect() body = %v, want contains %v", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/rpc"
+	"github.com/Tatsuemon/anony/usecase"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TagHandler implements rpc.TagServiceServer interface
+type TagHandler struct {
+	usecase usecase.TagUseCase
+}
+
+// NewTagHandler creates a new TagHandler
+func NewTagHandler(u usecase.TagUseCase) *TagHandler {
+	return &TagHandler{u}
+}
+
+// CreateTag creates a tag of the user
+func (h *TagHandler) CreateTag(ctx context.Context, in *rpc.CreateTagRequest) (*rpc.CreateTagResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := model.NewTag(uuid.New().String(), userID, in.GetName(), in.GetParentId())
+	t, err = h.usecase.CreateTag(ctx, t)
+	if err != nil {
+		return nil, tagError("failed to create tag", err)
+	}
+	return &rpc.CreateTagResponse{Tag: toRPCTag(t)}, nil
+}
+
+// RenameTag renames the tag
+func (h *TagHandler) RenameTag(ctx context.Context, in *rpc.RenameTagRequest) (*rpc.RenameTagResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := h.usecase.RenameTag(ctx, in.GetTagId(), in.GetName(), userID)
+	if err != nil {
+		return nil, tagError("failed to rename tag", err)
+	}
+	return &rpc.RenameTagResponse{Tag: toRPCTag(t)}, nil
+}
+
+// DeleteTag deletes the tag and its descendants
+func (h *TagHandler) DeleteTag(ctx context.Context, in *rpc.DeleteTagRequest) (*emptypb.Empty, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.usecase.DeleteTag(ctx, in.GetTagId(), userID); err != nil {
+		return nil, tagError("failed to delete tag", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListTags lists user's tags
+func (h *TagHandler) ListTags(ctx context.Context, in *emptypb.Empty) (*rpc.ListTagsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := h.usecase.ListTags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.ListTagsResponse{Tags: toRPCTags(ts)}, nil
+}
+
+// TagAnonyURLs tags the AnonyURLs with the tags
+func (h *TagHandler) TagAnonyURLs(ctx context.Context, in *rpc.TagAnonyURLsRequest) (*rpc.TagAnonyURLsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := h.usecase.TagAnonyURLs(ctx, in.GetTagIds(), toAnonyURLKeys(in.GetLinks()), userID)
+	if err != nil {
+		return nil, tagError("failed to tag anonyURLs", err)
+	}
+	return &rpc.TagAnonyURLsResponse{Count: int64(n)}, nil
+}
+
+// UntagAnonyURLs untags the AnonyURLs from the tags
+func (h *TagHandler) UntagAnonyURLs(ctx context.Context, in *rpc.TagAnonyURLsRequest) (*rpc.TagAnonyURLsResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := h.usecase.UntagAnonyURLs(ctx, in.GetTagIds(), toAnonyURLKeys(in.GetLinks()), userID)
+	if err != nil {
+		return nil, tagError("failed to untag anonyURLs", err)
+	}
+	return &rpc.TagAnonyURLsResponse{Count: int64(n)}, nil
+}
+
+// tagError converts the error of TagUseCase to the status
+func tagError(msg string, err error) error {
+	if errors.Is(err, usecase.ErrTagNotFound) {
+		return status.Errorf(codes.NotFound, "%s \n: %s", msg, err)
+	}
+	return status.Errorf(codes.InvalidArgument, "%s \n: %s", msg, err)
+}
+
+func toAnonyURLKeys(links []*rpc.AnonyURLKey) []usecase.AnonyURLKey {
+	res := make([]usecase.AnonyURLKey, len(links))
+	for i, v := range links {
+		res[i] = usecase.AnonyURLKey{Original: v.GetOriginalUrl(), UTM: toModelUTM(v.GetUtm())}
+	}
+	return res
+}
+
+func toRPCTag(t *model.Tag) *rpc.Tag {
+	return &rpc.Tag{
+		Id:       t.ID,
+		Name:     t.Name,
+		ParentId: t.ParentID,
+		Path:     t.Path,
+	}
+}
+
+func toRPCTags(ts []*model.Tag) []*rpc.Tag {
+	res := make([]*rpc.Tag, len(ts))
+	for i, v := range ts {
+		res[i] = toRPCTag(v)
+	}
+	return res
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+const (
+	// variantCookieName is the cookie keeping the assigned variant, its path is the short code
+	variantCookieName = "anony_variant"
+	// variantCookieMaxAge is 30 days
+	variantCookieMaxAge = 30 * 24 * 60 * 60
+)
+
+// pickVariant picks the variant for the visitor and keeps it in the cookie, or returns nil if there are no variants
+// Cookieが無い場合はIPとUser-Agentから振り分けるので, Cookieを受け付けないクライアントでもなるべく同じVariantになる
+func pickVariant(w http.ResponseWriter, r *http.Request, an *model.AnonyURL) *model.Variant {
+	if len(an.Variants) == 0 {
+		return nil
+	}
+	if c, err := r.Cookie(variantCookieName); err == nil {
+		if v := an.FindVariant(c.Value); v != nil {
+			return v
+		}
+	}
+
+	key := r.UserAgent()
+	if ip := clientIP(r); ip != nil {
+		key = ip.String() + "|" + key
+	}
+	v := an.PickVariant(key)
+	if v == nil {
+		return nil
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     variantCookieName,
+		Value:    v.ID,
+		Path:     "/" + an.Short,
+		MaxAge:   variantCookieMaxAge,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return v
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+func Test_pickVariant(t *testing.T) {
+	an := &model.AnonyURL{
+		ID:    "url-id",
+		Short: "aaaabbbb",
+		Variants: []*model.Variant{
+			{ID: "a", Destination: "https://example.com/a", Weight: 1},
+			{ID: "b", Destination: "https://example.com/b", Weight: 1},
+		},
+	}
+	newRequest := func(cookie string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "http://localhost/aaaabbbb", nil)
+		r.RemoteAddr = "192.0.2.1:12345"
+		r.Header.Set("User-Agent", "test-agent")
+		if cookie != "" {
+			r.AddCookie(&http.Cookie{Name: variantCookieName, Value: cookie})
+		}
+		return r
+	}
+
+	t.Run("NORMAL: Cookieがない場合は振り分けてCookieに保存する", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		got := pickVariant(w, newRequest(""), an)
+		if got == nil {
+			t.Fatalf("pickVariant() = nil")
+		}
+		cookies := w.Result().Cookies()
+		if len(cookies) != 1 || cookies[0].Value != got.ID || cookies[0].Path != "/aaaabbbb" {
+			t.Errorf("pickVariant() cookies = %v, want %s", cookies, got.ID)
+		}
+		// 同じクライアントには同じVariantを返す
+		if again := pickVariant(httptest.NewRecorder(), newRequest(""), an); again != got {
+			t.Errorf("pickVariant() = %v, want %v", again.ID, got.ID)
+		}
+	})
+
+	t.Run("NORMAL: CookieのVariantを返す", func(t *testing.T) {
+		for _, id := range []string{"a", "b"} {
+			w := httptest.NewRecorder()
+			got := pickVariant(w, newRequest(id), an)
+			if got == nil || got.ID != id {
+				t.Errorf("pickVariant() = %v, want %s", got, id)
+			}
+			if cookies := w.Result().Cookies(); len(cookies) != 0 {
+				t.Errorf("pickVariant() cookies = %v, want none", cookies)
+			}
+		}
+	})
+
+	t.Run("NORMAL: CookieのVariantが存在しない場合は振り分け直す", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		got := pickVariant(w, newRequest("deleted"), an)
+		if got == nil {
+			t.Fatalf("pickVariant() = nil")
+		}
+		if cookies := w.Result().Cookies(); len(cookies) != 1 || cookies[0].Value != got.ID {
+			t.Errorf("pickVariant() cookies = %v, want %s", cookies, got.ID)
+		}
+	})
+
+	t.Run("NORMAL: Variantがない場合はnilを返す", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		if got := pickVariant(w, newRequest(""), &model.AnonyURL{ID: "url-id", Short: "aaaabbbb"}); got != nil {
+			t.Errorf("pickVariant() = %v, want nil", got)
+		}
+		if cookies := w.Result().Cookies(); len(cookies) != 0 {
+			t.Errorf("pickVariant() cookies = %v, want none", cookies)
+		}
+	})
+}
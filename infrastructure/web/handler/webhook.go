@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/rpc"
+	"github.com/Tatsuemon/anony/usecase"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebhookHandler implements rpc.WebhookServiceServer interface
+type WebhookHandler struct {
+	usecase usecase.WebhookUseCase
+}
+
+// NewWebhookHandler creates a new WebhookHandler
+func NewWebhookHandler(u usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{u}
+}
+
+// CreateWebhook creates a webhook of the user, the secret is returned only here
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, in *rpc.CreateWebhookRequest) (*rpc.CreateWebhookResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create webhook \n: %s", err)
+	}
+	eventTypes := in.GetEventTypes()
+	// 指定がない場合は全てのイベントを受け取る
+	if len(eventTypes) == 0 {
+		eventTypes = model.WebhookEventTypes
+	}
+	w := model.NewWebhook(uuid.New().String(), userID, in.GetUrl(), secret, eventTypes)
+	w, err = h.usecase.CreateWebhook(ctx, w)
+	if err != nil {
+		return nil, webhookError("failed to create webhook", err)
+	}
+	return &rpc.CreateWebhookResponse{Webhook: toRPCWebhook(w), Secret: secret}, nil
+}
+
+// DeleteWebhook deletes the webhook and its pending deliveries
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, in *rpc.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.usecase.DeleteWebhook(ctx, in.GetWebhookId(), userID); err != nil {
+		return nil, webhookError("failed to delete webhook", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListWebhooks lists user's webhooks
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, in *emptypb.Empty) (*rpc.ListWebhooksResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ws, err := h.usecase.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*rpc.Webhook, len(ws))
+	for i, w := range ws {
+		res[i] = toRPCWebhook(w)
+	}
+	return &rpc.ListWebhooksResponse{Webhooks: res}, nil
+}
+
+// ListDeadWebhookDeliveries lists the deliveries of the webhook given up after the retries
+func (h *WebhookHandler) ListDeadWebhookDeliveries(ctx context.Context, in *rpc.ListDeadWebhookDeliveriesRequest) (*rpc.ListDeadWebhookDeliveriesResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := h.usecase.ListDeadDeliveries(ctx, in.GetWebhookId(), userID)
+	if err != nil {
+		return nil, webhookError("failed to list webhook deliveries", err)
+	}
+	res := make([]*rpc.WebhookDelivery, len(ds))
+	for i, d := range ds {
+		res[i] = toRPCWebhookDelivery(d)
+	}
+	return &rpc.ListDeadWebhookDeliveriesResponse{Deliveries: res}, nil
+}
+
+// RedeliverWebhookDelivery queues the delivery given up again
+func (h *WebhookHandler) RedeliverWebhookDelivery(ctx context.Context, in *rpc.RedeliverWebhookDeliveryRequest) (*rpc.RedeliverWebhookDeliveryResponse, error) {
+	userID, err := model.GetUserIDInContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d, err := h.usecase.RedeliverWebhookDelivery(ctx, in.GetDeliveryId(), userID)
+	if err != nil {
+		return nil, webhookError("failed to redeliver webhook delivery", err)
+	}
+	return &rpc.RedeliverWebhookDeliveryResponse{Delivery: toRPCWebhookDelivery(d)}, nil
+}
+
+func webhookError(msg string, err error) error {
+	if errors.Is(err, usecase.ErrWebhookNotFound) {
+		return status.Errorf(codes.NotFound, "%s \n: %s", msg, err)
+	}
+	return status.Errorf(codes.InvalidArgument, "%s \n: %s", msg, err)
+}
+
+// generateWebhookSecret generates a random secret of 32 bytes in hex
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func toRPCWebhook(w *model.Webhook) *rpc.Webhook {
+	return &rpc.Webhook{
+		Id:         w.ID,
+		Url:        w.URL,
+		EventTypes: w.EventTypes,
+		CreatedAt:  timestamppb.New(w.CreatedAt),
+	}
+}
+
+func toRPCWebhookDelivery(d *model.WebhookDelivery) *rpc.WebhookDelivery {
+	return &rpc.WebhookDelivery{
+		Id:        d.ID,
+		WebhookId: d.WebhookID,
+		EventId:   d.EventID,
+		EventType: d.EventType,
+		Payload:   string(d.Payload),
+		Attempts:  d.Attempts,
+		LastError: d.LastError,
+		CreatedAt: timestamppb.New(d.CreatedAt),
+	}
+}
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/netguard"
+)
+
+// Config is the configuration of the HTTP webhook sender
+type Config struct {
+	// Timeout is the timeout of each request
+	Timeout   time.Duration
+	UserAgent string
+	// AllowPrivate allows webhooks on loopback and private addresses. テストとローカルの開発用
+	AllowPrivate bool
+}
+
+// DefaultConfig returns the configuration used by the API server
+func DefaultConfig() Config {
+	return Config{
+		Timeout:   10 * time.Second,
+		UserAgent: "anony-webhook/1.0",
+	}
+}
+
+// 応答の本文を読み捨てる上限
+const maxDiscardBody = 64 << 10
+
+type sender struct {
+	client *http.Client
+	config Config
+	now    func() time.Time
+}
+
+// NewSender creates a WebhookSender posting JSON payloads signed with HMAC-SHA256
+// リダイレクトは追わず, 失敗として扱う. 登録後にDNSで内部のアドレスに変えられても, 接続時に拒否する
+func NewSender(c Config) service.WebhookSender {
+	client := netguard.NewClient(c.Timeout, c.AllowPrivate)
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &sender{
+		client: client,
+		config: c,
+		now:    time.Now,
+	}
+}
+
+func (s *sender) CheckURL(ctx context.Context, url string) error {
+	if s.config.AllowPrivate {
+		return nil
+	}
+	return netguard.CheckURL(ctx, url)
+}
+
+func (s *sender) Send(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery) error {
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", s.config.UserAgent)
+	req.Header.Set(HeaderEvent, d.EventType)
+	req.Header.Set(HeaderDelivery, d.ID)
+	req.Header.Set(HeaderSignature, Sign(w.Secret, s.now(), d.Payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// コネクションを再利用できるように本文を読み捨てる
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, maxDiscardBody))
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/netguard"
+)
+
+func TestSign_Verify(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	payload := []byte(`{"id":"event-id"}`)
+	header := Sign("secret", now, payload)
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		payload []byte
+		now     time.Time
+		wantErr error
+	}{
+		{name: "NORMAL: 同じ鍵とペイロード", secret: "secret", header: header, payload: payload, now: now},
+		{name: "NORMAL: 複数の署名のいずれかが一致する", secret: "secret", header: "t=1600000000,v1=00," + header[len("t=1600000000,"):], payload: payload, now: now},
+		{name: "ERROR: 鍵が違う", secret: "other", header: header, payload: payload, now: now, wantErr: ErrSignatureMismatch},
+		{name: "ERROR: ペイロードが改ざんされた", secret: "secret", header: header, payload: []byte(`{"id":"other"}`), now: now, wantErr: ErrSignatureMismatch},
+		{name: "ERROR: 時刻が古い", secret: "secret", header: header, payload: payload, now: now.Add(10 * time.Minute), wantErr: ErrSignatureExpired},
+		{name: "ERROR: ヘッダが不正", secret: "secret", header: "v1=abc", payload: payload, now: now, wantErr: ErrInvalidSignatureHeader},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Verify(tt.secret, tt.header, tt.payload, tt.now, 5*time.Minute); err != tt.wantErr {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_sender_Send(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "NORMAL: 2xxは成功", status: http.StatusNoContent, wantErr: false},
+		{name: "ERROR: 5xxは失敗", status: http.StatusInternalServerError, wantErr: true},
+		{name: "ERROR: リダイレクトは追わない", status: http.StatusFound, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			var body []byte
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				body, _ = ioutil.ReadAll(r.Body)
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer ts.Close()
+
+			s := NewSender(Config{Timeout: time.Second, UserAgent: "anony-test", AllowPrivate: true})
+			w := &model.Webhook{ID: "webhook-id", URL: ts.URL + "/hook", Secret: "secret"}
+			d := &model.WebhookDelivery{ID: "delivery-id", EventType: model.WebhookEventAnonyURLCreated, Payload: []byte(`{"type":"anony_url.created"}`)}
+			err := s.Send(context.Background(), w, d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sender.Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got == nil || got.Method != http.MethodPost || got.URL.Path != "/hook" {
+				t.Fatalf("sender.Send() request = %v", got)
+			}
+			if got.Header.Get(HeaderEvent) != d.EventType || got.Header.Get(HeaderDelivery) != d.ID || got.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("sender.Send() header = %v", got.Header)
+			}
+			if err := Verify("secret", got.Header.Get(HeaderSignature), body, time.Now(), time.Minute); err != nil {
+				t.Errorf("sender.Send() signature is invalid: %v", err)
+			}
+		})
+	}
+}
+
+func Test_sender_PrivateAddress(t *testing.T) {
+	requested := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer ts.Close()
+
+	s := NewSender(Config{Timeout: time.Second, UserAgent: "anony-test"})
+	if err := s.CheckURL(context.Background(), ts.URL+"/hook"); !errors.Is(err, netguard.ErrNonPublicAddress) {
+		t.Errorf("sender.CheckURL() error = %v, want %v", err, netguard.ErrNonPublicAddress)
+	}
+	// 登録後に内部のアドレスになった場合も送らない
+	w := &model.Webhook{ID: "webhook-id", URL: ts.URL + "/hook", Secret: "secret"}
+	d := &model.WebhookDelivery{ID: "delivery-id", EventType: model.WebhookEventAnonyURLCreated, Payload: []byte(`{}`)}
+	if err := s.Send(context.Background(), w, d); !errors.Is(err, netguard.ErrNonPublicAddress) {
+		t.Errorf("sender.Send() error = %v, want %v", err, netguard.ErrNonPublicAddress)
+	}
+	if requested {
+		t.Errorf("sender.Send() requested the loopback address")
+	}
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Webhookのリクエストヘッダ
+const (
+	HeaderEvent     = "X-Anony-Event"
+	HeaderDelivery  = "X-Anony-Delivery"
+	HeaderSignature = "X-Anony-Signature"
+)
+
+// 署名の検証のエラー
+var (
+	ErrInvalidSignatureHeader = errors.New("signature header is invalid")
+	ErrSignatureMismatch      = errors.New("signature does not match")
+	ErrSignatureExpired       = errors.New("signature timestamp is out of tolerance")
+)
+
+// Sign returns the value of X-Anony-Signature: "t=<unix time>,v1=<hex of HMAC-SHA256>"
+// 署名の対象は"<unix time>.<payload>". 時刻を含めることで古いリクエストの再送を検出できる
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	t := timestamp.Unix()
+	return fmt.Sprintf("t=%d,v1=%s", t, hex.EncodeToString(mac(secret, t, payload)))
+}
+
+// Verify verifies the signature header for receivers
+// toleranceが0より大きい場合は, 署名の時刻とnowの差がtolerance以内であることも確認する
+func Verify(secret, header string, payload []byte, now time.Time, tolerance time.Duration) error {
+	var (
+		t    int64
+		sigs [][]byte
+		hasT bool
+	)
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			return ErrInvalidSignatureHeader
+		}
+		switch kv[0] {
+		case "t":
+			v, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return ErrInvalidSignatureHeader
+			}
+			t, hasT = v, true
+		case "v1":
+			sig, err := hex.DecodeString(kv[1])
+			if err != nil {
+				return ErrInvalidSignatureHeader
+			}
+			sigs = append(sigs, sig)
+		}
+	}
+	if !hasT || len(sigs) == 0 {
+		return ErrInvalidSignatureHeader
+	}
+	if tolerance > 0 {
+		if d := now.Sub(time.Unix(t, 0)); d > tolerance || d < -tolerance {
+			return ErrSignatureExpired
+		}
+	}
+	expected := mac(secret, t, payload)
+	for _, sig := range sigs {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return ErrSignatureMismatch
+}
+
+func mac(secret string, t int64, payload []byte) []byte {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(strconv.FormatInt(t, 10)))
+	m.Write([]byte("."))
+	m.Write(payload)
+	return m.Sum(nil)
+}
@@ -9,12 +9,14 @@ package rpc
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -30,6 +32,282 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type RedirectMode int32
+
+const (
+	// 指定しない場合はFOUND(302)
+	RedirectMode_REDIRECT_MODE_UNSPECIFIED RedirectMode = 0
+	RedirectMode_MOVED_PERMANENTLY         RedirectMode = 1
+	RedirectMode_FOUND                     RedirectMode = 2
+	RedirectMode_TEMPORARY_REDIRECT        RedirectMode = 3
+	RedirectMode_PERMANENT_REDIRECT        RedirectMode = 4
+	// meta-refreshとJavaScriptでリダイレクトする中間ページ
+	RedirectMode_INTERSTITIAL RedirectMode = 5
+)
+
+// Enum value maps for RedirectMode.
+var (
+	RedirectMode_name = map[int32]string{
+		0: "REDIRECT_MODE_UNSPECIFIED",
+		1: "MOVED_PERMANENTLY",
+		2: "FOUND",
+		3: "TEMPORARY_REDIRECT",
+		4: "PERMANENT_REDIRECT",
+		5: "INTERSTITIAL",
+	}
+	RedirectMode_value = map[string]int32{
+		"REDIRECT_MODE_UNSPECIFIED": 0,
+		"MOVED_PERMANENTLY":         1,
+		"FOUND":                     2,
+		"TEMPORARY_REDIRECT":        3,
+		"PERMANENT_REDIRECT":        4,
+		"INTERSTITIAL":              5,
+	}
+)
+
+func (x RedirectMode) Enum() *RedirectMode {
+	p := new(RedirectMode)
+	*p = x
+	return p
+}
+
+func (x RedirectMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RedirectMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_anony_proto_enumTypes[0].Descriptor()
+}
+
+func (RedirectMode) Type() protoreflect.EnumType {
+	return &file_anony_proto_enumTypes[0]
+}
+
+func (x RedirectMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RedirectMode.Descriptor instead.
+func (RedirectMode) EnumDescriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{0}
+}
+
+type QueryMode int32
+
+const (
+	// 短縮URLのクエリを無視する
+	QueryMode_QUERY_MODE_IGNORE QueryMode = 0
+	// 元のURLのクエリに追加する
+	QueryMode_QUERY_MODE_APPEND QueryMode = 1
+	// 同じキーは短縮URLのクエリで上書きする
+	QueryMode_QUERY_MODE_OVERRIDE QueryMode = 2
+)
+
+// Enum value maps for QueryMode.
+var (
+	QueryMode_name = map[int32]string{
+		0: "QUERY_MODE_IGNORE",
+		1: "QUERY_MODE_APPEND",
+		2: "QUERY_MODE_OVERRIDE",
+	}
+	QueryMode_value = map[string]int32{
+		"QUERY_MODE_IGNORE":   0,
+		"QUERY_MODE_APPEND":   1,
+		"QUERY_MODE_OVERRIDE": 2,
+	}
+)
+
+func (x QueryMode) Enum() *QueryMode {
+	p := new(QueryMode)
+	*p = x
+	return p
+}
+
+func (x QueryMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_anony_proto_enumTypes[1].Descriptor()
+}
+
+func (QueryMode) Type() protoreflect.EnumType {
+	return &file_anony_proto_enumTypes[1]
+}
+
+func (x QueryMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryMode.Descriptor instead.
+func (QueryMode) EnumDescriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{1}
+}
+
+type QRCodeFormat int32
+
+const (
+	QRCodeFormat_PNG QRCodeFormat = 0
+	QRCodeFormat_SVG QRCodeFormat = 1
+)
+
+// Enum value maps for QRCodeFormat.
+var (
+	QRCodeFormat_name = map[int32]string{
+		0: "PNG",
+		1: "SVG",
+	}
+	QRCodeFormat_value = map[string]int32{
+		"PNG": 0,
+		"SVG": 1,
+	}
+)
+
+func (x QRCodeFormat) Enum() *QRCodeFormat {
+	p := new(QRCodeFormat)
+	*p = x
+	return p
+}
+
+func (x QRCodeFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QRCodeFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_anony_proto_enumTypes[2].Descriptor()
+}
+
+func (QRCodeFormat) Type() protoreflect.EnumType {
+	return &file_anony_proto_enumTypes[2]
+}
+
+func (x QRCodeFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QRCodeFormat.Descriptor instead.
+func (QRCodeFormat) EnumDescriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{2}
+}
+
+// 誤り訂正レベル. 指定しない場合はM
+type ErrorCorrectionLevel int32
+
+const (
+	ErrorCorrectionLevel_ERROR_CORRECTION_LEVEL_UNSPECIFIED ErrorCorrectionLevel = 0
+	ErrorCorrectionLevel_LOW                                ErrorCorrectionLevel = 1
+	ErrorCorrectionLevel_MEDIUM                             ErrorCorrectionLevel = 2
+	ErrorCorrectionLevel_QUARTILE                           ErrorCorrectionLevel = 3
+	ErrorCorrectionLevel_HIGH                               ErrorCorrectionLevel = 4
+)
+
+// Enum value maps for ErrorCorrectionLevel.
+var (
+	ErrorCorrectionLevel_name = map[int32]string{
+		0: "ERROR_CORRECTION_LEVEL_UNSPECIFIED",
+		1: "LOW",
+		2: "MEDIUM",
+		3: "QUARTILE",
+		4: "HIGH",
+	}
+	ErrorCorrectionLevel_value = map[string]int32{
+		"ERROR_CORRECTION_LEVEL_UNSPECIFIED": 0,
+		"LOW":                                1,
+		"MEDIUM":                             2,
+		"QUARTILE":                           3,
+		"HIGH":                               4,
+	}
+)
+
+func (x ErrorCorrectionLevel) Enum() *ErrorCorrectionLevel {
+	p := new(ErrorCorrectionLevel)
+	*p = x
+	return p
+}
+
+func (x ErrorCorrectionLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCorrectionLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_anony_proto_enumTypes[3].Descriptor()
+}
+
+func (ErrorCorrectionLevel) Type() protoreflect.EnumType {
+	return &file_anony_proto_enumTypes[3]
+}
+
+func (x ErrorCorrectionLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCorrectionLevel.Descriptor instead.
+func (ErrorCorrectionLevel) EnumDescriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{3}
+}
+
+type Platform int32
+
+const (
+	Platform_PLATFORM_ANY Platform = 0
+	Platform_IOS          Platform = 1
+	Platform_ANDROID      Platform = 2
+	Platform_WINDOWS      Platform = 3
+	Platform_MACOS        Platform = 4
+	Platform_LINUX        Platform = 5
+	// 上記のいずれでもないUser-Agent
+	Platform_OTHER Platform = 6
+)
+
+// Enum value maps for Platform.
+var (
+	Platform_name = map[int32]string{
+		0: "PLATFORM_ANY",
+		1: "IOS",
+		2: "ANDROID",
+		3: "WINDOWS",
+		4: "MACOS",
+		5: "LINUX",
+		6: "OTHER",
+	}
+	Platform_value = map[string]int32{
+		"PLATFORM_ANY": 0,
+		"IOS":          1,
+		"ANDROID":      2,
+		"WINDOWS":      3,
+		"MACOS":        4,
+		"LINUX":        5,
+		"OTHER":        6,
+	}
+)
+
+func (x Platform) Enum() *Platform {
+	p := new(Platform)
+	*p = x
+	return p
+}
+
+func (x Platform) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Platform) Descriptor() protoreflect.EnumDescriptor {
+	return file_anony_proto_enumTypes[4].Descriptor()
+}
+
+func (Platform) Type() protoreflect.EnumType {
+	return &file_anony_proto_enumTypes[4]
+}
+
+func (x Platform) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Platform.Descriptor instead.
+func (Platform) EnumDescriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{4}
+}
+
 type UserBase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -313,6 +591,86 @@ func (x *LogInUserResponse) GetToken() string {
 	return ""
 }
 
+// リダイレクト時に元のURLのクエリに付与する. 空文字のパラメータは付与しない
+type UTM struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source   string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Medium   string `protobuf:"bytes,2,opt,name=medium,proto3" json:"medium,omitempty"`
+	Campaign string `protobuf:"bytes,3,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Term     string `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	Content  string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UTM) Reset() {
+	*x = UTM{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UTM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UTM) ProtoMessage() {}
+
+func (x *UTM) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UTM.ProtoReflect.Descriptor instead.
+func (*UTM) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UTM) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *UTM) GetMedium() string {
+	if x != nil {
+		return x.Medium
+	}
+	return ""
+}
+
+func (x *UTM) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *UTM) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *UTM) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
 type CreateAnonyURLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -320,12 +678,32 @@ type CreateAnonyURLRequest struct {
 
 	OriginalUrl string `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
 	IsActive    bool   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// 空文字の場合はデフォルトのホスト
+	Domain       string       `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	RedirectMode RedirectMode `protobuf:"varint,4,opt,name=redirect_mode,json=redirectMode,proto3,enum=anony.RedirectMode" json:"redirect_mode,omitempty"`
+	QueryMode    QueryMode    `protobuf:"varint,5,opt,name=query_mode,json=queryMode,proto3,enum=anony.QueryMode" json:"query_mode,omitempty"`
+	// trueの場合はコード以降のパスを元のURLに追加する
+	ForwardPath bool `protobuf:"varint,6,opt,name=forward_path,json=forwardPath,proto3" json:"forward_path,omitempty"`
+	Utm         *UTM `protobuf:"bytes,7,opt,name=utm,proto3" json:"utm,omitempty"`
+	// 指定した場合はoriginal_urlの代わりにvariantsへ重みに応じて振り分ける
+	Variants []*Variant `protobuf:"bytes,8,rep,name=variants,proto3" json:"variants,omitempty"`
+	// 有効期間. 指定しない場合は制限しない
+	ActiveFrom  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	ActiveUntil *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	// 有効期間外のリダイレクト先. 空文字の場合は404
+	FallbackUrl string `protobuf:"bytes,11,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+	// trueの場合, original_urlが短縮URLであれば最終的なリダイレクト先を登録する
+	CollapseRedirectChain bool `protobuf:"varint,12,opt,name=collapse_redirect_chain,json=collapseRedirectChain,proto3" json:"collapse_redirect_chain,omitempty"`
+	// 所有者が付けるタイトル(255文字まで), メモ(4096文字まで), 任意のキーと値(32個, キーは64文字, 値は1024文字まで)
+	Title    string            `protobuf:"bytes,13,opt,name=title,proto3" json:"title,omitempty"`
+	Notes    string            `protobuf:"bytes,14,opt,name=notes,proto3" json:"notes,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,15,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *CreateAnonyURLRequest) Reset() {
 	*x = CreateAnonyURLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[5]
+		mi := &file_anony_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +716,7 @@ func (x *CreateAnonyURLRequest) String() string {
 func (*CreateAnonyURLRequest) ProtoMessage() {}
 
 func (x *CreateAnonyURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[5]
+	mi := &file_anony_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +729,7 @@ func (x *CreateAnonyURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAnonyURLRequest.ProtoReflect.Descriptor instead.
 func (*CreateAnonyURLRequest) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{5}
+	return file_anony_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateAnonyURLRequest) GetOriginalUrl() string {
@@ -368,6 +746,97 @@ func (x *CreateAnonyURLRequest) GetIsActive() bool {
 	return false
 }
 
+func (x *CreateAnonyURLRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreateAnonyURLRequest) GetRedirectMode() RedirectMode {
+	if x != nil {
+		return x.RedirectMode
+	}
+	return RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+func (x *CreateAnonyURLRequest) GetQueryMode() QueryMode {
+	if x != nil {
+		return x.QueryMode
+	}
+	return QueryMode_QUERY_MODE_IGNORE
+}
+
+func (x *CreateAnonyURLRequest) GetForwardPath() bool {
+	if x != nil {
+		return x.ForwardPath
+	}
+	return false
+}
+
+func (x *CreateAnonyURLRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+func (x *CreateAnonyURLRequest) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *CreateAnonyURLRequest) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *CreateAnonyURLRequest) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *CreateAnonyURLRequest) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *CreateAnonyURLRequest) GetCollapseRedirectChain() bool {
+	if x != nil {
+		return x.CollapseRedirectChain
+	}
+	return false
+}
+
+func (x *CreateAnonyURLRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAnonyURLRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateAnonyURLRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type CreateAnonyURLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -379,7 +848,7 @@ type CreateAnonyURLResponse struct {
 func (x *CreateAnonyURLResponse) Reset() {
 	*x = CreateAnonyURLResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[6]
+		mi := &file_anony_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -392,7 +861,7 @@ func (x *CreateAnonyURLResponse) String() string {
 func (*CreateAnonyURLResponse) ProtoMessage() {}
 
 func (x *CreateAnonyURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[6]
+	mi := &file_anony_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +874,7 @@ func (x *CreateAnonyURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAnonyURLResponse.ProtoReflect.Descriptor instead.
 func (*CreateAnonyURLResponse) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{6}
+	return file_anony_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateAnonyURLResponse) GetAnonyUrls() *AnonyURL {
@@ -422,12 +891,14 @@ type UpdateAnonyURLStatusRequest struct {
 
 	OriginalUrl string `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
 	IsActive    bool   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// リンクはoriginal_urlとutmの組で特定する
+	Utm *UTM `protobuf:"bytes,3,opt,name=utm,proto3" json:"utm,omitempty"`
 }
 
 func (x *UpdateAnonyURLStatusRequest) Reset() {
 	*x = UpdateAnonyURLStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[7]
+		mi := &file_anony_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -440,7 +911,7 @@ func (x *UpdateAnonyURLStatusRequest) String() string {
 func (*UpdateAnonyURLStatusRequest) ProtoMessage() {}
 
 func (x *UpdateAnonyURLStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[7]
+	mi := &file_anony_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +924,7 @@ func (x *UpdateAnonyURLStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAnonyURLStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAnonyURLStatusRequest) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{7}
+	return file_anony_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateAnonyURLStatusRequest) GetOriginalUrl() string {
@@ -470,6 +941,13 @@ func (x *UpdateAnonyURLStatusRequest) GetIsActive() bool {
 	return false
 }
 
+func (x *UpdateAnonyURLStatusRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
 type UpdateAnonyURLStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -481,7 +959,7 @@ type UpdateAnonyURLStatusResponse struct {
 func (x *UpdateAnonyURLStatusResponse) Reset() {
 	*x = UpdateAnonyURLStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[8]
+		mi := &file_anony_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -494,7 +972,7 @@ func (x *UpdateAnonyURLStatusResponse) String() string {
 func (*UpdateAnonyURLStatusResponse) ProtoMessage() {}
 
 func (x *UpdateAnonyURLStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[8]
+	mi := &file_anony_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +985,7 @@ func (x *UpdateAnonyURLStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAnonyURLStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAnonyURLStatusResponse) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{8}
+	return file_anony_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateAnonyURLStatusResponse) GetAnonyUrl() *AnonyURL {
@@ -522,15 +1000,37 @@ type AnonyURL struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OriginalUrl string `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
-	ShortUrl    string `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
-	IsActive    bool   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	OriginalUrl  string                 `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	ShortUrl     string                 `protobuf:"bytes,2,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+	IsActive     bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	RedirectMode RedirectMode           `protobuf:"varint,4,opt,name=redirect_mode,json=redirectMode,proto3,enum=anony.RedirectMode" json:"redirect_mode,omitempty"`
+	QueryMode    QueryMode              `protobuf:"varint,5,opt,name=query_mode,json=queryMode,proto3,enum=anony.QueryMode" json:"query_mode,omitempty"`
+	ForwardPath  bool                   `protobuf:"varint,6,opt,name=forward_path,json=forwardPath,proto3" json:"forward_path,omitempty"`
+	Utm          *UTM                   `protobuf:"bytes,7,opt,name=utm,proto3" json:"utm,omitempty"`
+	ActiveFrom   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	ActiveUntil  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	FallbackUrl  string                 `protobuf:"bytes,10,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+	// 登録後にリダイレクト先がブロックリストに載った場合の理由. 空文字でない場合はリダイレクトしない
+	BlockedReason string `protobuf:"bytes,11,opt,name=blocked_reason,json=blockedReason,proto3" json:"blocked_reason,omitempty"`
+	// リダイレクト先の最後の死活確認の結果. 未確認の場合はlast_checked_atが空
+	// last_status_codeが0の場合は接続できなかった
+	LastStatusCode int64                  `protobuf:"varint,12,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastLatencyMs  int64                  `protobuf:"varint,13,opt,name=last_latency_ms,json=lastLatencyMs,proto3" json:"last_latency_ms,omitempty"`
+	LastCheckedAt  *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"`
+	// 作成時にリダイレクト先のページから非同期に取得する. 未取得の場合は空
+	Preview *LinkPreview `protobuf:"bytes,15,opt,name=preview,proto3" json:"preview,omitempty"`
+	// ListAnonyURLsでのみ設定する
+	Tags []*Tag `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 所有者が付けたタイトル, メモ, 任意のキーと値
+	Title    string            `protobuf:"bytes,17,opt,name=title,proto3" json:"title,omitempty"`
+	Notes    string            `protobuf:"bytes,18,opt,name=notes,proto3" json:"notes,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,19,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *AnonyURL) Reset() {
 	*x = AnonyURL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[9]
+		mi := &file_anony_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -543,7 +1043,7 @@ func (x *AnonyURL) String() string {
 func (*AnonyURL) ProtoMessage() {}
 
 func (x *AnonyURL) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[9]
+	mi := &file_anony_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +1056,7 @@ func (x *AnonyURL) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnonyURL.ProtoReflect.Descriptor instead.
 func (*AnonyURL) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{9}
+	return file_anony_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AnonyURL) GetOriginalUrl() string {
@@ -580,32 +1080,148 @@ func (x *AnonyURL) GetIsActive() bool {
 	return false
 }
 
-type ListAnonyURLsRequest struct {
+func (x *AnonyURL) GetRedirectMode() RedirectMode {
+	if x != nil {
+		return x.RedirectMode
+	}
+	return RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+func (x *AnonyURL) GetQueryMode() QueryMode {
+	if x != nil {
+		return x.QueryMode
+	}
+	return QueryMode_QUERY_MODE_IGNORE
+}
+
+func (x *AnonyURL) GetForwardPath() bool {
+	if x != nil {
+		return x.ForwardPath
+	}
+	return false
+}
+
+func (x *AnonyURL) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+func (x *AnonyURL) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *AnonyURL) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *AnonyURL) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+func (x *AnonyURL) GetBlockedReason() string {
+	if x != nil {
+		return x.BlockedReason
+	}
+	return ""
+}
+
+func (x *AnonyURL) GetLastStatusCode() int64 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *AnonyURL) GetLastLatencyMs() int64 {
+	if x != nil {
+		return x.LastLatencyMs
+	}
+	return 0
+}
+
+func (x *AnonyURL) GetLastCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return nil
+}
+
+func (x *AnonyURL) GetPreview() *LinkPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+func (x *AnonyURL) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *AnonyURL) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AnonyURL) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AnonyURL) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// リダイレクト先のページのtitle, OpenGraph, favicon
+type LinkPreview struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InActive bool `protobuf:"varint,1,opt,name=inActive,proto3" json:"inActive,omitempty"`
-	All      bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl    string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	FaviconUrl  string                 `protobuf:"bytes,4,opt,name=favicon_url,json=faviconUrl,proto3" json:"favicon_url,omitempty"`
+	FetchedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=fetched_at,json=fetchedAt,proto3" json:"fetched_at,omitempty"`
 }
 
-func (x *ListAnonyURLsRequest) Reset() {
-	*x = ListAnonyURLsRequest{}
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[10]
+		mi := &file_anony_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAnonyURLsRequest) String() string {
+func (x *LinkPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAnonyURLsRequest) ProtoMessage() {}
+func (*LinkPreview) ProtoMessage() {}
 
-func (x *ListAnonyURLsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[10]
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,50 +1232,73 @@ func (x *ListAnonyURLsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAnonyURLsRequest.ProtoReflect.Descriptor instead.
-func (*ListAnonyURLsRequest) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListAnonyURLsRequest) GetInActive() bool {
+func (x *LinkPreview) GetTitle() string {
 	if x != nil {
-		return x.InActive
+		return x.Title
 	}
-	return false
+	return ""
 }
 
-func (x *ListAnonyURLsRequest) GetAll() bool {
+func (x *LinkPreview) GetDescription() string {
 	if x != nil {
-		return x.All
+		return x.Description
 	}
-	return false
+	return ""
 }
 
-type ListAnonyURLsResponse struct {
+func (x *LinkPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetFaviconUrl() string {
+	if x != nil {
+		return x.FaviconUrl
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetFetchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FetchedAt
+	}
+	return nil
+}
+
+// リダイレクト先のページからプレビューを取得し直す
+type RefreshAnonyURLMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AnonyUrls []*AnonyURL `protobuf:"bytes,1,rep,name=anony_urls,json=anonyUrls,proto3" json:"anony_urls,omitempty"`
+	OriginalUrl string `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM   `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
 }
 
-func (x *ListAnonyURLsResponse) Reset() {
-	*x = ListAnonyURLsResponse{}
+func (x *RefreshAnonyURLMetadataRequest) Reset() {
+	*x = RefreshAnonyURLMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[11]
+		mi := &file_anony_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListAnonyURLsResponse) String() string {
+func (x *RefreshAnonyURLMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAnonyURLsResponse) ProtoMessage() {}
+func (*RefreshAnonyURLMetadataRequest) ProtoMessage() {}
 
-func (x *ListAnonyURLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[11]
+func (x *RefreshAnonyURLMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,46 +1309,50 @@ func (x *ListAnonyURLsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAnonyURLsResponse.ProtoReflect.Descriptor instead.
-func (*ListAnonyURLsResponse) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RefreshAnonyURLMetadataRequest.ProtoReflect.Descriptor instead.
+func (*RefreshAnonyURLMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListAnonyURLsResponse) GetAnonyUrls() []*AnonyURL {
+func (x *RefreshAnonyURLMetadataRequest) GetOriginalUrl() string {
 	if x != nil {
-		return x.AnonyUrls
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *RefreshAnonyURLMetadataRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
 	}
 	return nil
 }
 
-type CountAnonyURLsResponse struct {
+type RefreshAnonyURLMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email       string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	CountAll    int64  `protobuf:"varint,3,opt,name=count_all,json=countAll,proto3" json:"count_all,omitempty"`
-	CountActive int64  `protobuf:"varint,4,opt,name=count_active,json=countActive,proto3" json:"count_active,omitempty"`
+	AnonyUrl *AnonyURL `protobuf:"bytes,1,opt,name=anony_url,json=anonyUrl,proto3" json:"anony_url,omitempty"`
 }
 
-func (x *CountAnonyURLsResponse) Reset() {
-	*x = CountAnonyURLsResponse{}
+func (x *RefreshAnonyURLMetadataResponse) Reset() {
+	*x = RefreshAnonyURLMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_anony_proto_msgTypes[12]
+		mi := &file_anony_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CountAnonyURLsResponse) String() string {
+func (x *RefreshAnonyURLMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountAnonyURLsResponse) ProtoMessage() {}
+func (*RefreshAnonyURLMetadataResponse) ProtoMessage() {}
 
-func (x *CountAnonyURLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_anony_proto_msgTypes[12]
+func (x *RefreshAnonyURLMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,682 +1363,6738 @@ func (x *CountAnonyURLsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountAnonyURLsResponse.ProtoReflect.Descriptor instead.
-func (*CountAnonyURLsResponse) Descriptor() ([]byte, []int) {
-	return file_anony_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RefreshAnonyURLMetadataResponse.ProtoReflect.Descriptor instead.
+func (*RefreshAnonyURLMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CountAnonyURLsResponse) GetName() string {
+func (x *RefreshAnonyURLMetadataResponse) GetAnonyUrl() *AnonyURL {
 	if x != nil {
-		return x.Name
+		return x.AnonyUrl
 	}
-	return ""
+	return nil
 }
 
-func (x *CountAnonyURLsResponse) GetEmail() string {
+// タイトル, メモ, 任意のキーと値を置き換える. 上限はCreateAnonyURLRequestと同じ
+type UpdateAnonyURLMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string            `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM              `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+	Title       string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Notes       string            `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Metadata    map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UpdateAnonyURLMetadataRequest) Reset() {
+	*x = UpdateAnonyURLMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAnonyURLMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAnonyURLMetadataRequest) ProtoMessage() {}
+
+func (x *UpdateAnonyURLMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnonyURLMetadataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAnonyURLMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateAnonyURLMetadataRequest) GetOriginalUrl() string {
 	if x != nil {
-		return x.Email
+		return x.OriginalUrl
 	}
 	return ""
 }
 
-func (x *CountAnonyURLsResponse) GetCountAll() int64 {
+func (x *UpdateAnonyURLMetadataRequest) GetUtm() *UTM {
 	if x != nil {
-		return x.CountAll
+		return x.Utm
 	}
-	return 0
+	return nil
 }
 
-func (x *CountAnonyURLsResponse) GetCountActive() int64 {
+func (x *UpdateAnonyURLMetadataRequest) GetTitle() string {
 	if x != nil {
-		return x.CountActive
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-var File_anony_proto protoreflect.FileDescriptor
+func (x *UpdateAnonyURLMetadataRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
 
-var file_anony_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61,
-	0x6e, 0x6f, 0x6e, 0x79, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x34, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f,
-	0x6e, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a,
-	0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
-	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x50, 0x0a, 0x10, 0x4c, 0x6f, 0x67,
-	0x49, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4e, 0x0a, 0x11, 0x4c,
-	0x6f, 0x67, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a, 0x15, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e,
-	0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
-	0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
-	0x55, 0x52, 0x4c, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x73, 0x22, 0x5d,
-	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x4c, 0x0a,
-	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a,
-	0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52,
-	0x4c, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x67, 0x0a, 0x08, 0x41,
-	0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e,
-	0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x47, 0x0a, 0x15, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
-	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
-	0x72, 0x6c, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x6f,
-	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x32, 0x90, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4c,
-	0x6f, 0x67, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
-	0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd3, 0x02, 0x0a, 0x0c,
-	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x1c,
-	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f,
-	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
-	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1b, 0x2e,
-	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
-	0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x6e, 0x6f,
-	0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x05, 0x5a, 0x03, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *UpdateAnonyURLMetadataRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-var (
-	file_anony_proto_rawDescOnce sync.Once
-	file_anony_proto_rawDescData = file_anony_proto_rawDesc
-)
+type UpdateAnonyURLMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_anony_proto_rawDescGZIP() []byte {
-	file_anony_proto_rawDescOnce.Do(func() {
-		file_anony_proto_rawDescData = protoimpl.X.CompressGZIP(file_anony_proto_rawDescData)
-	})
-	return file_anony_proto_rawDescData
+	AnonyUrl *AnonyURL `protobuf:"bytes,1,opt,name=anony_url,json=anonyUrl,proto3" json:"anony_url,omitempty"`
 }
 
-var file_anony_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_anony_proto_goTypes = []interface{}{
-	(*UserBase)(nil),                     // 0: anony.UserBase
-	(*CreateUserRequest)(nil),            // 1: anony.CreateUserRequest
-	(*CreateUserResponse)(nil),           // 2: anony.CreateUserResponse
-	(*LogInUserRequest)(nil),             // 3: anony.LogInUserRequest
-	(*LogInUserResponse)(nil),            // 4: anony.LogInUserResponse
-	(*CreateAnonyURLRequest)(nil),        // 5: anony.CreateAnonyURLRequest
-	(*CreateAnonyURLResponse)(nil),       // 6: anony.CreateAnonyURLResponse
-	(*UpdateAnonyURLStatusRequest)(nil),  // 7: anony.UpdateAnonyURLStatusRequest
-	(*UpdateAnonyURLStatusResponse)(nil), // 8: anony.UpdateAnonyURLStatusResponse
-	(*AnonyURL)(nil),                     // 9: anony.AnonyURL
-	(*ListAnonyURLsRequest)(nil),         // 10: anony.ListAnonyURLsRequest
-	(*ListAnonyURLsResponse)(nil),        // 11: anony.ListAnonyURLsResponse
-	(*CountAnonyURLsResponse)(nil),       // 12: anony.CountAnonyURLsResponse
-	(*emptypb.Empty)(nil),                // 13: google.protobuf.Empty
+func (x *UpdateAnonyURLMetadataResponse) Reset() {
+	*x = UpdateAnonyURLMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_anony_proto_depIdxs = []int32{
-	0,  // 0: anony.CreateUserRequest.user:type_name -> anony.UserBase
-	0,  // 1: anony.CreateUserResponse.user:type_name -> anony.UserBase
-	0,  // 2: anony.LogInUserResponse.user:type_name -> anony.UserBase
-	9,  // 3: anony.CreateAnonyURLResponse.anony_urls:type_name -> anony.AnonyURL
-	9,  // 4: anony.UpdateAnonyURLStatusResponse.anony_url:type_name -> anony.AnonyURL
-	9,  // 5: anony.ListAnonyURLsResponse.anony_urls:type_name -> anony.AnonyURL
-	1,  // 6: anony.UserService.CreateUser:input_type -> anony.CreateUserRequest
-	3,  // 7: anony.UserService.LogInUser:input_type -> anony.LogInUserRequest
-	5,  // 8: anony.AnonyService.CreateAnonyURL:input_type -> anony.CreateAnonyURLRequest
-	7,  // 9: anony.AnonyService.UpdateAnonyURLStatus:input_type -> anony.UpdateAnonyURLStatusRequest
-	10, // 10: anony.AnonyService.ListAnonyURLs:input_type -> anony.ListAnonyURLsRequest
-	13, // 11: anony.AnonyService.CountAnonyURLs:input_type -> google.protobuf.Empty
-	2,  // 12: anony.UserService.CreateUser:output_type -> anony.CreateUserResponse
-	4,  // 13: anony.UserService.LogInUser:output_type -> anony.LogInUserResponse
-	6,  // 14: anony.AnonyService.CreateAnonyURL:output_type -> anony.CreateAnonyURLResponse
-	8,  // 15: anony.AnonyService.UpdateAnonyURLStatus:output_type -> anony.UpdateAnonyURLStatusResponse
-	11, // 16: anony.AnonyService.ListAnonyURLs:output_type -> anony.ListAnonyURLsResponse
-	12, // 17: anony.AnonyService.CountAnonyURLs:output_type -> anony.CountAnonyURLsResponse
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (x *UpdateAnonyURLMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_anony_proto_init() }
-func file_anony_proto_init() {
-	if File_anony_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_anony_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserBase); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*UpdateAnonyURLMetadataResponse) ProtoMessage() {}
+
+func (x *UpdateAnonyURLMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAnonyURLMetadataResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAnonyURLMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateAnonyURLMetadataResponse) GetAnonyUrl() *AnonyURL {
+	if x != nil {
+		return x.AnonyUrl
+	}
+	return nil
+}
+
+// 短縮URLのQRコードの画像を生成する
+type GetAnonyURLQRCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string       `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM         `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+	Format      QRCodeFormat `protobuf:"varint,3,opt,name=format,proto3,enum=anony.QRCodeFormat" json:"format,omitempty"`
+	// 画像の一辺のピクセル数. 0の場合は256
+	ImageSize       int32                `protobuf:"varint,4,opt,name=image_size,json=imageSize,proto3" json:"image_size,omitempty"`
+	ErrorCorrection ErrorCorrectionLevel `protobuf:"varint,5,opt,name=error_correction,json=errorCorrection,proto3,enum=anony.ErrorCorrectionLevel" json:"error_correction,omitempty"`
+	// 周囲の余白のモジュール数. 0の場合は4, 余白を付けない場合は-1
+	Margin int32 `protobuf:"varint,6,opt,name=margin,proto3" json:"margin,omitempty"`
+}
+
+func (x *GetAnonyURLQRCodeRequest) Reset() {
+	*x = GetAnonyURLQRCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnonyURLQRCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnonyURLQRCodeRequest) ProtoMessage() {}
+
+func (x *GetAnonyURLQRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnonyURLQRCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetAnonyURLQRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetAnonyURLQRCodeRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *GetAnonyURLQRCodeRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+func (x *GetAnonyURLQRCodeRequest) GetFormat() QRCodeFormat {
+	if x != nil {
+		return x.Format
+	}
+	return QRCodeFormat_PNG
+}
+
+func (x *GetAnonyURLQRCodeRequest) GetImageSize() int32 {
+	if x != nil {
+		return x.ImageSize
+	}
+	return 0
+}
+
+func (x *GetAnonyURLQRCodeRequest) GetErrorCorrection() ErrorCorrectionLevel {
+	if x != nil {
+		return x.ErrorCorrection
+	}
+	return ErrorCorrectionLevel_ERROR_CORRECTION_LEVEL_UNSPECIFIED
+}
+
+func (x *GetAnonyURLQRCodeRequest) GetMargin() int32 {
+	if x != nil {
+		return x.Margin
+	}
+	return 0
+}
+
+type GetAnonyURLQRCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image []byte `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// image/pngまたはimage/svg+xml
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ShortUrl    string `protobuf:"bytes,3,opt,name=short_url,json=shortUrl,proto3" json:"short_url,omitempty"`
+}
+
+func (x *GetAnonyURLQRCodeResponse) Reset() {
+	*x = GetAnonyURLQRCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnonyURLQRCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnonyURLQRCodeResponse) ProtoMessage() {}
+
+func (x *GetAnonyURLQRCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogInUserRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnonyURLQRCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetAnonyURLQRCodeResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAnonyURLQRCodeResponse) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *GetAnonyURLQRCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetAnonyURLQRCodeResponse) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+// 有効期間とFallbackを置き換える. is_activeが無効の場合は有効期間内でもリダイレクトしない
+type SetAnonyURLScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string                 `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM                   `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+	ActiveFrom  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	ActiveUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	FallbackUrl string                 `protobuf:"bytes,5,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+}
+
+func (x *SetAnonyURLScheduleRequest) Reset() {
+	*x = SetAnonyURLScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAnonyURLScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnonyURLScheduleRequest) ProtoMessage() {}
+
+func (x *SetAnonyURLScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogInUserResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnonyURLScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetAnonyURLScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetAnonyURLScheduleRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *SetAnonyURLScheduleRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+func (x *SetAnonyURLScheduleRequest) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *SetAnonyURLScheduleRequest) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *SetAnonyURLScheduleRequest) GetFallbackUrl() string {
+	if x != nil {
+		return x.FallbackUrl
+	}
+	return ""
+}
+
+type SetAnonyURLScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnonyUrl *AnonyURL `protobuf:"bytes,1,opt,name=anony_url,json=anonyUrl,proto3" json:"anony_url,omitempty"`
+}
+
+func (x *SetAnonyURLScheduleResponse) Reset() {
+	*x = SetAnonyURLScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAnonyURLScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnonyURLScheduleResponse) ProtoMessage() {}
+
+func (x *SetAnonyURLScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAnonyURLRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_anony_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAnonyURLResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnonyURLScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetAnonyURLScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetAnonyURLScheduleResponse) GetAnonyUrl() *AnonyURL {
+	if x != nil {
+		return x.AnonyUrl
+	}
+	return nil
+}
+
+type Variant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// 1〜10000
+	Weight int64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// レスポンスのみ
+	Clicks int64 `protobuf:"varint,3,opt,name=clicks,proto3" json:"clicks,omitempty"`
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAnonyURLStatusRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Variant) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Variant) GetWeight() int64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Variant) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+// variantsで全ての振り分け先を置き換える. クリック数はリセットされる. 空の場合はA/Bテストを終了する
+type SetAnonyURLVariantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string     `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM       `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+	Variants    []*Variant `protobuf:"bytes,3,rep,name=variants,proto3" json:"variants,omitempty"`
+}
+
+func (x *SetAnonyURLVariantsRequest) Reset() {
+	*x = SetAnonyURLVariantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAnonyURLVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnonyURLVariantsRequest) ProtoMessage() {}
+
+func (x *SetAnonyURLVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAnonyURLStatusResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnonyURLVariantsRequest.ProtoReflect.Descriptor instead.
+func (*SetAnonyURLVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetAnonyURLVariantsRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *SetAnonyURLVariantsRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+func (x *SetAnonyURLVariantsRequest) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type SetAnonyURLVariantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variants []*Variant `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+}
+
+func (x *SetAnonyURLVariantsResponse) Reset() {
+	*x = SetAnonyURLVariantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAnonyURLVariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnonyURLVariantsResponse) ProtoMessage() {}
+
+func (x *SetAnonyURLVariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnonyURL); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnonyURLVariantsResponse.ProtoReflect.Descriptor instead.
+func (*SetAnonyURLVariantsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetAnonyURLVariantsResponse) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type GetAnonyURLStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM   `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+}
+
+func (x *GetAnonyURLStatsRequest) Reset() {
+	*x = GetAnonyURLStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnonyURLStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnonyURLStatsRequest) ProtoMessage() {}
+
+func (x *GetAnonyURLStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAnonyURLsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnonyURLStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnonyURLStatsRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAnonyURLStatsRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *GetAnonyURLStatsRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+type GetAnonyURLStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnonyUrl *AnonyURL  `protobuf:"bytes,1,opt,name=anony_url,json=anonyUrl,proto3" json:"anony_url,omitempty"`
+	Clicks   int64      `protobuf:"varint,2,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Variants []*Variant `protobuf:"bytes,3,rep,name=variants,proto3" json:"variants,omitempty"`
+}
+
+func (x *GetAnonyURLStatsResponse) Reset() {
+	*x = GetAnonyURLStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnonyURLStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnonyURLStatsResponse) ProtoMessage() {}
+
+func (x *GetAnonyURLStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAnonyURLsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnonyURLStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnonyURLStatsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAnonyURLStatsResponse) GetAnonyUrl() *AnonyURL {
+	if x != nil {
+		return x.AnonyUrl
+	}
+	return nil
+}
+
+func (x *GetAnonyURLStatsResponse) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *GetAnonyURLStatsResponse) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type CreateCampaignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl           string       `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	IsActive              bool         `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Domain                string       `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	RedirectMode          RedirectMode `protobuf:"varint,4,opt,name=redirect_mode,json=redirectMode,proto3,enum=anony.RedirectMode" json:"redirect_mode,omitempty"`
+	QueryMode             QueryMode    `protobuf:"varint,5,opt,name=query_mode,json=queryMode,proto3,enum=anony.QueryMode" json:"query_mode,omitempty"`
+	ForwardPath           bool         `protobuf:"varint,6,opt,name=forward_path,json=forwardPath,proto3" json:"forward_path,omitempty"`
+	Campaign              string       `protobuf:"bytes,7,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Channels              []*UTM       `protobuf:"bytes,8,rep,name=channels,proto3" json:"channels,omitempty"`
+	CollapseRedirectChain bool         `protobuf:"varint,9,opt,name=collapse_redirect_chain,json=collapseRedirectChain,proto3" json:"collapse_redirect_chain,omitempty"`
+}
+
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignRequest) ProtoMessage() {}
+
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_anony_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountAnonyURLsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateCampaignRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateCampaignRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetRedirectMode() RedirectMode {
+	if x != nil {
+		return x.RedirectMode
+	}
+	return RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+func (x *CreateCampaignRequest) GetQueryMode() QueryMode {
+	if x != nil {
+		return x.QueryMode
+	}
+	return QueryMode_QUERY_MODE_IGNORE
+}
+
+func (x *CreateCampaignRequest) GetForwardPath() bool {
+	if x != nil {
+		return x.ForwardPath
+	}
+	return false
+}
+
+func (x *CreateCampaignRequest) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetChannels() []*UTM {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *CreateCampaignRequest) GetCollapseRedirectChain() bool {
+	if x != nil {
+		return x.CollapseRedirectChain
+	}
+	return false
+}
+
+type CreateCampaignResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnonyUrls []*AnonyURL `protobuf:"bytes,1,rep,name=anony_urls,json=anonyUrls,proto3" json:"anony_urls,omitempty"`
+}
+
+func (x *CreateCampaignResponse) Reset() {
+	*x = CreateCampaignResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCampaignResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignResponse) ProtoMessage() {}
+
+func (x *CreateCampaignResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignResponse.ProtoReflect.Descriptor instead.
+func (*CreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateCampaignResponse) GetAnonyUrls() []*AnonyURL {
+	if x != nil {
+		return x.AnonyUrls
+	}
+	return nil
+}
+
+type ListAnonyURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InActive bool `protobuf:"varint,1,opt,name=inActive,proto3" json:"inActive,omitempty"`
+	All      bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	// 指定した全てのタグ(またはその子孫のタグ)が付いたリンクに絞り込む
+	TagIds []string `protobuf:"bytes,3,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+}
+
+func (x *ListAnonyURLsRequest) Reset() {
+	*x = ListAnonyURLsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnonyURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnonyURLsRequest) ProtoMessage() {}
+
+func (x *ListAnonyURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnonyURLsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnonyURLsRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAnonyURLsRequest) GetInActive() bool {
+	if x != nil {
+		return x.InActive
+	}
+	return false
+}
+
+func (x *ListAnonyURLsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ListAnonyURLsRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+type ListAnonyURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnonyUrls []*AnonyURL `protobuf:"bytes,1,rep,name=anony_urls,json=anonyUrls,proto3" json:"anony_urls,omitempty"`
+}
+
+func (x *ListAnonyURLsResponse) Reset() {
+	*x = ListAnonyURLsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAnonyURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnonyURLsResponse) ProtoMessage() {}
+
+func (x *ListAnonyURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnonyURLsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnonyURLsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListAnonyURLsResponse) GetAnonyUrls() []*AnonyURL {
+	if x != nil {
+		return x.AnonyUrls
+	}
+	return nil
+}
+
+// 元のURL, 短縮コード, タイトル, メモ, タグ名を検索する
+// 空白で区切った全ての語(2文字以上, 8語まで)を含むリンクを関連度の高い順に返す
+type SearchAnonyURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 0の場合は50件. 最大200件
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *SearchAnonyURLsRequest) Reset() {
+	*x = SearchAnonyURLsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAnonyURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnonyURLsRequest) ProtoMessage() {}
+
+func (x *SearchAnonyURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnonyURLsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAnonyURLsRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SearchAnonyURLsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchAnonyURLsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchAnonyURLsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchAnonyURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*AnonyURLSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchAnonyURLsResponse) Reset() {
+	*x = SearchAnonyURLsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAnonyURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAnonyURLsResponse) ProtoMessage() {}
+
+func (x *SearchAnonyURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAnonyURLsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAnonyURLsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SearchAnonyURLsResponse) GetResults() []*AnonyURLSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AnonyURLSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnonyUrl *AnonyURL `protobuf:"bytes,1,opt,name=anony_url,json=anonyUrl,proto3" json:"anony_url,omitempty"`
+	// 関連度. 同じ検索結果の中での比較にのみ使う
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *AnonyURLSearchResult) Reset() {
+	*x = AnonyURLSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonyURLSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonyURLSearchResult) ProtoMessage() {}
+
+func (x *AnonyURLSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonyURLSearchResult.ProtoReflect.Descriptor instead.
+func (*AnonyURLSearchResult) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AnonyURLSearchResult) GetAnonyUrl() *AnonyURL {
+	if x != nil {
+		return x.AnonyUrl
+	}
+	return nil
+}
+
+func (x *AnonyURLSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 最後の死活確認で接続できなかった, またはエラーのステータスを返したリンク
+type ListBrokenAnonyURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AnonyUrls []*AnonyURL `protobuf:"bytes,1,rep,name=anony_urls,json=anonyUrls,proto3" json:"anony_urls,omitempty"`
+}
+
+func (x *ListBrokenAnonyURLsResponse) Reset() {
+	*x = ListBrokenAnonyURLsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBrokenAnonyURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrokenAnonyURLsResponse) ProtoMessage() {}
+
+func (x *ListBrokenAnonyURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrokenAnonyURLsResponse.ProtoReflect.Descriptor instead.
+func (*ListBrokenAnonyURLsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListBrokenAnonyURLsResponse) GetAnonyUrls() []*AnonyURL {
+	if x != nil {
+		return x.AnonyUrls
+	}
+	return nil
+}
+
+type CountAnonyURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ListAnonyURLsRequestのtag_idsと同じ
+	TagIds []string `protobuf:"bytes,1,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+}
+
+func (x *CountAnonyURLsRequest) Reset() {
+	*x = CountAnonyURLsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountAnonyURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountAnonyURLsRequest) ProtoMessage() {}
+
+func (x *CountAnonyURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountAnonyURLsRequest.ProtoReflect.Descriptor instead.
+func (*CountAnonyURLsRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CountAnonyURLsRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+type CountAnonyURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email       string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	CountAll    int64  `protobuf:"varint,3,opt,name=count_all,json=countAll,proto3" json:"count_all,omitempty"`
+	CountActive int64  `protobuf:"varint,4,opt,name=count_active,json=countActive,proto3" json:"count_active,omitempty"`
+	// タグで絞り込んだ場合も, ユーザーの全てのAnonyURLの使用量
+	Quota *Quota `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *CountAnonyURLsResponse) Reset() {
+	*x = CountAnonyURLsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountAnonyURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountAnonyURLsResponse) ProtoMessage() {}
+
+func (x *CountAnonyURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountAnonyURLsResponse.ProtoReflect.Descriptor instead.
+func (*CountAnonyURLsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CountAnonyURLsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CountAnonyURLsResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CountAnonyURLsResponse) GetCountAll() int64 {
+	if x != nil {
+		return x.CountAll
+	}
+	return 0
+}
+
+func (x *CountAnonyURLsResponse) GetCountActive() int64 {
+	if x != nil {
+		return x.CountActive
+	}
+	return 0
+}
+
+func (x *CountAnonyURLsResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// ユーザーのクォータと使用量. 上限の0は無制限
+// 上限を超える作成や有効化はRESOURCE_EXHAUSTEDになる
+type Quota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAnonyUrls       int64 `protobuf:"varint,1,opt,name=max_anony_urls,json=maxAnonyUrls,proto3" json:"max_anony_urls,omitempty"`
+	MaxActiveAnonyUrls int64 `protobuf:"varint,2,opt,name=max_active_anony_urls,json=maxActiveAnonyUrls,proto3" json:"max_active_anony_urls,omitempty"`
+	// 直近24時間に作成できる数
+	MaxCreationsPerDay  int64 `protobuf:"varint,3,opt,name=max_creations_per_day,json=maxCreationsPerDay,proto3" json:"max_creations_per_day,omitempty"`
+	CountAll            int64 `protobuf:"varint,4,opt,name=count_all,json=countAll,proto3" json:"count_all,omitempty"`
+	CountActive         int64 `protobuf:"varint,5,opt,name=count_active,json=countActive,proto3" json:"count_active,omitempty"`
+	CountCreatedLastDay int64 `protobuf:"varint,6,opt,name=count_created_last_day,json=countCreatedLastDay,proto3" json:"count_created_last_day,omitempty"`
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Quota) GetMaxAnonyUrls() int64 {
+	if x != nil {
+		return x.MaxAnonyUrls
+	}
+	return 0
+}
+
+func (x *Quota) GetMaxActiveAnonyUrls() int64 {
+	if x != nil {
+		return x.MaxActiveAnonyUrls
+	}
+	return 0
+}
+
+func (x *Quota) GetMaxCreationsPerDay() int64 {
+	if x != nil {
+		return x.MaxCreationsPerDay
+	}
+	return 0
+}
+
+func (x *Quota) GetCountAll() int64 {
+	if x != nil {
+		return x.CountAll
+	}
+	return 0
+}
+
+func (x *Quota) GetCountActive() int64 {
+	if x != nil {
+		return x.CountActive
+	}
+	return 0
+}
+
+func (x *Quota) GetCountCreatedLastDay() int64 {
+	if x != nil {
+		return x.CountCreatedLastDay
+	}
+	return 0
+}
+
+type Domain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Verified                bool   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerificationRecordName  string `protobuf:"bytes,3,opt,name=verification_record_name,json=verificationRecordName,proto3" json:"verification_record_name,omitempty"`
+	VerificationRecordValue string `protobuf:"bytes,4,opt,name=verification_record_value,json=verificationRecordValue,proto3" json:"verification_record_value,omitempty"`
+}
+
+func (x *Domain) Reset() {
+	*x = Domain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Domain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Domain) ProtoMessage() {}
+
+func (x *Domain) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Domain.ProtoReflect.Descriptor instead.
+func (*Domain) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Domain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Domain) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Domain) GetVerificationRecordName() string {
+	if x != nil {
+		return x.VerificationRecordName
+	}
+	return ""
+}
+
+func (x *Domain) GetVerificationRecordValue() string {
+	if x != nil {
+		return x.VerificationRecordValue
+	}
+	return ""
+}
+
+type RegisterDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RegisterDomainRequest) Reset() {
+	*x = RegisterDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDomainRequest) ProtoMessage() {}
+
+func (x *RegisterDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDomainRequest.ProtoReflect.Descriptor instead.
+func (*RegisterDomainRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RegisterDomainRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterDomainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain *Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *RegisterDomainResponse) Reset() {
+	*x = RegisterDomainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDomainResponse) ProtoMessage() {}
+
+func (x *RegisterDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDomainResponse.ProtoReflect.Descriptor instead.
+func (*RegisterDomainResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RegisterDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+type VerifyDomainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainRequest) ProtoMessage() {}
+
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VerifyDomainRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type VerifyDomainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain *Domain `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainResponse) ProtoMessage() {}
+
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VerifyDomainResponse) GetDomain() *Domain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+type ListDomainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domains []*Domain `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+}
+
+func (x *ListDomainsResponse) Reset() {
+	*x = ListDomainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsResponse) ProtoMessage() {}
+
+func (x *ListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDomainsResponse) GetDomains() []*Domain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type RedirectRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platform Platform `protobuf:"varint,1,opt,name=platform,proto3,enum=anony.Platform" json:"platform,omitempty"`
+	// Accept-Languageの最優先の言語タグ. "ja"は"ja-JP"にも一致する
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// ISO 3166-1 alpha-2の国コード
+	Country     string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	ActiveFrom  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`
+	ActiveUntil *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`
+	Destination string                 `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *RedirectRule) Reset() {
+	*x = RedirectRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectRule) ProtoMessage() {}
+
+func (x *RedirectRule) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectRule.ProtoReflect.Descriptor instead.
+func (*RedirectRule) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RedirectRule) GetPlatform() Platform {
+	if x != nil {
+		return x.Platform
+	}
+	return Platform_PLATFORM_ANY
+}
+
+func (x *RedirectRule) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *RedirectRule) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *RedirectRule) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type ListRedirectRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM   `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+}
+
+func (x *ListRedirectRulesRequest) Reset() {
+	*x = ListRedirectRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRedirectRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedirectRulesRequest) ProtoMessage() {}
+
+func (x *ListRedirectRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedirectRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRedirectRulesRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListRedirectRulesRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *ListRedirectRulesRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+type ListRedirectRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*RedirectRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ListRedirectRulesResponse) Reset() {
+	*x = ListRedirectRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRedirectRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRedirectRulesResponse) ProtoMessage() {}
+
+func (x *ListRedirectRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRedirectRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRedirectRulesResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListRedirectRulesResponse) GetRules() []*RedirectRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// rulesで全てのルールを置き換える
+type SetRedirectRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string          `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM            `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+	Rules       []*RedirectRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *SetRedirectRulesRequest) Reset() {
+	*x = SetRedirectRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRedirectRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRedirectRulesRequest) ProtoMessage() {}
+
+func (x *SetRedirectRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRedirectRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetRedirectRulesRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetRedirectRulesRequest) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *SetRedirectRulesRequest) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+func (x *SetRedirectRulesRequest) GetRules() []*RedirectRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetRedirectRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*RedirectRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *SetRedirectRulesResponse) Reset() {
+	*x = SetRedirectRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRedirectRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRedirectRulesResponse) ProtoMessage() {}
+
+func (x *SetRedirectRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRedirectRulesResponse.ProtoReflect.Descriptor instead.
+func (*SetRedirectRulesResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetRedirectRulesResponse) GetRules() []*RedirectRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 空文字の場合は最上位
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// 最上位からの名前を"/"で結合したもの
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Tag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Tag) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CreateTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagId string `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RenameTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag *Tag `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RenameTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagId string `protobuf:"bytes,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteTagRequest) GetTagId() string {
+	if x != nil {
+		return x.TagId
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// リンクはoriginal_urlとutmの組で特定する
+type AnonyURLKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginalUrl string `protobuf:"bytes,1,opt,name=original_url,json=originalUrl,proto3" json:"original_url,omitempty"`
+	Utm         *UTM   `protobuf:"bytes,2,opt,name=utm,proto3" json:"utm,omitempty"`
+}
+
+func (x *AnonyURLKey) Reset() {
+	*x = AnonyURLKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnonyURLKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnonyURLKey) ProtoMessage() {}
+
+func (x *AnonyURLKey) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnonyURLKey.ProtoReflect.Descriptor instead.
+func (*AnonyURLKey) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AnonyURLKey) GetOriginalUrl() string {
+	if x != nil {
+		return x.OriginalUrl
+	}
+	return ""
+}
+
+func (x *AnonyURLKey) GetUtm() *UTM {
+	if x != nil {
+		return x.Utm
+	}
+	return nil
+}
+
+// 全てのlinksに全てのtag_idsを付ける(外す). 一度に20タグ, 500リンクまで
+type TagAnonyURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TagIds []string       `protobuf:"bytes,1,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Links  []*AnonyURLKey `protobuf:"bytes,2,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *TagAnonyURLsRequest) Reset() {
+	*x = TagAnonyURLsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagAnonyURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagAnonyURLsRequest) ProtoMessage() {}
+
+func (x *TagAnonyURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagAnonyURLsRequest.ProtoReflect.Descriptor instead.
+func (*TagAnonyURLsRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TagAnonyURLsRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *TagAnonyURLsRequest) GetLinks() []*AnonyURLKey {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type TagAnonyURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 対象になったリンクの数
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TagAnonyURLsResponse) Reset() {
+	*x = TagAnonyURLsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagAnonyURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagAnonyURLsResponse) ProtoMessage() {}
+
+func (x *TagAnonyURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagAnonyURLsResponse.ProtoReflect.Descriptor instead.
+func (*TagAnonyURLsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TagAnonyURLsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Webhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// anony_url.created, anony_url.deactivated, anony_url.expired, anony_url.clicked
+	EventTypes []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId string `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId   string `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType string `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// 送信したJSON
+	Payload   string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts  int64                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// 署名の検証に使う. 作成時のみ返す
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type ListDeadWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+}
+
+func (x *ListDeadWebhookDeliveriesRequest) Reset() {
+	*x = ListDeadWebhookDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListDeadWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListDeadWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type ListDeadWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListDeadWebhookDeliveriesResponse) Reset() {
+	*x = ListDeadWebhookDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListDeadWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListDeadWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId string `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+}
+
+func (x *RedeliverWebhookDeliveryRequest) Reset() {
+	*x = RedeliverWebhookDeliveryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RedeliverWebhookDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type RedeliverWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delivery *WebhookDelivery `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+}
+
+func (x *RedeliverWebhookDeliveryResponse) Reset() {
+	*x = RedeliverWebhookDeliveryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RedeliverWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// "<対象の種類>.<操作>". anony_url.create, user.update, tag.delete など
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// anony_url, user, domain, tag, webhook
+	TargetType string `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId   string `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// 変更前後の値のJSON. 作成ではbefore, 削除ではafterが空
+	Before    string                 `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After     string                 `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	RequestId string                 `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ClientIp  string                 `protobuf:"bytes,9,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 空の場合は絞り込まない. 管理者以外は自分のIDのみ
+	ActorId    string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action     string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType string                 `protobuf:"bytes,3,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId   string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Since      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	// 0の場合は50件, 最大500件
+	Limit  int64 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int64 `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_anony_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anony_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_anony_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_anony_proto protoreflect.FileDescriptor
+
+var file_anony_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x1a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x34, 0x0a, 0x08, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x7f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x4f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x50, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x4f, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x61, 0x6d,
+	0x65, 0x4f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x4e, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x03, 0x55, 0x54, 0x4d, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xf1, 0x05, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xe2, 0xdf, 0x1f, 0x13, 0x0a, 0x0e, 0x5e, 0x28, 0x3f,
+	0x69, 0x29, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3f, 0x3a, 0x2f, 0x2f, 0x78, 0x81, 0x10, 0x52, 0x0b,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c,
+	0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d, 0x12, 0x2a, 0x0a, 0x08,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xdf, 0x1f, 0x03,
+	0x78, 0x81, 0x10, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c,
+	0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x72, 0x6c, 0x73, 0x22, 0x7b, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d,
+	0x22, 0x4c, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e,
+	0x79, 0x55, 0x52, 0x4c, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x22, 0xe1,
+	0x06, 0x0a, 0x08, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52,
+	0x03, 0x75, 0x74, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x42, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xbe, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x76, 0x69, 0x63,
+	0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61,
+	0x76, 0x69, 0x63, 0x6f, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x1e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54,
+	0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d, 0x22, 0x4f, 0x0a, 0x1f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x08, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x99, 0x02, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03,
+	0x75, 0x74, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x4e, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x55, 0x72, 0x6c, 0x22, 0xae, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
+	0x55, 0x52, 0x4c, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75, 0x74,
+	0x6d, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x31,
+	0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x18, 0x81, 0x10, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0xe2, 0xdf, 0x1f, 0x0d, 0x10,
+	0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x18, 0x11, 0x52, 0x06, 0x6d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x22, 0x71, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
+	0x55, 0x52, 0x4c, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x85, 0x02, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x41,
+	0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55,
+	0x54, 0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xdf, 0x1f, 0x03, 0x78,
+	0x81, 0x10, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x22,
+	0x4b, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x22, 0x64, 0x0a, 0x07,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xdf,
+	0x1f, 0x03, 0x78, 0x81, 0x10, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c,
+	0x69, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75,
+	0x74, 0x6d, 0x12, 0x2a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x49,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d,
+	0x52, 0x03, 0x75, 0x74, 0x6d, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0x92, 0x03, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xe2, 0xdf, 0x1f, 0x13, 0x0a, 0x0e, 0x5e, 0x28, 0x3f, 0x69,
+	0x29, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3f, 0x3a, 0x2f, 0x2f, 0x78, 0x81, 0x10, 0x52, 0x0b, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
+	0x38, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x0a, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x15, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x48, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x72, 0x6c, 0x73, 0x22, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
+	0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49,
+	0x64, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c,
+	0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x16,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x58, 0x01, 0x78, 0x81, 0x02,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x10, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0f,
+	0xe2, 0xdf, 0x1f, 0x0b, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x50, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f, 0x6e,
+	0x79, 0x55, 0x52, 0x4c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x14, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x08, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x6f,
+	0x6b, 0x65, 0x6e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x55, 0x72, 0x6c, 0x73, 0x22, 0x30, 0x0a, 0x15, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x67, 0x49, 0x64, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22,
+	0x88, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72, 0x6c, 0x73, 0x12,
+	0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x72,
+	0x6c, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61,
+	0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x6c, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x44, 0x61, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x06, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3a, 0x0a, 0x19, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x29, 0x0a, 0x13, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x22, 0x3e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xdf, 0x1f, 0x03, 0x78, 0x81,
+	0x10, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a,
+	0x03, 0x75, 0x74, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d, 0x22, 0x46, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55,
+	0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03, 0x75, 0x74, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d,
+	0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x18, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x5a, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x4c,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xe2, 0xdf, 0x1f, 0x03, 0x78, 0x80, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22,
+	0x46, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xe2, 0xdf, 0x1f, 0x03, 0x78, 0x80,
+	0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x29, 0x0a, 0x10, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x67, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x4e, 0x0a, 0x0b, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x03, 0x75,
+	0x74, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x2e, 0x55, 0x54, 0x4d, 0x52, 0x03, 0x75, 0x74, 0x6d, 0x22, 0x58, 0x0a, 0x13, 0x54, 0x61, 0x67,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4b, 0x65, 0x79, 0x52, 0x05, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x54, 0x61, 0x67, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x87, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x0f,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x62, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xe2,
+	0xdf, 0x1f, 0x13, 0x0a, 0x0e, 0x5e, 0x28, 0x3f, 0x69, 0x29, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3f,
+	0x3a, 0x2f, 0x2f, 0x78, 0x81, 0x10, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x42,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x22, 0x41, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x42, 0x0a, 0x1f, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x20, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0xb2,
+	0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x18, 0xf5, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0f, 0xe2,
+	0xdf, 0x1f, 0x0b, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x44, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x91, 0x01, 0x0a,
+	0x0c, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x19, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x4c,
+	0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x16,
+	0x0a, 0x12, 0x54, 0x45, 0x4d, 0x50, 0x4f, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x44, 0x49,
+	0x52, 0x45, 0x43, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x04, 0x12, 0x10,
+	0x0a, 0x0c, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x53, 0x54, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x05,
+	0x2a, 0x52, 0x0a, 0x09, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a,
+	0x11, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x47, 0x4e, 0x4f,
+	0x52, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x51,
+	0x55, 0x45, 0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49,
+	0x44, 0x45, 0x10, 0x02, 0x2a, 0x20, 0x0a, 0x0c, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x53, 0x56, 0x47, 0x10, 0x01, 0x2a, 0x6b, 0x0a, 0x14, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26,
+	0x0a, 0x22, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x51,
+	0x55, 0x41, 0x52, 0x54, 0x49, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47,
+	0x48, 0x10, 0x04, 0x2a, 0x60, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x10, 0x0a, 0x0c, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x41, 0x4e, 0x59, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e,
+	0x44, 0x52, 0x4f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x53, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x41, 0x43, 0x4f, 0x53, 0x10, 0x04, 0x12,
+	0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4e, 0x55, 0x58, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x06, 0x32, 0x90, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x49,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x6f,
+	0x67, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x87, 0x09, 0x0a, 0x0c, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x22, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52,
+	0x4c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x55, 0x52, 0x4c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52,
+	0x4c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e,
+	0x79, 0x55, 0x52, 0x4c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x6f, 0x6b,
+	0x65, 0x6e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x17, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x25, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52,
+	0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c,
+	0x51, 0x52, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x51, 0x52, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x51, 0x52, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1d, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79,
+	0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xea, 0x01, 0x0a, 0x0d, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xc2, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9b, 0x03, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67,
+	0x12, 0x17, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67,
+	0x12, 0x17, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67,
+	0x12, 0x17, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x3b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0c, 0x54, 0x61, 0x67, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1a,
+	0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x54, 0x61, 0x67, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x2e, 0x54, 0x61, 0x67, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x55, 0x6e, 0x74, 0x61, 0x67,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x54, 0x61, 0x67, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x54, 0x61,
+	0x67, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xc4, 0x03, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1b, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x61, 0x64, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18,
+	0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x60, 0x0a, 0x0c, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61,
+	0x6e, 0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x05, 0x5a, 0x03, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_anony_proto_rawDescOnce sync.Once
+	file_anony_proto_rawDescData = file_anony_proto_rawDesc
+)
+
+func file_anony_proto_rawDescGZIP() []byte {
+	file_anony_proto_rawDescOnce.Do(func() {
+		file_anony_proto_rawDescData = protoimpl.X.CompressGZIP(file_anony_proto_rawDescData)
+	})
+	return file_anony_proto_rawDescData
+}
+
+var file_anony_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_anony_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_anony_proto_goTypes = []interface{}{
+	(RedirectMode)(0),                         // 0: anony.RedirectMode
+	(QueryMode)(0),                            // 1: anony.QueryMode
+	(QRCodeFormat)(0),                         // 2: anony.QRCodeFormat
+	(ErrorCorrectionLevel)(0),                 // 3: anony.ErrorCorrectionLevel
+	(Platform)(0),                             // 4: anony.Platform
+	(*UserBase)(nil),                          // 5: anony.UserBase
+	(*CreateUserRequest)(nil),                 // 6: anony.CreateUserRequest
+	(*CreateUserResponse)(nil),                // 7: anony.CreateUserResponse
+	(*LogInUserRequest)(nil),                  // 8: anony.LogInUserRequest
+	(*LogInUserResponse)(nil),                 // 9: anony.LogInUserResponse
+	(*UTM)(nil),                               // 10: anony.UTM
+	(*CreateAnonyURLRequest)(nil),             // 11: anony.CreateAnonyURLRequest
+	(*CreateAnonyURLResponse)(nil),            // 12: anony.CreateAnonyURLResponse
+	(*UpdateAnonyURLStatusRequest)(nil),       // 13: anony.UpdateAnonyURLStatusRequest
+	(*UpdateAnonyURLStatusResponse)(nil),      // 14: anony.UpdateAnonyURLStatusResponse
+	(*AnonyURL)(nil),                          // 15: anony.AnonyURL
+	(*LinkPreview)(nil),                       // 16: anony.LinkPreview
+	(*RefreshAnonyURLMetadataRequest)(nil),    // 17: anony.RefreshAnonyURLMetadataRequest
+	(*RefreshAnonyURLMetadataResponse)(nil),   // 18: anony.RefreshAnonyURLMetadataResponse
+	(*UpdateAnonyURLMetadataRequest)(nil),     // 19: anony.UpdateAnonyURLMetadataRequest
+	(*UpdateAnonyURLMetadataResponse)(nil),    // 20: anony.UpdateAnonyURLMetadataResponse
+	(*GetAnonyURLQRCodeRequest)(nil),          // 21: anony.GetAnonyURLQRCodeRequest
+	(*GetAnonyURLQRCodeResponse)(nil),         // 22: anony.GetAnonyURLQRCodeResponse
+	(*SetAnonyURLScheduleRequest)(nil),        // 23: anony.SetAnonyURLScheduleRequest
+	(*SetAnonyURLScheduleResponse)(nil),       // 24: anony.SetAnonyURLScheduleResponse
+	(*Variant)(nil),                           // 25: anony.Variant
+	(*SetAnonyURLVariantsRequest)(nil),        // 26: anony.SetAnonyURLVariantsRequest
+	(*SetAnonyURLVariantsResponse)(nil),       // 27: anony.SetAnonyURLVariantsResponse
+	(*GetAnonyURLStatsRequest)(nil),           // 28: anony.GetAnonyURLStatsRequest
+	(*GetAnonyURLStatsResponse)(nil),          // 29: anony.GetAnonyURLStatsResponse
+	(*CreateCampaignRequest)(nil),             // 30: anony.CreateCampaignRequest
+	(*CreateCampaignResponse)(nil),            // 31: anony.CreateCampaignResponse
+	(*ListAnonyURLsRequest)(nil),              // 32: anony.ListAnonyURLsRequest
+	(*ListAnonyURLsResponse)(nil),             // 33: anony.ListAnonyURLsResponse
+	(*SearchAnonyURLsRequest)(nil),            // 34: anony.SearchAnonyURLsRequest
+	(*SearchAnonyURLsResponse)(nil),           // 35: anony.SearchAnonyURLsResponse
+	(*AnonyURLSearchResult)(nil),              // 36: anony.AnonyURLSearchResult
+	(*ListBrokenAnonyURLsResponse)(nil),       // 37: anony.ListBrokenAnonyURLsResponse
+	(*CountAnonyURLsRequest)(nil),             // 38: anony.CountAnonyURLsRequest
+	(*CountAnonyURLsResponse)(nil),            // 39: anony.CountAnonyURLsResponse
+	(*Quota)(nil),                             // 40: anony.Quota
+	(*Domain)(nil),                            // 41: anony.Domain
+	(*RegisterDomainRequest)(nil),             // 42: anony.RegisterDomainRequest
+	(*RegisterDomainResponse)(nil),            // 43: anony.RegisterDomainResponse
+	(*VerifyDomainRequest)(nil),               // 44: anony.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),              // 45: anony.VerifyDomainResponse
+	(*ListDomainsResponse)(nil),               // 46: anony.ListDomainsResponse
+	(*RedirectRule)(nil),                      // 47: anony.RedirectRule
+	(*ListRedirectRulesRequest)(nil),          // 48: anony.ListRedirectRulesRequest
+	(*ListRedirectRulesResponse)(nil),         // 49: anony.ListRedirectRulesResponse
+	(*SetRedirectRulesRequest)(nil),           // 50: anony.SetRedirectRulesRequest
+	(*SetRedirectRulesResponse)(nil),          // 51: anony.SetRedirectRulesResponse
+	(*Tag)(nil),                               // 52: anony.Tag
+	(*CreateTagRequest)(nil),                  // 53: anony.CreateTagRequest
+	(*CreateTagResponse)(nil),                 // 54: anony.CreateTagResponse
+	(*RenameTagRequest)(nil),                  // 55: anony.RenameTagRequest
+	(*RenameTagResponse)(nil),                 // 56: anony.RenameTagResponse
+	(*DeleteTagRequest)(nil),                  // 57: anony.DeleteTagRequest
+	(*ListTagsResponse)(nil),                  // 58: anony.ListTagsResponse
+	(*AnonyURLKey)(nil),                       // 59: anony.AnonyURLKey
+	(*TagAnonyURLsRequest)(nil),               // 60: anony.TagAnonyURLsRequest
+	(*TagAnonyURLsResponse)(nil),              // 61: anony.TagAnonyURLsResponse
+	(*Webhook)(nil),                           // 62: anony.Webhook
+	(*WebhookDelivery)(nil),                   // 63: anony.WebhookDelivery
+	(*CreateWebhookRequest)(nil),              // 64: anony.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),             // 65: anony.CreateWebhookResponse
+	(*DeleteWebhookRequest)(nil),              // 66: anony.DeleteWebhookRequest
+	(*ListWebhooksResponse)(nil),              // 67: anony.ListWebhooksResponse
+	(*ListDeadWebhookDeliveriesRequest)(nil),  // 68: anony.ListDeadWebhookDeliveriesRequest
+	(*ListDeadWebhookDeliveriesResponse)(nil), // 69: anony.ListDeadWebhookDeliveriesResponse
+	(*RedeliverWebhookDeliveryRequest)(nil),   // 70: anony.RedeliverWebhookDeliveryRequest
+	(*RedeliverWebhookDeliveryResponse)(nil),  // 71: anony.RedeliverWebhookDeliveryResponse
+	(*AuditEvent)(nil),                        // 72: anony.AuditEvent
+	(*ListAuditEventsRequest)(nil),            // 73: anony.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),           // 74: anony.ListAuditEventsResponse
+	nil,                                       // 75: anony.CreateAnonyURLRequest.MetadataEntry
+	nil,                                       // 76: anony.AnonyURL.MetadataEntry
+	nil,                                       // 77: anony.UpdateAnonyURLMetadataRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),             // 78: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 79: google.protobuf.Empty
+}
+var file_anony_proto_depIdxs = []int32{
+	5,   // 0: anony.CreateUserRequest.user:type_name -> anony.UserBase
+	5,   // 1: anony.CreateUserResponse.user:type_name -> anony.UserBase
+	5,   // 2: anony.LogInUserResponse.user:type_name -> anony.UserBase
+	0,   // 3: anony.CreateAnonyURLRequest.redirect_mode:type_name -> anony.RedirectMode
+	1,   // 4: anony.CreateAnonyURLRequest.query_mode:type_name -> anony.QueryMode
+	10,  // 5: anony.CreateAnonyURLRequest.utm:type_name -> anony.UTM
+	25,  // 6: anony.CreateAnonyURLRequest.variants:type_name -> anony.Variant
+	78,  // 7: anony.CreateAnonyURLRequest.active_from:type_name -> google.protobuf.Timestamp
+	78,  // 8: anony.CreateAnonyURLRequest.active_until:type_name -> google.protobuf.Timestamp
+	75,  // 9: anony.CreateAnonyURLRequest.metadata:type_name -> anony.CreateAnonyURLRequest.MetadataEntry
+	15,  // 10: anony.CreateAnonyURLResponse.anony_urls:type_name -> anony.AnonyURL
+	10,  // 11: anony.UpdateAnonyURLStatusRequest.utm:type_name -> anony.UTM
+	15,  // 12: anony.UpdateAnonyURLStatusResponse.anony_url:type_name -> anony.AnonyURL
+	0,   // 13: anony.AnonyURL.redirect_mode:type_name -> anony.RedirectMode
+	1,   // 14: anony.AnonyURL.query_mode:type_name -> anony.QueryMode
+	10,  // 15: anony.AnonyURL.utm:type_name -> anony.UTM
+	78,  // 16: anony.AnonyURL.active_from:type_name -> google.protobuf.Timestamp
+	78,  // 17: anony.AnonyURL.active_until:type_name -> google.protobuf.Timestamp
+	78,  // 18: anony.AnonyURL.last_checked_at:type_name -> google.protobuf.Timestamp
+	16,  // 19: anony.AnonyURL.preview:type_name -> anony.LinkPreview
+	52,  // 20: anony.AnonyURL.tags:type_name -> anony.Tag
+	76,  // 21: anony.AnonyURL.metadata:type_name -> anony.AnonyURL.MetadataEntry
+	78,  // 22: anony.LinkPreview.fetched_at:type_name -> google.protobuf.Timestamp
+	10,  // 23: anony.RefreshAnonyURLMetadataRequest.utm:type_name -> anony.UTM
+	15,  // 24: anony.RefreshAnonyURLMetadataResponse.anony_url:type_name -> anony.AnonyURL
+	10,  // 25: anony.UpdateAnonyURLMetadataRequest.utm:type_name -> anony.UTM
+	77,  // 26: anony.UpdateAnonyURLMetadataRequest.metadata:type_name -> anony.UpdateAnonyURLMetadataRequest.MetadataEntry
+	15,  // 27: anony.UpdateAnonyURLMetadataResponse.anony_url:type_name -> anony.AnonyURL
+	10,  // 28: anony.GetAnonyURLQRCodeRequest.utm:type_name -> anony.UTM
+	2,   // 29: anony.GetAnonyURLQRCodeRequest.format:type_name -> anony.QRCodeFormat
+	3,   // 30: anony.GetAnonyURLQRCodeRequest.error_correction:type_name -> anony.ErrorCorrectionLevel
+	10,  // 31: anony.SetAnonyURLScheduleRequest.utm:type_name -> anony.UTM
+	78,  // 32: anony.SetAnonyURLScheduleRequest.active_from:type_name -> google.protobuf.Timestamp
+	78,  // 33: anony.SetAnonyURLScheduleRequest.active_until:type_name -> google.protobuf.Timestamp
+	15,  // 34: anony.SetAnonyURLScheduleResponse.anony_url:type_name -> anony.AnonyURL
+	10,  // 35: anony.SetAnonyURLVariantsRequest.utm:type_name -> anony.UTM
+	25,  // 36: anony.SetAnonyURLVariantsRequest.variants:type_name -> anony.Variant
+	25,  // 37: anony.SetAnonyURLVariantsResponse.variants:type_name -> anony.Variant
+	10,  // 38: anony.GetAnonyURLStatsRequest.utm:type_name -> anony.UTM
+	15,  // 39: anony.GetAnonyURLStatsResponse.anony_url:type_name -> anony.AnonyURL
+	25,  // 40: anony.GetAnonyURLStatsResponse.variants:type_name -> anony.Variant
+	0,   // 41: anony.CreateCampaignRequest.redirect_mode:type_name -> anony.RedirectMode
+	1,   // 42: anony.CreateCampaignRequest.query_mode:type_name -> anony.QueryMode
+	10,  // 43: anony.CreateCampaignRequest.channels:type_name -> anony.UTM
+	15,  // 44: anony.CreateCampaignResponse.anony_urls:type_name -> anony.AnonyURL
+	15,  // 45: anony.ListAnonyURLsResponse.anony_urls:type_name -> anony.AnonyURL
+	36,  // 46: anony.SearchAnonyURLsResponse.results:type_name -> anony.AnonyURLSearchResult
+	15,  // 47: anony.AnonyURLSearchResult.anony_url:type_name -> anony.AnonyURL
+	15,  // 48: anony.ListBrokenAnonyURLsResponse.anony_urls:type_name -> anony.AnonyURL
+	40,  // 49: anony.CountAnonyURLsResponse.quota:type_name -> anony.Quota
+	41,  // 50: anony.RegisterDomainResponse.domain:type_name -> anony.Domain
+	41,  // 51: anony.VerifyDomainResponse.domain:type_name -> anony.Domain
+	41,  // 52: anony.ListDomainsResponse.domains:type_name -> anony.Domain
+	4,   // 53: anony.RedirectRule.platform:type_name -> anony.Platform
+	78,  // 54: anony.RedirectRule.active_from:type_name -> google.protobuf.Timestamp
+	78,  // 55: anony.RedirectRule.active_until:type_name -> google.protobuf.Timestamp
+	10,  // 56: anony.ListRedirectRulesRequest.utm:type_name -> anony.UTM
+	47,  // 57: anony.ListRedirectRulesResponse.rules:type_name -> anony.RedirectRule
+	10,  // 58: anony.SetRedirectRulesRequest.utm:type_name -> anony.UTM
+	47,  // 59: anony.SetRedirectRulesRequest.rules:type_name -> anony.RedirectRule
+	47,  // 60: anony.SetRedirectRulesResponse.rules:type_name -> anony.RedirectRule
+	52,  // 61: anony.CreateTagResponse.tag:type_name -> anony.Tag
+	52,  // 62: anony.RenameTagResponse.tag:type_name -> anony.Tag
+	52,  // 63: anony.ListTagsResponse.tags:type_name -> anony.Tag
+	10,  // 64: anony.AnonyURLKey.utm:type_name -> anony.UTM
+	59,  // 65: anony.TagAnonyURLsRequest.links:type_name -> anony.AnonyURLKey
+	78,  // 66: anony.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 67: anony.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 68: anony.CreateWebhookResponse.webhook:type_name -> anony.Webhook
+	62,  // 69: anony.ListWebhooksResponse.webhooks:type_name -> anony.Webhook
+	63,  // 70: anony.ListDeadWebhookDeliveriesResponse.deliveries:type_name -> anony.WebhookDelivery
+	63,  // 71: anony.RedeliverWebhookDeliveryResponse.delivery:type_name -> anony.WebhookDelivery
+	78,  // 72: anony.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 73: anony.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	78,  // 74: anony.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	72,  // 75: anony.ListAuditEventsResponse.events:type_name -> anony.AuditEvent
+	6,   // 76: anony.UserService.CreateUser:input_type -> anony.CreateUserRequest
+	8,   // 77: anony.UserService.LogInUser:input_type -> anony.LogInUserRequest
+	11,  // 78: anony.AnonyService.CreateAnonyURL:input_type -> anony.CreateAnonyURLRequest
+	13,  // 79: anony.AnonyService.UpdateAnonyURLStatus:input_type -> anony.UpdateAnonyURLStatusRequest
+	32,  // 80: anony.AnonyService.ListAnonyURLs:input_type -> anony.ListAnonyURLsRequest
+	38,  // 81: anony.AnonyService.CountAnonyURLs:input_type -> anony.CountAnonyURLsRequest
+	30,  // 82: anony.AnonyService.CreateCampaign:input_type -> anony.CreateCampaignRequest
+	26,  // 83: anony.AnonyService.SetAnonyURLVariants:input_type -> anony.SetAnonyURLVariantsRequest
+	28,  // 84: anony.AnonyService.GetAnonyURLStats:input_type -> anony.GetAnonyURLStatsRequest
+	23,  // 85: anony.AnonyService.SetAnonyURLSchedule:input_type -> anony.SetAnonyURLScheduleRequest
+	79,  // 86: anony.AnonyService.ListBrokenAnonyURLs:input_type -> google.protobuf.Empty
+	17,  // 87: anony.AnonyService.RefreshAnonyURLMetadata:input_type -> anony.RefreshAnonyURLMetadataRequest
+	21,  // 88: anony.AnonyService.GetAnonyURLQRCode:input_type -> anony.GetAnonyURLQRCodeRequest
+	34,  // 89: anony.AnonyService.SearchAnonyURLs:input_type -> anony.SearchAnonyURLsRequest
+	19,  // 90: anony.AnonyService.UpdateAnonyURLMetadata:input_type -> anony.UpdateAnonyURLMetadataRequest
+	42,  // 91: anony.DomainService.RegisterDomain:input_type -> anony.RegisterDomainRequest
+	44,  // 92: anony.DomainService.VerifyDomain:input_type -> anony.VerifyDomainRequest
+	79,  // 93: anony.DomainService.ListDomains:input_type -> google.protobuf.Empty
+	48,  // 94: anony.RedirectRuleService.ListRedirectRules:input_type -> anony.ListRedirectRulesRequest
+	50,  // 95: anony.RedirectRuleService.SetRedirectRules:input_type -> anony.SetRedirectRulesRequest
+	53,  // 96: anony.TagService.CreateTag:input_type -> anony.CreateTagRequest
+	55,  // 97: anony.TagService.RenameTag:input_type -> anony.RenameTagRequest
+	57,  // 98: anony.TagService.DeleteTag:input_type -> anony.DeleteTagRequest
+	79,  // 99: anony.TagService.ListTags:input_type -> google.protobuf.Empty
+	60,  // 100: anony.TagService.TagAnonyURLs:input_type -> anony.TagAnonyURLsRequest
+	60,  // 101: anony.TagService.UntagAnonyURLs:input_type -> anony.TagAnonyURLsRequest
+	64,  // 102: anony.WebhookService.CreateWebhook:input_type -> anony.CreateWebhookRequest
+	66,  // 103: anony.WebhookService.DeleteWebhook:input_type -> anony.DeleteWebhookRequest
+	79,  // 104: anony.WebhookService.ListWebhooks:input_type -> google.protobuf.Empty
+	68,  // 105: anony.WebhookService.ListDeadWebhookDeliveries:input_type -> anony.ListDeadWebhookDeliveriesRequest
+	70,  // 106: anony.WebhookService.RedeliverWebhookDelivery:input_type -> anony.RedeliverWebhookDeliveryRequest
+	73,  // 107: anony.AuditService.ListAuditEvents:input_type -> anony.ListAuditEventsRequest
+	7,   // 108: anony.UserService.CreateUser:output_type -> anony.CreateUserResponse
+	9,   // 109: anony.UserService.LogInUser:output_type -> anony.LogInUserResponse
+	12,  // 110: anony.AnonyService.CreateAnonyURL:output_type -> anony.CreateAnonyURLResponse
+	14,  // 111: anony.AnonyService.UpdateAnonyURLStatus:output_type -> anony.UpdateAnonyURLStatusResponse
+	33,  // 112: anony.AnonyService.ListAnonyURLs:output_type -> anony.ListAnonyURLsResponse
+	39,  // 113: anony.AnonyService.CountAnonyURLs:output_type -> anony.CountAnonyURLsResponse
+	31,  // 114: anony.AnonyService.CreateCampaign:output_type -> anony.CreateCampaignResponse
+	27,  // 115: anony.AnonyService.SetAnonyURLVariants:output_type -> anony.SetAnonyURLVariantsResponse
+	29,  // 116: anony.AnonyService.GetAnonyURLStats:output_type -> anony.GetAnonyURLStatsResponse
+	24,  // 117: anony.AnonyService.SetAnonyURLSchedule:output_type -> anony.SetAnonyURLScheduleResponse
+	37,  // 118: anony.AnonyService.ListBrokenAnonyURLs:output_type -> anony.ListBrokenAnonyURLsResponse
+	18,  // 119: anony.AnonyService.RefreshAnonyURLMetadata:output_type -> anony.RefreshAnonyURLMetadataResponse
+	22,  // 120: anony.AnonyService.GetAnonyURLQRCode:output_type -> anony.GetAnonyURLQRCodeResponse
+	35,  // 121: anony.AnonyService.SearchAnonyURLs:output_type -> anony.SearchAnonyURLsResponse
+	20,  // 122: anony.AnonyService.UpdateAnonyURLMetadata:output_type -> anony.UpdateAnonyURLMetadataResponse
+	43,  // 123: anony.DomainService.RegisterDomain:output_type -> anony.RegisterDomainResponse
+	45,  // 124: anony.DomainService.VerifyDomain:output_type -> anony.VerifyDomainResponse
+	46,  // 125: anony.DomainService.ListDomains:output_type -> anony.ListDomainsResponse
+	49,  // 126: anony.RedirectRuleService.ListRedirectRules:output_type -> anony.ListRedirectRulesResponse
+	51,  // 127: anony.RedirectRuleService.SetRedirectRules:output_type -> anony.SetRedirectRulesResponse
+	54,  // 128: anony.TagService.CreateTag:output_type -> anony.CreateTagResponse
+	56,  // 129: anony.TagService.RenameTag:output_type -> anony.RenameTagResponse
+	79,  // 130: anony.TagService.DeleteTag:output_type -> google.protobuf.Empty
+	58,  // 131: anony.TagService.ListTags:output_type -> anony.ListTagsResponse
+	61,  // 132: anony.TagService.TagAnonyURLs:output_type -> anony.TagAnonyURLsResponse
+	61,  // 133: anony.TagService.UntagAnonyURLs:output_type -> anony.TagAnonyURLsResponse
+	65,  // 134: anony.WebhookService.CreateWebhook:output_type -> anony.CreateWebhookResponse
+	79,  // 135: anony.WebhookService.DeleteWebhook:output_type -> google.protobuf.Empty
+	67,  // 136: anony.WebhookService.ListWebhooks:output_type -> anony.ListWebhooksResponse
+	69,  // 137: anony.WebhookService.ListDeadWebhookDeliveries:output_type -> anony.ListDeadWebhookDeliveriesResponse
+	71,  // 138: anony.WebhookService.RedeliverWebhookDelivery:output_type -> anony.RedeliverWebhookDeliveryResponse
+	74,  // 139: anony.AuditService.ListAuditEvents:output_type -> anony.ListAuditEventsResponse
+	108, // [108:140] is the sub-list for method output_type
+	76,  // [76:108] is the sub-list for method input_type
+	76,  // [76:76] is the sub-list for extension type_name
+	76,  // [76:76] is the sub-list for extension extendee
+	0,   // [0:76] is the sub-list for field type_name
+}
+
+func init() { file_anony_proto_init() }
+func file_anony_proto_init() {
+	if File_anony_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_anony_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserBase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogInUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UTM); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAnonyURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAnonyURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAnonyURLStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAnonyURLStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnonyURL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshAnonyURLMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshAnonyURLMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAnonyURLMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAnonyURLMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnonyURLQRCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnonyURLQRCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAnonyURLScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAnonyURLScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAnonyURLVariantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAnonyURLVariantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnonyURLStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnonyURLStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCampaignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCampaignResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnonyURLsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAnonyURLsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAnonyURLsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAnonyURLsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnonyURLSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBrokenAnonyURLsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountAnonyURLsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountAnonyURLsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Domain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterDomainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDomainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyDomainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDomainsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRedirectRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRedirectRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRedirectRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRedirectRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameTagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnonyURLKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagAnonyURLsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagAnonyURLsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Webhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadWebhookDeliveriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadWebhookDeliveriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeliverWebhookDeliveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeliverWebhookDeliveryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_anony_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_anony_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   73,
+			NumExtensions: 0,
+			NumServices:   7,
+		},
+		GoTypes:           file_anony_proto_goTypes,
+		DependencyIndexes: file_anony_proto_depIdxs,
+		EnumInfos:         file_anony_proto_enumTypes,
+		MessageInfos:      file_anony_proto_msgTypes,
+	}.Build()
+	File_anony_proto = out.File
+	file_anony_proto_rawDesc = nil
+	file_anony_proto_goTypes = nil
+	file_anony_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserServiceClient interface {
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	// rpc UpdateUser (UpdateUserRequest) returns (UpdateUserResponse);
+	// rpc DeleteUser (DeleteUserRequest) returns (google.protobuf.Empty);
+	LogInUser(ctx context.Context, in *LogInUserRequest, opts ...grpc.CallOption) (*LogInUserResponse, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, "/anony.UserService/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) LogInUser(ctx context.Context, in *LogInUserRequest, opts ...grpc.CallOption) (*LogInUserResponse, error) {
+	out := new(LogInUserResponse)
+	err := c.cc.Invoke(ctx, "/anony.UserService/LogInUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+type UserServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	// rpc UpdateUser (UpdateUserRequest) returns (UpdateUserResponse);
+	// rpc DeleteUser (DeleteUserRequest) returns (google.protobuf.Empty);
+	LogInUser(context.Context, *LogInUserRequest) (*LogInUserResponse, error)
+}
+
+// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
+}
+
+func (*UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedUserServiceServer) LogInUser(context.Context, *LogInUserRequest) (*LogInUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogInUser not implemented")
+}
+
+func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
+	s.RegisterService(&_UserService_serviceDesc, srv)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.UserService/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_LogInUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogInUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LogInUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.UserService/LogInUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LogInUser(ctx, req.(*LogInUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _UserService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "anony.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "LogInUser",
+			Handler:    _UserService_LogInUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anony.proto",
+}
+
+// AnonyServiceClient is the client API for AnonyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AnonyServiceClient interface {
+	CreateAnonyURL(ctx context.Context, in *CreateAnonyURLRequest, opts ...grpc.CallOption) (*CreateAnonyURLResponse, error)
+	UpdateAnonyURLStatus(ctx context.Context, in *UpdateAnonyURLStatusRequest, opts ...grpc.CallOption) (*UpdateAnonyURLStatusResponse, error)
+	ListAnonyURLs(ctx context.Context, in *ListAnonyURLsRequest, opts ...grpc.CallOption) (*ListAnonyURLsResponse, error)
+	CountAnonyURLs(ctx context.Context, in *CountAnonyURLsRequest, opts ...grpc.CallOption) (*CountAnonyURLsResponse, error)
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error)
+	SetAnonyURLVariants(ctx context.Context, in *SetAnonyURLVariantsRequest, opts ...grpc.CallOption) (*SetAnonyURLVariantsResponse, error)
+	GetAnonyURLStats(ctx context.Context, in *GetAnonyURLStatsRequest, opts ...grpc.CallOption) (*GetAnonyURLStatsResponse, error)
+	SetAnonyURLSchedule(ctx context.Context, in *SetAnonyURLScheduleRequest, opts ...grpc.CallOption) (*SetAnonyURLScheduleResponse, error)
+	ListBrokenAnonyURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBrokenAnonyURLsResponse, error)
+	RefreshAnonyURLMetadata(ctx context.Context, in *RefreshAnonyURLMetadataRequest, opts ...grpc.CallOption) (*RefreshAnonyURLMetadataResponse, error)
+	GetAnonyURLQRCode(ctx context.Context, in *GetAnonyURLQRCodeRequest, opts ...grpc.CallOption) (*GetAnonyURLQRCodeResponse, error)
+	SearchAnonyURLs(ctx context.Context, in *SearchAnonyURLsRequest, opts ...grpc.CallOption) (*SearchAnonyURLsResponse, error)
+	UpdateAnonyURLMetadata(ctx context.Context, in *UpdateAnonyURLMetadataRequest, opts ...grpc.CallOption) (*UpdateAnonyURLMetadataResponse, error)
+}
+
+type anonyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnonyServiceClient(cc grpc.ClientConnInterface) AnonyServiceClient {
+	return &anonyServiceClient{cc}
+}
+
+func (c *anonyServiceClient) CreateAnonyURL(ctx context.Context, in *CreateAnonyURLRequest, opts ...grpc.CallOption) (*CreateAnonyURLResponse, error) {
+	out := new(CreateAnonyURLResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/CreateAnonyURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) UpdateAnonyURLStatus(ctx context.Context, in *UpdateAnonyURLStatusRequest, opts ...grpc.CallOption) (*UpdateAnonyURLStatusResponse, error) {
+	out := new(UpdateAnonyURLStatusResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/UpdateAnonyURLStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) ListAnonyURLs(ctx context.Context, in *ListAnonyURLsRequest, opts ...grpc.CallOption) (*ListAnonyURLsResponse, error) {
+	out := new(ListAnonyURLsResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/ListAnonyURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) CountAnonyURLs(ctx context.Context, in *CountAnonyURLsRequest, opts ...grpc.CallOption) (*CountAnonyURLsResponse, error) {
+	out := new(CountAnonyURLsResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/CountAnonyURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignResponse, error) {
+	out := new(CreateCampaignResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/CreateCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) SetAnonyURLVariants(ctx context.Context, in *SetAnonyURLVariantsRequest, opts ...grpc.CallOption) (*SetAnonyURLVariantsResponse, error) {
+	out := new(SetAnonyURLVariantsResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/SetAnonyURLVariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) GetAnonyURLStats(ctx context.Context, in *GetAnonyURLStatsRequest, opts ...grpc.CallOption) (*GetAnonyURLStatsResponse, error) {
+	out := new(GetAnonyURLStatsResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/GetAnonyURLStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) SetAnonyURLSchedule(ctx context.Context, in *SetAnonyURLScheduleRequest, opts ...grpc.CallOption) (*SetAnonyURLScheduleResponse, error) {
+	out := new(SetAnonyURLScheduleResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/SetAnonyURLSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) ListBrokenAnonyURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBrokenAnonyURLsResponse, error) {
+	out := new(ListBrokenAnonyURLsResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/ListBrokenAnonyURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) RefreshAnonyURLMetadata(ctx context.Context, in *RefreshAnonyURLMetadataRequest, opts ...grpc.CallOption) (*RefreshAnonyURLMetadataResponse, error) {
+	out := new(RefreshAnonyURLMetadataResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/RefreshAnonyURLMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) GetAnonyURLQRCode(ctx context.Context, in *GetAnonyURLQRCodeRequest, opts ...grpc.CallOption) (*GetAnonyURLQRCodeResponse, error) {
+	out := new(GetAnonyURLQRCodeResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/GetAnonyURLQRCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) SearchAnonyURLs(ctx context.Context, in *SearchAnonyURLsRequest, opts ...grpc.CallOption) (*SearchAnonyURLsResponse, error) {
+	out := new(SearchAnonyURLsResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/SearchAnonyURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *anonyServiceClient) UpdateAnonyURLMetadata(ctx context.Context, in *UpdateAnonyURLMetadataRequest, opts ...grpc.CallOption) (*UpdateAnonyURLMetadataResponse, error) {
+	out := new(UpdateAnonyURLMetadataResponse)
+	err := c.cc.Invoke(ctx, "/anony.AnonyService/UpdateAnonyURLMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnonyServiceServer is the server API for AnonyService service.
+type AnonyServiceServer interface {
+	CreateAnonyURL(context.Context, *CreateAnonyURLRequest) (*CreateAnonyURLResponse, error)
+	UpdateAnonyURLStatus(context.Context, *UpdateAnonyURLStatusRequest) (*UpdateAnonyURLStatusResponse, error)
+	ListAnonyURLs(context.Context, *ListAnonyURLsRequest) (*ListAnonyURLsResponse, error)
+	CountAnonyURLs(context.Context, *CountAnonyURLsRequest) (*CountAnonyURLsResponse, error)
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error)
+	SetAnonyURLVariants(context.Context, *SetAnonyURLVariantsRequest) (*SetAnonyURLVariantsResponse, error)
+	GetAnonyURLStats(context.Context, *GetAnonyURLStatsRequest) (*GetAnonyURLStatsResponse, error)
+	SetAnonyURLSchedule(context.Context, *SetAnonyURLScheduleRequest) (*SetAnonyURLScheduleResponse, error)
+	ListBrokenAnonyURLs(context.Context, *emptypb.Empty) (*ListBrokenAnonyURLsResponse, error)
+	RefreshAnonyURLMetadata(context.Context, *RefreshAnonyURLMetadataRequest) (*RefreshAnonyURLMetadataResponse, error)
+	GetAnonyURLQRCode(context.Context, *GetAnonyURLQRCodeRequest) (*GetAnonyURLQRCodeResponse, error)
+	SearchAnonyURLs(context.Context, *SearchAnonyURLsRequest) (*SearchAnonyURLsResponse, error)
+	UpdateAnonyURLMetadata(context.Context, *UpdateAnonyURLMetadataRequest) (*UpdateAnonyURLMetadataResponse, error)
+}
+
+// UnimplementedAnonyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAnonyServiceServer struct {
+}
+
+func (*UnimplementedAnonyServiceServer) CreateAnonyURL(context.Context, *CreateAnonyURLRequest) (*CreateAnonyURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnonyURL not implemented")
+}
+func (*UnimplementedAnonyServiceServer) UpdateAnonyURLStatus(context.Context, *UpdateAnonyURLStatusRequest) (*UpdateAnonyURLStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnonyURLStatus not implemented")
+}
+func (*UnimplementedAnonyServiceServer) ListAnonyURLs(context.Context, *ListAnonyURLsRequest) (*ListAnonyURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnonyURLs not implemented")
+}
+func (*UnimplementedAnonyServiceServer) CountAnonyURLs(context.Context, *CountAnonyURLsRequest) (*CountAnonyURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountAnonyURLs not implemented")
+}
+func (*UnimplementedAnonyServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (*UnimplementedAnonyServiceServer) SetAnonyURLVariants(context.Context, *SetAnonyURLVariantsRequest) (*SetAnonyURLVariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnonyURLVariants not implemented")
+}
+func (*UnimplementedAnonyServiceServer) GetAnonyURLStats(context.Context, *GetAnonyURLStatsRequest) (*GetAnonyURLStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnonyURLStats not implemented")
+}
+func (*UnimplementedAnonyServiceServer) SetAnonyURLSchedule(context.Context, *SetAnonyURLScheduleRequest) (*SetAnonyURLScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnonyURLSchedule not implemented")
+}
+func (*UnimplementedAnonyServiceServer) ListBrokenAnonyURLs(context.Context, *emptypb.Empty) (*ListBrokenAnonyURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrokenAnonyURLs not implemented")
+}
+func (*UnimplementedAnonyServiceServer) RefreshAnonyURLMetadata(context.Context, *RefreshAnonyURLMetadataRequest) (*RefreshAnonyURLMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshAnonyURLMetadata not implemented")
+}
+func (*UnimplementedAnonyServiceServer) GetAnonyURLQRCode(context.Context, *GetAnonyURLQRCodeRequest) (*GetAnonyURLQRCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnonyURLQRCode not implemented")
+}
+func (*UnimplementedAnonyServiceServer) SearchAnonyURLs(context.Context, *SearchAnonyURLsRequest) (*SearchAnonyURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAnonyURLs not implemented")
+}
+func (*UnimplementedAnonyServiceServer) UpdateAnonyURLMetadata(context.Context, *UpdateAnonyURLMetadataRequest) (*UpdateAnonyURLMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnonyURLMetadata not implemented")
+}
+
+func RegisterAnonyServiceServer(s *grpc.Server, srv AnonyServiceServer) {
+	s.RegisterService(&_AnonyService_serviceDesc, srv)
+}
+
+func _AnonyService_CreateAnonyURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnonyURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).CreateAnonyURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/CreateAnonyURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).CreateAnonyURL(ctx, req.(*CreateAnonyURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_UpdateAnonyURLStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnonyURLStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).UpdateAnonyURLStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/UpdateAnonyURLStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).UpdateAnonyURLStatus(ctx, req.(*UpdateAnonyURLStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_ListAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnonyURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).ListAnonyURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/ListAnonyURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).ListAnonyURLs(ctx, req.(*ListAnonyURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_CountAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountAnonyURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).CountAnonyURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/CountAnonyURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).CountAnonyURLs(ctx, req.(*CountAnonyURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/CreateCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_SetAnonyURLVariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnonyURLVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).SetAnonyURLVariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/SetAnonyURLVariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).SetAnonyURLVariants(ctx, req.(*SetAnonyURLVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_GetAnonyURLStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnonyURLStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).GetAnonyURLStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/GetAnonyURLStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).GetAnonyURLStats(ctx, req.(*GetAnonyURLStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_SetAnonyURLSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnonyURLScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).SetAnonyURLSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/SetAnonyURLSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).SetAnonyURLSchedule(ctx, req.(*SetAnonyURLScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_ListBrokenAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).ListBrokenAnonyURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/ListBrokenAnonyURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).ListBrokenAnonyURLs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_RefreshAnonyURLMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshAnonyURLMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).RefreshAnonyURLMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/RefreshAnonyURLMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).RefreshAnonyURLMetadata(ctx, req.(*RefreshAnonyURLMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_GetAnonyURLQRCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnonyURLQRCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).GetAnonyURLQRCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/GetAnonyURLQRCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).GetAnonyURLQRCode(ctx, req.(*GetAnonyURLQRCodeRequest))
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_anony_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
-			NumExtensions: 0,
-			NumServices:   2,
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_SearchAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAnonyURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).SearchAnonyURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/SearchAnonyURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).SearchAnonyURLs(ctx, req.(*SearchAnonyURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnonyService_UpdateAnonyURLMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAnonyURLMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnonyServiceServer).UpdateAnonyURLMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AnonyService/UpdateAnonyURLMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnonyServiceServer).UpdateAnonyURLMetadata(ctx, req.(*UpdateAnonyURLMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AnonyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "anony.AnonyService",
+	HandlerType: (*AnonyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAnonyURL",
+			Handler:    _AnonyService_CreateAnonyURL_Handler,
 		},
-		GoTypes:           file_anony_proto_goTypes,
-		DependencyIndexes: file_anony_proto_depIdxs,
-		MessageInfos:      file_anony_proto_msgTypes,
-	}.Build()
-	File_anony_proto = out.File
-	file_anony_proto_rawDesc = nil
-	file_anony_proto_goTypes = nil
-	file_anony_proto_depIdxs = nil
+		{
+			MethodName: "UpdateAnonyURLStatus",
+			Handler:    _AnonyService_UpdateAnonyURLStatus_Handler,
+		},
+		{
+			MethodName: "ListAnonyURLs",
+			Handler:    _AnonyService_ListAnonyURLs_Handler,
+		},
+		{
+			MethodName: "CountAnonyURLs",
+			Handler:    _AnonyService_CountAnonyURLs_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _AnonyService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "SetAnonyURLVariants",
+			Handler:    _AnonyService_SetAnonyURLVariants_Handler,
+		},
+		{
+			MethodName: "GetAnonyURLStats",
+			Handler:    _AnonyService_GetAnonyURLStats_Handler,
+		},
+		{
+			MethodName: "SetAnonyURLSchedule",
+			Handler:    _AnonyService_SetAnonyURLSchedule_Handler,
+		},
+		{
+			MethodName: "ListBrokenAnonyURLs",
+			Handler:    _AnonyService_ListBrokenAnonyURLs_Handler,
+		},
+		{
+			MethodName: "RefreshAnonyURLMetadata",
+			Handler:    _AnonyService_RefreshAnonyURLMetadata_Handler,
+		},
+		{
+			MethodName: "GetAnonyURLQRCode",
+			Handler:    _AnonyService_GetAnonyURLQRCode_Handler,
+		},
+		{
+			MethodName: "SearchAnonyURLs",
+			Handler:    _AnonyService_SearchAnonyURLs_Handler,
+		},
+		{
+			MethodName: "UpdateAnonyURLMetadata",
+			Handler:    _AnonyService_UpdateAnonyURLMetadata_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anony.proto",
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+// DomainServiceClient is the client API for DomainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DomainServiceClient interface {
+	RegisterDomain(ctx context.Context, in *RegisterDomainRequest, opts ...grpc.CallOption) (*RegisterDomainResponse, error)
+	VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error)
+	ListDomains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDomainsResponse, error)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+type domainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
 
-// UserServiceClient is the client API for UserService service.
+func NewDomainServiceClient(cc grpc.ClientConnInterface) DomainServiceClient {
+	return &domainServiceClient{cc}
+}
+
+func (c *domainServiceClient) RegisterDomain(ctx context.Context, in *RegisterDomainRequest, opts ...grpc.CallOption) (*RegisterDomainResponse, error) {
+	out := new(RegisterDomainResponse)
+	err := c.cc.Invoke(ctx, "/anony.DomainService/RegisterDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainServiceClient) VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error) {
+	out := new(VerifyDomainResponse)
+	err := c.cc.Invoke(ctx, "/anony.DomainService/VerifyDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainServiceClient) ListDomains(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDomainsResponse, error) {
+	out := new(ListDomainsResponse)
+	err := c.cc.Invoke(ctx, "/anony.DomainService/ListDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DomainServiceServer is the server API for DomainService service.
+type DomainServiceServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error)
+	ListDomains(context.Context, *emptypb.Empty) (*ListDomainsResponse, error)
+}
+
+// UnimplementedDomainServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDomainServiceServer struct {
+}
+
+func (*UnimplementedDomainServiceServer) RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDomain not implemented")
+}
+func (*UnimplementedDomainServiceServer) VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+}
+func (*UnimplementedDomainServiceServer) ListDomains(context.Context, *emptypb.Empty) (*ListDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomains not implemented")
+}
+
+func RegisterDomainServiceServer(s *grpc.Server, srv DomainServiceServer) {
+	s.RegisterService(&_DomainService_serviceDesc, srv)
+}
+
+func _DomainService_RegisterDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).RegisterDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.DomainService/RegisterDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).RegisterDomain(ctx, req.(*RegisterDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainService_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.DomainService/VerifyDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).VerifyDomain(ctx, req.(*VerifyDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainService_ListDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).ListDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.DomainService/ListDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).ListDomains(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DomainService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "anony.DomainService",
+	HandlerType: (*DomainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDomain",
+			Handler:    _DomainService_RegisterDomain_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _DomainService_VerifyDomain_Handler,
+		},
+		{
+			MethodName: "ListDomains",
+			Handler:    _DomainService_ListDomains_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anony.proto",
+}
+
+// RedirectRuleServiceClient is the client API for RedirectRuleService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UserServiceClient interface {
-	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
-	// rpc UpdateUser (UpdateUserRequest) returns (UpdateUserResponse);
-	// rpc DeleteUser (DeleteUserRequest) returns (google.protobuf.Empty);
-	LogInUser(ctx context.Context, in *LogInUserRequest, opts ...grpc.CallOption) (*LogInUserResponse, error)
+type RedirectRuleServiceClient interface {
+	ListRedirectRules(ctx context.Context, in *ListRedirectRulesRequest, opts ...grpc.CallOption) (*ListRedirectRulesResponse, error)
+	SetRedirectRules(ctx context.Context, in *SetRedirectRulesRequest, opts ...grpc.CallOption) (*SetRedirectRulesResponse, error)
+}
+
+type redirectRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRedirectRuleServiceClient(cc grpc.ClientConnInterface) RedirectRuleServiceClient {
+	return &redirectRuleServiceClient{cc}
+}
+
+func (c *redirectRuleServiceClient) ListRedirectRules(ctx context.Context, in *ListRedirectRulesRequest, opts ...grpc.CallOption) (*ListRedirectRulesResponse, error) {
+	out := new(ListRedirectRulesResponse)
+	err := c.cc.Invoke(ctx, "/anony.RedirectRuleService/ListRedirectRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *redirectRuleServiceClient) SetRedirectRules(ctx context.Context, in *SetRedirectRulesRequest, opts ...grpc.CallOption) (*SetRedirectRulesResponse, error) {
+	out := new(SetRedirectRulesResponse)
+	err := c.cc.Invoke(ctx, "/anony.RedirectRuleService/SetRedirectRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RedirectRuleServiceServer is the server API for RedirectRuleService service.
+type RedirectRuleServiceServer interface {
+	ListRedirectRules(context.Context, *ListRedirectRulesRequest) (*ListRedirectRulesResponse, error)
+	SetRedirectRules(context.Context, *SetRedirectRulesRequest) (*SetRedirectRulesResponse, error)
+}
+
+// UnimplementedRedirectRuleServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRedirectRuleServiceServer struct {
+}
+
+func (*UnimplementedRedirectRuleServiceServer) ListRedirectRules(context.Context, *ListRedirectRulesRequest) (*ListRedirectRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRedirectRules not implemented")
+}
+func (*UnimplementedRedirectRuleServiceServer) SetRedirectRules(context.Context, *SetRedirectRulesRequest) (*SetRedirectRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRedirectRules not implemented")
+}
+
+func RegisterRedirectRuleServiceServer(s *grpc.Server, srv RedirectRuleServiceServer) {
+	s.RegisterService(&_RedirectRuleService_serviceDesc, srv)
+}
+
+func _RedirectRuleService_ListRedirectRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRedirectRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedirectRuleServiceServer).ListRedirectRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.RedirectRuleService/ListRedirectRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedirectRuleServiceServer).ListRedirectRules(ctx, req.(*ListRedirectRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RedirectRuleService_SetRedirectRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRedirectRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RedirectRuleServiceServer).SetRedirectRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.RedirectRuleService/SetRedirectRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RedirectRuleServiceServer).SetRedirectRules(ctx, req.(*SetRedirectRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RedirectRuleService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "anony.RedirectRuleService",
+	HandlerType: (*RedirectRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRedirectRules",
+			Handler:    _RedirectRuleService_ListRedirectRules_Handler,
+		},
+		{
+			MethodName: "SetRedirectRules",
+			Handler:    _RedirectRuleService_SetRedirectRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anony.proto",
+}
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TagServiceClient interface {
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	TagAnonyURLs(ctx context.Context, in *TagAnonyURLsRequest, opts ...grpc.CallOption) (*TagAnonyURLsResponse, error)
+	UntagAnonyURLs(ctx context.Context, in *TagAnonyURLsRequest, opts ...grpc.CallOption) (*TagAnonyURLsResponse, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, "/anony.TagService/CreateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type userServiceClient struct {
-	cc grpc.ClientConnInterface
+func (c *tagServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, "/anony.TagService/RenameTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
-	return &userServiceClient{cc}
+func (c *tagServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/anony.TagService/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
-	out := new(CreateUserResponse)
-	err := c.cc.Invoke(ctx, "/anony.UserService/CreateUser", in, out, opts...)
+func (c *tagServiceClient) ListTags(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/anony.TagService/ListTags", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *userServiceClient) LogInUser(ctx context.Context, in *LogInUserRequest, opts ...grpc.CallOption) (*LogInUserResponse, error) {
-	out := new(LogInUserResponse)
-	err := c.cc.Invoke(ctx, "/anony.UserService/LogInUser", in, out, opts...)
+func (c *tagServiceClient) TagAnonyURLs(ctx context.Context, in *TagAnonyURLsRequest, opts ...grpc.CallOption) (*TagAnonyURLsResponse, error) {
+	out := new(TagAnonyURLsResponse)
+	err := c.cc.Invoke(ctx, "/anony.TagService/TagAnonyURLs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// UserServiceServer is the server API for UserService service.
-type UserServiceServer interface {
-	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
-	// rpc UpdateUser (UpdateUserRequest) returns (UpdateUserResponse);
-	// rpc DeleteUser (DeleteUserRequest) returns (google.protobuf.Empty);
-	LogInUser(context.Context, *LogInUserRequest) (*LogInUserResponse, error)
+func (c *tagServiceClient) UntagAnonyURLs(ctx context.Context, in *TagAnonyURLsRequest, opts ...grpc.CallOption) (*TagAnonyURLsResponse, error) {
+	out := new(TagAnonyURLsResponse)
+	err := c.cc.Invoke(ctx, "/anony.TagService/UntagAnonyURLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedUserServiceServer struct {
+// TagServiceServer is the server API for TagService service.
+type TagServiceServer interface {
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*emptypb.Empty, error)
+	ListTags(context.Context, *emptypb.Empty) (*ListTagsResponse, error)
+	TagAnonyURLs(context.Context, *TagAnonyURLsRequest) (*TagAnonyURLsResponse, error)
+	UntagAnonyURLs(context.Context, *TagAnonyURLsRequest) (*TagAnonyURLsResponse, error)
 }
 
-func (*UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+// UnimplementedTagServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTagServiceServer struct {
 }
-func (*UnimplementedUserServiceServer) LogInUser(context.Context, *LogInUserRequest) (*LogInUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogInUser not implemented")
+
+func (*UnimplementedTagServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (*UnimplementedTagServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (*UnimplementedTagServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (*UnimplementedTagServiceServer) ListTags(context.Context, *emptypb.Empty) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (*UnimplementedTagServiceServer) TagAnonyURLs(context.Context, *TagAnonyURLsRequest) (*TagAnonyURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagAnonyURLs not implemented")
+}
+func (*UnimplementedTagServiceServer) UntagAnonyURLs(context.Context, *TagAnonyURLsRequest) (*TagAnonyURLsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntagAnonyURLs not implemented")
 }
 
-func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
-	s.RegisterService(&_UserService_serviceDesc, srv)
+func RegisterTagServiceServer(s *grpc.Server, srv TagServiceServer) {
+	s.RegisterService(&_TagService_serviceDesc, srv)
 }
 
-func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _TagService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUser(ctx, in)
+		return srv.(TagServiceServer).CreateTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anony.UserService/CreateUser",
+		FullMethod: "/anony.TagService/CreateTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(TagServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_LogInUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogInUserRequest)
+func _TagService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).LogInUser(ctx, in)
+		return srv.(TagServiceServer).RenameTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anony.UserService/LogInUser",
+		FullMethod: "/anony.TagService/RenameTag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).LogInUser(ctx, req.(*LogInUserRequest))
+		return srv.(TagServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _UserService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "anony.UserService",
-	HandlerType: (*UserServiceServer)(nil),
+func _TagService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.TagService/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.TagService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTags(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_TagAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagAnonyURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).TagAnonyURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.TagService/TagAnonyURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).TagAnonyURLs(ctx, req.(*TagAnonyURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UntagAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagAnonyURLsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UntagAnonyURLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.TagService/UntagAnonyURLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UntagAnonyURLs(ctx, req.(*TagAnonyURLsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TagService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "anony.TagService",
+	HandlerType: (*TagServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateUser",
-			Handler:    _UserService_CreateUser_Handler,
+			MethodName: "CreateTag",
+			Handler:    _TagService_CreateTag_Handler,
 		},
 		{
-			MethodName: "LogInUser",
-			Handler:    _UserService_LogInUser_Handler,
+			MethodName: "RenameTag",
+			Handler:    _TagService_RenameTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TagService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _TagService_ListTags_Handler,
+		},
+		{
+			MethodName: "TagAnonyURLs",
+			Handler:    _TagService_TagAnonyURLs_Handler,
+		},
+		{
+			MethodName: "UntagAnonyURLs",
+			Handler:    _TagService_UntagAnonyURLs_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "anony.proto",
 }
 
-// AnonyServiceClient is the client API for AnonyService service.
+// WebhookServiceClient is the client API for WebhookService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AnonyServiceClient interface {
-	CreateAnonyURL(ctx context.Context, in *CreateAnonyURLRequest, opts ...grpc.CallOption) (*CreateAnonyURLResponse, error)
-	UpdateAnonyURLStatus(ctx context.Context, in *UpdateAnonyURLStatusRequest, opts ...grpc.CallOption) (*UpdateAnonyURLStatusResponse, error)
-	ListAnonyURLs(ctx context.Context, in *ListAnonyURLsRequest, opts ...grpc.CallOption) (*ListAnonyURLsResponse, error)
-	CountAnonyURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CountAnonyURLsResponse, error)
+type WebhookServiceClient interface {
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	ListDeadWebhookDeliveries(ctx context.Context, in *ListDeadWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListDeadWebhookDeliveriesResponse, error)
+	RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*RedeliverWebhookDeliveryResponse, error)
 }
 
-type anonyServiceClient struct {
+type webhookServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewAnonyServiceClient(cc grpc.ClientConnInterface) AnonyServiceClient {
-	return &anonyServiceClient{cc}
+func NewWebhookServiceClient(cc grpc.ClientConnInterface) WebhookServiceClient {
+	return &webhookServiceClient{cc}
 }
 
-func (c *anonyServiceClient) CreateAnonyURL(ctx context.Context, in *CreateAnonyURLRequest, opts ...grpc.CallOption) (*CreateAnonyURLResponse, error) {
-	out := new(CreateAnonyURLResponse)
-	err := c.cc.Invoke(ctx, "/anony.AnonyService/CreateAnonyURL", in, out, opts...)
+func (c *webhookServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/anony.WebhookService/CreateWebhook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *anonyServiceClient) UpdateAnonyURLStatus(ctx context.Context, in *UpdateAnonyURLStatusRequest, opts ...grpc.CallOption) (*UpdateAnonyURLStatusResponse, error) {
-	out := new(UpdateAnonyURLStatusResponse)
-	err := c.cc.Invoke(ctx, "/anony.AnonyService/UpdateAnonyURLStatus", in, out, opts...)
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/anony.WebhookService/DeleteWebhook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *anonyServiceClient) ListAnonyURLs(ctx context.Context, in *ListAnonyURLsRequest, opts ...grpc.CallOption) (*ListAnonyURLsResponse, error) {
-	out := new(ListAnonyURLsResponse)
-	err := c.cc.Invoke(ctx, "/anony.AnonyService/ListAnonyURLs", in, out, opts...)
+func (c *webhookServiceClient) ListWebhooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/anony.WebhookService/ListWebhooks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *anonyServiceClient) CountAnonyURLs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CountAnonyURLsResponse, error) {
-	out := new(CountAnonyURLsResponse)
-	err := c.cc.Invoke(ctx, "/anony.AnonyService/CountAnonyURLs", in, out, opts...)
+func (c *webhookServiceClient) ListDeadWebhookDeliveries(ctx context.Context, in *ListDeadWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListDeadWebhookDeliveriesResponse, error) {
+	out := new(ListDeadWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/anony.WebhookService/ListDeadWebhookDeliveries", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AnonyServiceServer is the server API for AnonyService service.
-type AnonyServiceServer interface {
-	CreateAnonyURL(context.Context, *CreateAnonyURLRequest) (*CreateAnonyURLResponse, error)
-	UpdateAnonyURLStatus(context.Context, *UpdateAnonyURLStatusRequest) (*UpdateAnonyURLStatusResponse, error)
-	ListAnonyURLs(context.Context, *ListAnonyURLsRequest) (*ListAnonyURLsResponse, error)
-	CountAnonyURLs(context.Context, *emptypb.Empty) (*CountAnonyURLsResponse, error)
+func (c *webhookServiceClient) RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*RedeliverWebhookDeliveryResponse, error) {
+	out := new(RedeliverWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/anony.WebhookService/RedeliverWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedAnonyServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAnonyServiceServer struct {
+// WebhookServiceServer is the server API for WebhookService service.
+type WebhookServiceServer interface {
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error)
+	ListWebhooks(context.Context, *emptypb.Empty) (*ListWebhooksResponse, error)
+	ListDeadWebhookDeliveries(context.Context, *ListDeadWebhookDeliveriesRequest) (*ListDeadWebhookDeliveriesResponse, error)
+	RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*RedeliverWebhookDeliveryResponse, error)
 }
 
-func (*UnimplementedAnonyServiceServer) CreateAnonyURL(context.Context, *CreateAnonyURLRequest) (*CreateAnonyURLResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAnonyURL not implemented")
+// UnimplementedWebhookServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWebhookServiceServer struct {
 }
-func (*UnimplementedAnonyServiceServer) UpdateAnonyURLStatus(context.Context, *UpdateAnonyURLStatusRequest) (*UpdateAnonyURLStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAnonyURLStatus not implemented")
+
+func (*UnimplementedWebhookServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
 }
-func (*UnimplementedAnonyServiceServer) ListAnonyURLs(context.Context, *ListAnonyURLsRequest) (*ListAnonyURLsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAnonyURLs not implemented")
+func (*UnimplementedWebhookServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
 }
-func (*UnimplementedAnonyServiceServer) CountAnonyURLs(context.Context, *emptypb.Empty) (*CountAnonyURLsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CountAnonyURLs not implemented")
+func (*UnimplementedWebhookServiceServer) ListWebhooks(context.Context, *emptypb.Empty) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (*UnimplementedWebhookServiceServer) ListDeadWebhookDeliveries(context.Context, *ListDeadWebhookDeliveriesRequest) (*ListDeadWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadWebhookDeliveries not implemented")
+}
+func (*UnimplementedWebhookServiceServer) RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*RedeliverWebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhookDelivery not implemented")
 }
 
-func RegisterAnonyServiceServer(s *grpc.Server, srv AnonyServiceServer) {
-	s.RegisterService(&_AnonyService_serviceDesc, srv)
+func RegisterWebhookServiceServer(s *grpc.Server, srv WebhookServiceServer) {
+	s.RegisterService(&_WebhookService_serviceDesc, srv)
 }
 
-func _AnonyService_CreateAnonyURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAnonyURLRequest)
+func _WebhookService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnonyServiceServer).CreateAnonyURL(ctx, in)
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anony.AnonyService/CreateAnonyURL",
+		FullMethod: "/anony.WebhookService/CreateWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnonyServiceServer).CreateAnonyURL(ctx, req.(*CreateAnonyURLRequest))
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnonyService_UpdateAnonyURLStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAnonyURLStatusRequest)
+func _WebhookService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnonyServiceServer).UpdateAnonyURLStatus(ctx, in)
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anony.AnonyService/UpdateAnonyURLStatus",
+		FullMethod: "/anony.WebhookService/DeleteWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnonyServiceServer).UpdateAnonyURLStatus(ctx, req.(*UpdateAnonyURLStatusRequest))
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnonyService_ListAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAnonyURLsRequest)
+func _WebhookService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnonyServiceServer).ListAnonyURLs(ctx, in)
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anony.AnonyService/ListAnonyURLs",
+		FullMethod: "/anony.WebhookService/ListWebhooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnonyServiceServer).ListAnonyURLs(ctx, req.(*ListAnonyURLsRequest))
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AnonyService_CountAnonyURLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _WebhookService_ListDeadWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AnonyServiceServer).CountAnonyURLs(ctx, in)
+		return srv.(WebhookServiceServer).ListDeadWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anony.AnonyService/CountAnonyURLs",
+		FullMethod: "/anony.WebhookService/ListDeadWebhookDeliveries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AnonyServiceServer).CountAnonyURLs(ctx, req.(*emptypb.Empty))
+		return srv.(WebhookServiceServer).ListDeadWebhookDeliveries(ctx, req.(*ListDeadWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _AnonyService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "anony.AnonyService",
-	HandlerType: (*AnonyServiceServer)(nil),
+func _WebhookService_RedeliverWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).RedeliverWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.WebhookService/RedeliverWebhookDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).RedeliverWebhookDelivery(ctx, req.(*RedeliverWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WebhookService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "anony.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateAnonyURL",
-			Handler:    _AnonyService_CreateAnonyURL_Handler,
+			MethodName: "CreateWebhook",
+			Handler:    _WebhookService_CreateWebhook_Handler,
 		},
 		{
-			MethodName: "UpdateAnonyURLStatus",
-			Handler:    _AnonyService_UpdateAnonyURLStatus_Handler,
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookService_DeleteWebhook_Handler,
 		},
 		{
-			MethodName: "ListAnonyURLs",
-			Handler:    _AnonyService_ListAnonyURLs_Handler,
+			MethodName: "ListWebhooks",
+			Handler:    _WebhookService_ListWebhooks_Handler,
 		},
 		{
-			MethodName: "CountAnonyURLs",
-			Handler:    _AnonyService_CountAnonyURLs_Handler,
+			MethodName: "ListDeadWebhookDeliveries",
+			Handler:    _WebhookService_ListDeadWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhookDelivery",
+			Handler:    _WebhookService_RedeliverWebhookDelivery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "anony.proto",
+}
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditServiceClient interface {
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/anony.AuditService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+type AuditServiceServer interface {
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+}
+
+// UnimplementedAuditServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAuditServiceServer struct {
+}
+
+func (*UnimplementedAuditServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+
+func RegisterAuditServiceServer(s *grpc.Server, srv AuditServiceServer) {
+	s.RegisterService(&_AuditService_serviceDesc, srv)
+}
+
+func _AuditService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anony.AuditService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "anony.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AuditService_ListAuditEvents_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -7,7 +7,10 @@ import (
 	fmt "fmt"
 	math "math"
 	proto "github.com/golang/protobuf/proto"
+	_ "github.com/mwitkow/go-proto-validators"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	regexp "regexp"
 	github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-validators"
 )
 
@@ -46,7 +49,45 @@ func (this *LogInUserResponse) Validate() error {
 	}
 	return nil
 }
+func (this *UTM) Validate() error {
+	return nil
+}
+
+var _regex_CreateAnonyURLRequest_OriginalUrl = regexp.MustCompile(`^(?i)https?://`)
+
 func (this *CreateAnonyURLRequest) Validate() error {
+	if !_regex_CreateAnonyURLRequest_OriginalUrl.MatchString(this.OriginalUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("OriginalUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^(?i)https?://"`, this.OriginalUrl))
+	}
+	if !(len(this.OriginalUrl) < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("OriginalUrl", fmt.Errorf(`value '%v' must have a length smaller than '2049'`, this.OriginalUrl))
+	}
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	for _, item := range this.Variants {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Variants", err)
+			}
+		}
+	}
+	if this.ActiveFrom != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveFrom); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveFrom", err)
+		}
+	}
+	if this.ActiveUntil != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveUntil); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveUntil", err)
+		}
+	}
+	if !(len(this.FallbackUrl) < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("FallbackUrl", fmt.Errorf(`value '%v' must have a length smaller than '2049'`, this.FallbackUrl))
+	}
+	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
 func (this *CreateAnonyURLResponse) Validate() error {
@@ -58,6 +99,11 @@ func (this *CreateAnonyURLResponse) Validate() error {
 	return nil
 }
 func (this *UpdateAnonyURLStatusRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
 	return nil
 }
 func (this *UpdateAnonyURLStatusResponse) Validate() error {
@@ -69,6 +115,215 @@ func (this *UpdateAnonyURLStatusResponse) Validate() error {
 	return nil
 }
 func (this *AnonyURL) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	if this.ActiveFrom != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveFrom); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveFrom", err)
+		}
+	}
+	if this.ActiveUntil != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveUntil); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveUntil", err)
+		}
+	}
+	if this.LastCheckedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastCheckedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastCheckedAt", err)
+		}
+	}
+	if this.Preview != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Preview); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Preview", err)
+		}
+	}
+	for _, item := range this.Tags {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+			}
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *LinkPreview) Validate() error {
+	if this.FetchedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FetchedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FetchedAt", err)
+		}
+	}
+	return nil
+}
+func (this *RefreshAnonyURLMetadataRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	return nil
+}
+func (this *RefreshAnonyURLMetadataResponse) Validate() error {
+	if this.AnonyUrl != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.AnonyUrl); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("AnonyUrl", err)
+		}
+	}
+	return nil
+}
+func (this *UpdateAnonyURLMetadataRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *UpdateAnonyURLMetadataResponse) Validate() error {
+	if this.AnonyUrl != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.AnonyUrl); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("AnonyUrl", err)
+		}
+	}
+	return nil
+}
+func (this *GetAnonyURLQRCodeRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	if !(this.ImageSize > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("ImageSize", fmt.Errorf(`value '%v' must be greater than '-1'`, this.ImageSize))
+	}
+	if !(this.ImageSize < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("ImageSize", fmt.Errorf(`value '%v' must be less than '2049'`, this.ImageSize))
+	}
+	if !(this.Margin > -2) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Margin", fmt.Errorf(`value '%v' must be greater than '-2'`, this.Margin))
+	}
+	if !(this.Margin < 17) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Margin", fmt.Errorf(`value '%v' must be less than '17'`, this.Margin))
+	}
+	return nil
+}
+func (this *GetAnonyURLQRCodeResponse) Validate() error {
+	return nil
+}
+func (this *SetAnonyURLScheduleRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	if this.ActiveFrom != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveFrom); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveFrom", err)
+		}
+	}
+	if this.ActiveUntil != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveUntil); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveUntil", err)
+		}
+	}
+	if !(len(this.FallbackUrl) < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("FallbackUrl", fmt.Errorf(`value '%v' must have a length smaller than '2049'`, this.FallbackUrl))
+	}
+	return nil
+}
+func (this *SetAnonyURLScheduleResponse) Validate() error {
+	if this.AnonyUrl != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.AnonyUrl); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("AnonyUrl", err)
+		}
+	}
+	return nil
+}
+func (this *Variant) Validate() error {
+	if !(len(this.Destination) < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Destination", fmt.Errorf(`value '%v' must have a length smaller than '2049'`, this.Destination))
+	}
+	return nil
+}
+func (this *SetAnonyURLVariantsRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	for _, item := range this.Variants {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Variants", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SetAnonyURLVariantsResponse) Validate() error {
+	for _, item := range this.Variants {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Variants", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GetAnonyURLStatsRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	return nil
+}
+func (this *GetAnonyURLStatsResponse) Validate() error {
+	if this.AnonyUrl != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.AnonyUrl); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("AnonyUrl", err)
+		}
+	}
+	for _, item := range this.Variants {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Variants", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_CreateCampaignRequest_OriginalUrl = regexp.MustCompile(`^(?i)https?://`)
+
+func (this *CreateCampaignRequest) Validate() error {
+	if !_regex_CreateCampaignRequest_OriginalUrl.MatchString(this.OriginalUrl) {
+		return github_com_mwitkow_go_proto_validators.FieldError("OriginalUrl", fmt.Errorf(`value '%v' must be a string conforming to regex "^(?i)https?://"`, this.OriginalUrl))
+	}
+	if !(len(this.OriginalUrl) < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("OriginalUrl", fmt.Errorf(`value '%v' must have a length smaller than '2049'`, this.OriginalUrl))
+	}
+	for _, item := range this.Channels {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Channels", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CreateCampaignResponse) Validate() error {
+	for _, item := range this.AnonyUrls {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("AnonyUrls", err)
+			}
+		}
+	}
 	return nil
 }
 func (this *ListAnonyURLsRequest) Validate() error {
@@ -84,6 +339,332 @@ func (this *ListAnonyURLsResponse) Validate() error {
 	}
 	return nil
 }
+func (this *SearchAnonyURLsRequest) Validate() error {
+	if this.Query == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Query", fmt.Errorf(`value '%v' must not be an empty string`, this.Query))
+	}
+	if !(len(this.Query) < 257) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Query", fmt.Errorf(`value '%v' must have a length smaller than '257'`, this.Query))
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
+	if !(this.Offset > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Offset", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Offset))
+	}
+	return nil
+}
+func (this *SearchAnonyURLsResponse) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AnonyURLSearchResult) Validate() error {
+	if this.AnonyUrl != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.AnonyUrl); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("AnonyUrl", err)
+		}
+	}
+	return nil
+}
+func (this *ListBrokenAnonyURLsResponse) Validate() error {
+	for _, item := range this.AnonyUrls {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("AnonyUrls", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CountAnonyURLsRequest) Validate() error {
+	return nil
+}
 func (this *CountAnonyURLsResponse) Validate() error {
+	if this.Quota != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Quota); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Quota", err)
+		}
+	}
+	return nil
+}
+func (this *Quota) Validate() error {
+	return nil
+}
+func (this *Domain) Validate() error {
+	return nil
+}
+func (this *RegisterDomainRequest) Validate() error {
+	return nil
+}
+func (this *RegisterDomainResponse) Validate() error {
+	if this.Domain != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Domain); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Domain", err)
+		}
+	}
+	return nil
+}
+func (this *VerifyDomainRequest) Validate() error {
+	return nil
+}
+func (this *VerifyDomainResponse) Validate() error {
+	if this.Domain != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Domain); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Domain", err)
+		}
+	}
+	return nil
+}
+func (this *ListDomainsResponse) Validate() error {
+	for _, item := range this.Domains {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Domains", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RedirectRule) Validate() error {
+	if this.ActiveFrom != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveFrom); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveFrom", err)
+		}
+	}
+	if this.ActiveUntil != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ActiveUntil); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ActiveUntil", err)
+		}
+	}
+	if !(len(this.Destination) < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Destination", fmt.Errorf(`value '%v' must have a length smaller than '2049'`, this.Destination))
+	}
+	return nil
+}
+func (this *ListRedirectRulesRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	return nil
+}
+func (this *ListRedirectRulesResponse) Validate() error {
+	for _, item := range this.Rules {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Rules", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SetRedirectRulesRequest) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	for _, item := range this.Rules {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Rules", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SetRedirectRulesResponse) Validate() error {
+	for _, item := range this.Rules {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Rules", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Tag) Validate() error {
+	return nil
+}
+func (this *CreateTagRequest) Validate() error {
+	if !(len(this.Name) < 256) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must have a length smaller than '256'`, this.Name))
+	}
+	return nil
+}
+func (this *CreateTagResponse) Validate() error {
+	if this.Tag != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tag); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tag", err)
+		}
+	}
+	return nil
+}
+func (this *RenameTagRequest) Validate() error {
+	if !(len(this.Name) < 256) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must have a length smaller than '256'`, this.Name))
+	}
+	return nil
+}
+func (this *RenameTagResponse) Validate() error {
+	if this.Tag != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tag); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tag", err)
+		}
+	}
+	return nil
+}
+func (this *DeleteTagRequest) Validate() error {
+	return nil
+}
+func (this *ListTagsResponse) Validate() error {
+	for _, item := range this.Tags {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Tags", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AnonyURLKey) Validate() error {
+	if this.Utm != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Utm); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Utm", err)
+		}
+	}
+	return nil
+}
+func (this *TagAnonyURLsRequest) Validate() error {
+	for _, item := range this.Links {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Links", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *TagAnonyURLsResponse) Validate() error {
+	return nil
+}
+func (this *Webhook) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	return nil
+}
+func (this *WebhookDelivery) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	return nil
+}
+
+var _regex_CreateWebhookRequest_Url = regexp.MustCompile(`^(?i)https?://`)
+
+func (this *CreateWebhookRequest) Validate() error {
+	if !_regex_CreateWebhookRequest_Url.MatchString(this.Url) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Url", fmt.Errorf(`value '%v' must be a string conforming to regex "^(?i)https?://"`, this.Url))
+	}
+	if !(len(this.Url) < 2049) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Url", fmt.Errorf(`value '%v' must have a length smaller than '2049'`, this.Url))
+	}
+	return nil
+}
+func (this *CreateWebhookResponse) Validate() error {
+	if this.Webhook != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Webhook); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Webhook", err)
+		}
+	}
+	return nil
+}
+func (this *DeleteWebhookRequest) Validate() error {
+	return nil
+}
+func (this *ListWebhooksResponse) Validate() error {
+	for _, item := range this.Webhooks {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Webhooks", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ListDeadWebhookDeliveriesRequest) Validate() error {
+	return nil
+}
+func (this *ListDeadWebhookDeliveriesResponse) Validate() error {
+	for _, item := range this.Deliveries {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Deliveries", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RedeliverWebhookDeliveryRequest) Validate() error {
+	return nil
+}
+func (this *RedeliverWebhookDeliveryResponse) Validate() error {
+	if this.Delivery != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Delivery); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Delivery", err)
+		}
+	}
+	return nil
+}
+func (this *AuditEvent) Validate() error {
+	if this.CreatedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+		}
+	}
+	return nil
+}
+func (this *ListAuditEventsRequest) Validate() error {
+	if this.Since != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Since); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Since", err)
+		}
+	}
+	if this.Until != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Until); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Until", err)
+		}
+	}
+	if !(this.Limit > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Limit))
+	}
+	if !(this.Limit < 501) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Limit", fmt.Errorf(`value '%v' must be less than '501'`, this.Limit))
+	}
+	if !(this.Offset > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Offset", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Offset))
+	}
+	return nil
+}
+func (this *ListAuditEventsResponse) Validate() error {
+	for _, item := range this.Events {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Events", err)
+			}
+		}
+	}
 	return nil
 }
@@ -1,6 +1,9 @@
 package testutils
 
-import "github.com/Tatsuemon/anony/usecase/dto"
+import (
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/usecase/dto"
+)
 
 // UserAnonyURLAccessorMock is mock of UserAnonyURLAccessor
 type UserAnonyURLAccessorMock struct {
@@ -10,3 +13,20 @@ type UserAnonyURLAccessorMock struct {
 func (m UserAnonyURLAccessorMock) CountAnonyURLByUser(userID string) (*dto.AnonyURLCountByUser, error) {
 	return m.FakeCountAnonyURLByUser(userID)
 }
+
+// TaggedAnonyURLAccessorMock is mock of TaggedAnonyURLAccessor
+type TaggedAnonyURLAccessorMock struct {
+	FakeFindAnonyURLsByTags       func(userID string, status int64, tagGroups [][]string) ([]*model.AnonyURL, error)
+	FakeCountAnonyURLByUserInTags func(userID string, tagGroups [][]string) (*dto.AnonyURLCountByUser, error)
+	FakeFindTagIDsByUserID        func(userID string) (map[string][]string, error)
+}
+
+func (m TaggedAnonyURLAccessorMock) FindAnonyURLsByTags(userID string, status int64, tagGroups [][]string) ([]*model.AnonyURL, error) {
+	return m.FakeFindAnonyURLsByTags(userID, status, tagGroups)
+}
+func (m TaggedAnonyURLAccessorMock) CountAnonyURLByUserInTags(userID string, tagGroups [][]string) (*dto.AnonyURLCountByUser, error) {
+	return m.FakeCountAnonyURLByUserInTags(userID, tagGroups)
+}
+func (m TaggedAnonyURLAccessorMock) FindTagIDsByUserID(userID string) (map[string][]string, error) {
+	return m.FakeFindTagIDsByUserID(userID)
+}
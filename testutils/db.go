@@ -96,11 +96,11 @@ type urls struct {
 func InsertURLData() {
 	InsertUserData()
 	urls := []urls{
-		{ID: "id1", Original: "original1", Short: "short1", Status: 1, UserID: "id1"},
-		{ID: "id2", Original: "original2", Short: "short2", Status: 1, UserID: "id1"},
-		{ID: "id3", Original: "original3", Short: "short3", Status: 2, UserID: "id1"},
-		{ID: "id4", Original: "original4", Short: "short4", Status: 2, UserID: "id1"},
-		{ID: "id5", Original: "original5", Short: "short5", Status: 2, UserID: "id1"},
+		{ID: "id1", Original: "http://localhost-test/original1", Short: "short1", Status: 1, UserID: "id1"},
+		{ID: "id2", Original: "http://localhost-test/original2", Short: "short2", Status: 1, UserID: "id1"},
+		{ID: "id3", Original: "http://localhost-test/original3", Short: "short3", Status: 2, UserID: "id1"},
+		{ID: "id4", Original: "http://localhost-test/original4", Short: "short4", Status: 2, UserID: "id1"},
+		{ID: "id5", Original: "http://localhost-test/original5", Short: "short5", Status: 2, UserID: "id1"},
 	}
 	for _, p := range urls {
 		_, err := testDB.DB.Exec("INSERT INTO urls (id, original, short, status, user_id) values (?, ?, ?, ?, ?)", p.ID, p.Original, p.Short, p.Status, p.UserID)
@@ -2,6 +2,7 @@ package testutils
 
 import (
 	"context"
+	"time"
 
 	"github.com/Tatsuemon/anony/domain/model"
 )
@@ -52,11 +53,22 @@ type AnonyURLRepoMock struct {
 	FakeFindByID               func(id string) (*model.AnonyURL, error)
 	FakeFindByUserID           func(userID string) ([]*model.AnonyURL, error)
 	FakeFindByUserIDWithStatus func(userID string, status int64) ([]*model.AnonyURL, error)
-	FakeFindByOriginalInUser   func(original string, userID string) (*model.AnonyURL, error)
-	FakeFindByAnonyURL         func(anonyURL string) (*model.AnonyURL, error)
-	FakeGetIDByOriginalUser    func(original, userID string) (string, error)
+	FakeFindByOriginalInUser   func(original string, utm model.UTM, userID string) (*model.AnonyURL, error)
+	FakeFindByAnonyURL         func(domainID, anonyURL string) (*model.AnonyURL, error)
+	FakeGetIDByOriginalUser    func(original string, utm model.UTM, userID string) (string, error)
 	FakeSave                   func(ctx context.Context, an *model.AnonyURL, userID string) error
 	FakeUpdateStatus           func(ctx context.Context, id string, status int64) error
+	FakeIncrementClicks        func(ctx context.Context, id string) error
+	FakeUpdateSchedule         func(ctx context.Context, id string, activeFrom, activeUntil *time.Time, fallback string) error
+	FakeFindByScheduleBoundary func(from, to time.Time) ([]*model.AnonyURL, error)
+	FakeFindPage               func(afterID string, limit int) ([]*model.AnonyURL, error)
+	FakeUpdateBlockedReason    func(ctx context.Context, id string, reason string) error
+	FakeUpdateHealth           func(ctx context.Context, id string, h *model.LinkHealth) error
+	FakeFindBrokenByUserID     func(userID string) ([]*model.AnonyURL, error)
+	FakeUpdatePreview          func(ctx context.Context, id string, p *model.LinkPreview) error
+	FakeGetUserIDByID          func(id string) (string, error)
+	FakeUpdateAnnotation       func(ctx context.Context, id string, title, notes string, metadata map[string]string) error
+	FakeFindUpdatedWithin      func(d time.Duration) ([]*model.AnonyURL, error)
 }
 
 func (a AnonyURLRepoMock) FindByID(id string) (*model.AnonyURL, error) {
@@ -68,18 +80,234 @@ func (a AnonyURLRepoMock) FindByUserID(userID string) ([]*model.AnonyURL, error)
 func (a AnonyURLRepoMock) FindByUserIDWithStatus(userID string, status int64) ([]*model.AnonyURL, error) {
 	return a.FakeFindByUserIDWithStatus(userID, status)
 }
-func (a AnonyURLRepoMock) FindByOriginalInUser(original string, userID string) (*model.AnonyURL, error) {
-	return a.FakeFindByOriginalInUser(original, userID)
+func (a AnonyURLRepoMock) FindByOriginalInUser(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+	return a.FakeFindByOriginalInUser(original, utm, userID)
 }
-func (a AnonyURLRepoMock) FindByAnonyURL(anonyURL string) (*model.AnonyURL, error) {
-	return a.FakeFindByAnonyURL(anonyURL)
+func (a AnonyURLRepoMock) FindByAnonyURL(domainID, anonyURL string) (*model.AnonyURL, error) {
+	return a.FakeFindByAnonyURL(domainID, anonyURL)
 }
-func (a AnonyURLRepoMock) GetIDByOriginalUser(original, userID string) (string, error) {
-	return a.FakeGetIDByOriginalUser(original, userID)
+func (a AnonyURLRepoMock) GetIDByOriginalUser(original string, utm model.UTM, userID string) (string, error) {
+	return a.FakeGetIDByOriginalUser(original, utm, userID)
 }
 func (a AnonyURLRepoMock) Save(ctx context.Context, an *model.AnonyURL, userID string) error {
 	return a.FakeSave(ctx, an, userID)
 }
+func (a AnonyURLRepoMock) IncrementClicks(ctx context.Context, id string) error {
+	return a.FakeIncrementClicks(ctx, id)
+}
+func (a AnonyURLRepoMock) UpdateSchedule(ctx context.Context, id string, activeFrom, activeUntil *time.Time, fallback string) error {
+	return a.FakeUpdateSchedule(ctx, id, activeFrom, activeUntil, fallback)
+}
+func (a AnonyURLRepoMock) FindByScheduleBoundary(from, to time.Time) ([]*model.AnonyURL, error) {
+	return a.FakeFindByScheduleBoundary(from, to)
+}
+func (a AnonyURLRepoMock) FindPage(afterID string, limit int) ([]*model.AnonyURL, error) {
+	return a.FakeFindPage(afterID, limit)
+}
+func (a AnonyURLRepoMock) UpdateBlockedReason(ctx context.Context, id string, reason string) error {
+	return a.FakeUpdateBlockedReason(ctx, id, reason)
+}
+func (a AnonyURLRepoMock) UpdateHealth(ctx context.Context, id string, h *model.LinkHealth) error {
+	return a.FakeUpdateHealth(ctx, id, h)
+}
+func (a AnonyURLRepoMock) FindBrokenByUserID(userID string) ([]*model.AnonyURL, error) {
+	return a.FakeFindBrokenByUserID(userID)
+}
+func (a AnonyURLRepoMock) UpdatePreview(ctx context.Context, id string, p *model.LinkPreview) error {
+	return a.FakeUpdatePreview(ctx, id, p)
+}
+func (a AnonyURLRepoMock) GetUserIDByID(id string) (string, error) {
+	return a.FakeGetUserIDByID(id)
+}
+func (a AnonyURLRepoMock) UpdateAnnotation(ctx context.Context, id string, title, notes string, metadata map[string]string) error {
+	return a.FakeUpdateAnnotation(ctx, id, title, notes, metadata)
+}
+func (a AnonyURLRepoMock) FindUpdatedWithin(d time.Duration) ([]*model.AnonyURL, error) {
+	return a.FakeFindUpdatedWithin(d)
+}
 func (a AnonyURLRepoMock) UpdateStatus(ctx context.Context, id string, status int64) error {
 	return a.FakeUpdateStatus(ctx, id, status)
 }
+
+// DomainRepoMock is mock of domainRepository
+type DomainRepoMock struct {
+	FakeFindByID       func(id string) (*model.Domain, error)
+	FakeFindByName     func(name string) (*model.Domain, error)
+	FakeFindByUserID   func(userID string) ([]*model.Domain, error)
+	FakeFindVerified   func() ([]*model.Domain, error)
+	FakeSave           func(ctx context.Context, d *model.Domain) error
+	FakeUpdateVerified func(ctx context.Context, id string, verified bool) error
+}
+
+func (m DomainRepoMock) FindByID(id string) (*model.Domain, error) {
+	return m.FakeFindByID(id)
+}
+func (m DomainRepoMock) FindByName(name string) (*model.Domain, error) {
+	return m.FakeFindByName(name)
+}
+func (m DomainRepoMock) FindByUserID(userID string) ([]*model.Domain, error) {
+	return m.FakeFindByUserID(userID)
+}
+func (m DomainRepoMock) FindVerified() ([]*model.Domain, error) {
+	return m.FakeFindVerified()
+}
+func (m DomainRepoMock) Save(ctx context.Context, d *model.Domain) error {
+	return m.FakeSave(ctx, d)
+}
+func (m DomainRepoMock) UpdateVerified(ctx context.Context, id string, verified bool) error {
+	return m.FakeUpdateVerified(ctx, id, verified)
+}
+
+// RedirectRuleRepoMock is mock of redirectRuleRepository
+type RedirectRuleRepoMock struct {
+	FakeFindByAnonyURLID    func(anonyURLID string) ([]*model.RedirectRule, error)
+	FakeReplaceByAnonyURLID func(ctx context.Context, anonyURLID string, rules []*model.RedirectRule) error
+}
+
+func (m RedirectRuleRepoMock) FindByAnonyURLID(anonyURLID string) ([]*model.RedirectRule, error) {
+	return m.FakeFindByAnonyURLID(anonyURLID)
+}
+func (m RedirectRuleRepoMock) ReplaceByAnonyURLID(ctx context.Context, anonyURLID string, rules []*model.RedirectRule) error {
+	return m.FakeReplaceByAnonyURLID(ctx, anonyURLID, rules)
+}
+
+// VariantRepoMock is mock of variantRepository
+type VariantRepoMock struct {
+	FakeFindByAnonyURLID    func(anonyURLID string) ([]*model.Variant, error)
+	FakeReplaceByAnonyURLID func(ctx context.Context, anonyURLID string, variants []*model.Variant) error
+	FakeIncrementClicks     func(ctx context.Context, id string) error
+}
+
+func (m VariantRepoMock) FindByAnonyURLID(anonyURLID string) ([]*model.Variant, error) {
+	return m.FakeFindByAnonyURLID(anonyURLID)
+}
+func (m VariantRepoMock) ReplaceByAnonyURLID(ctx context.Context, anonyURLID string, variants []*model.Variant) error {
+	return m.FakeReplaceByAnonyURLID(ctx, anonyURLID, variants)
+}
+func (m VariantRepoMock) IncrementClicks(ctx context.Context, id string) error {
+	return m.FakeIncrementClicks(ctx, id)
+}
+
+// TagRepoMock is mock of tagRepository
+type TagRepoMock struct {
+	FakeFindByID        func(id string) (*model.Tag, error)
+	FakeFindByUserID    func(userID string) (model.Tags, error)
+	FakeSave            func(ctx context.Context, t *model.Tag) error
+	FakeUpdateName      func(ctx context.Context, id string, name string) error
+	FakeDelete          func(ctx context.Context, id string) error
+	FakeAddAnonyURLs    func(ctx context.Context, tagIDs []string, anonyURLIDs []string) error
+	FakeRemoveAnonyURLs func(ctx context.Context, tagIDs []string, anonyURLIDs []string) error
+}
+
+func (m TagRepoMock) FindByID(id string) (*model.Tag, error) {
+	return m.FakeFindByID(id)
+}
+func (m TagRepoMock) FindByUserID(userID string) (model.Tags, error) {
+	return m.FakeFindByUserID(userID)
+}
+func (m TagRepoMock) Save(ctx context.Context, t *model.Tag) error {
+	return m.FakeSave(ctx, t)
+}
+func (m TagRepoMock) UpdateName(ctx context.Context, id string, name string) error {
+	return m.FakeUpdateName(ctx, id, name)
+}
+func (m TagRepoMock) Delete(ctx context.Context, id string) error {
+	return m.FakeDelete(ctx, id)
+}
+func (m TagRepoMock) AddAnonyURLs(ctx context.Context, tagIDs []string, anonyURLIDs []string) error {
+	return m.FakeAddAnonyURLs(ctx, tagIDs, anonyURLIDs)
+}
+func (m TagRepoMock) RemoveAnonyURLs(ctx context.Context, tagIDs []string, anonyURLIDs []string) error {
+	return m.FakeRemoveAnonyURLs(ctx, tagIDs, anonyURLIDs)
+}
+
+// WebhookRepoMock is mock of webhookRepository
+type WebhookRepoMock struct {
+	FakeFindByID     func(id string) (*model.Webhook, error)
+	FakeFindByUserID func(userID string) ([]*model.Webhook, error)
+	FakeSave         func(ctx context.Context, w *model.Webhook) error
+	FakeDelete       func(ctx context.Context, id string) error
+}
+
+func (m WebhookRepoMock) FindByID(id string) (*model.Webhook, error) {
+	return m.FakeFindByID(id)
+}
+func (m WebhookRepoMock) FindByUserID(userID string) ([]*model.Webhook, error) {
+	return m.FakeFindByUserID(userID)
+}
+func (m WebhookRepoMock) Save(ctx context.Context, w *model.Webhook) error {
+	return m.FakeSave(ctx, w)
+}
+func (m WebhookRepoMock) Delete(ctx context.Context, id string) error {
+	return m.FakeDelete(ctx, id)
+}
+
+// WebhookDeliveryRepoMock is mock of webhookDeliveryRepository
+type WebhookDeliveryRepoMock struct {
+	FakeFindByID               func(id string) (*model.WebhookDelivery, error)
+	FakeSaveAll                func(ctx context.Context, ds []*model.WebhookDelivery) error
+	FakeClaimDueWebhooks       func(ctx context.Context, now, until time.Time, limit int) ([]string, error)
+	FakeReleaseWebhook         func(ctx context.Context, webhookID string, now time.Time) error
+	FakeFindPendingByWebhookID func(webhookID string, limit int) ([]*model.WebhookDelivery, error)
+	FakeFindDeadByWebhookID    func(webhookID string) ([]*model.WebhookDelivery, error)
+	FakeUpdateAttempt          func(ctx context.Context, d *model.WebhookDelivery) error
+}
+
+func (m WebhookDeliveryRepoMock) FindByID(id string) (*model.WebhookDelivery, error) {
+	return m.FakeFindByID(id)
+}
+func (m WebhookDeliveryRepoMock) SaveAll(ctx context.Context, ds []*model.WebhookDelivery) error {
+	return m.FakeSaveAll(ctx, ds)
+}
+func (m WebhookDeliveryRepoMock) ClaimDueWebhooks(ctx context.Context, now, until time.Time, limit int) ([]string, error) {
+	return m.FakeClaimDueWebhooks(ctx, now, until, limit)
+}
+func (m WebhookDeliveryRepoMock) ReleaseWebhook(ctx context.Context, webhookID string, now time.Time) error {
+	return m.FakeReleaseWebhook(ctx, webhookID, now)
+}
+func (m WebhookDeliveryRepoMock) FindPendingByWebhookID(webhookID string, limit int) ([]*model.WebhookDelivery, error) {
+	return m.FakeFindPendingByWebhookID(webhookID, limit)
+}
+func (m WebhookDeliveryRepoMock) FindDeadByWebhookID(webhookID string) ([]*model.WebhookDelivery, error) {
+	return m.FakeFindDeadByWebhookID(webhookID)
+}
+func (m WebhookDeliveryRepoMock) UpdateAttempt(ctx context.Context, d *model.WebhookDelivery) error {
+	return m.FakeUpdateAttempt(ctx, d)
+}
+
+// AuditEventRepoMock is mock of auditEventRepository
+type AuditEventRepoMock struct {
+	FakeSave func(ctx context.Context, e *model.AuditEvent) error
+	FakeFind func(f model.AuditEventFilter) ([]*model.AuditEvent, error)
+}
+
+func (m AuditEventRepoMock) Save(ctx context.Context, e *model.AuditEvent) error {
+	return m.FakeSave(ctx, e)
+}
+func (m AuditEventRepoMock) Find(f model.AuditEventFilter) ([]*model.AuditEvent, error) {
+	return m.FakeFind(f)
+}
+
+// QuotaRepoMock is mock of quotaRepository
+type QuotaRepoMock struct {
+	FakeFindByUserID func(userID string) (*model.UserQuota, error)
+	FakeLockUser     func(ctx context.Context, userID string) error
+	FakeCountUsage   func(ctx context.Context, userID string) (*model.QuotaUsage, error)
+}
+
+func (m QuotaRepoMock) FindByUserID(userID string) (*model.UserQuota, error) {
+	return m.FakeFindByUserID(userID)
+}
+func (m QuotaRepoMock) LockUser(ctx context.Context, userID string) error {
+	return m.FakeLockUser(ctx, userID)
+}
+func (m QuotaRepoMock) CountUsage(ctx context.Context, userID string) (*model.QuotaUsage, error) {
+	return m.FakeCountUsage(ctx, userID)
+}
+
+// TransactionMock runs the function without a transaction
+type TransactionMock struct{}
+
+func (TransactionMock) DoInTx(ctx context.Context, f func(context.Context) (interface{}, error)) (interface{}, error) {
+	return f(ctx)
+}
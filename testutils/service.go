@@ -1,5 +1,11 @@
 package testutils
 
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
 // UserServiceMock is mock of UserService
 type UserServiceMock struct {
 	FakeExistsID             func(id string) (bool, error)
@@ -24,12 +30,128 @@ func (m UserServiceMock) ExistsDuplicatedUser(name, email string) (bool, error)
 // AnonyURLServiceMock is mock of AnonyURLService
 type AnonyURLServiceMock struct {
 	FakeExistID             func(id string) (bool, error)
-	FakeExistOriginalInUser func(original, userID string) (bool, error)
+	FakeExistOriginalInUser func(original string, utm model.UTM, userID string) (bool, error)
+	FakeExistAnonyURL       func(domainID, anonyURL string) (bool, error)
 }
 
 func (m AnonyURLServiceMock) ExistID(id string) (bool, error) {
 	return m.FakeExistID(id)
 }
-func (m AnonyURLServiceMock) ExistOriginalInUser(original, userID string) (bool, error) {
-	return m.FakeExistOriginalInUser(original, userID)
+func (m AnonyURLServiceMock) ExistOriginalInUser(original string, utm model.UTM, userID string) (bool, error) {
+	return m.FakeExistOriginalInUser(original, utm, userID)
+}
+func (m AnonyURLServiceMock) ExistAnonyURL(domainID, anonyURL string) (bool, error) {
+	return m.FakeExistAnonyURL(domainID, anonyURL)
+}
+
+// DomainServiceMock is mock of DomainService
+type DomainServiceMock struct {
+	FakeExistsName      func(name string) (bool, error)
+	FakeVerifyOwnership func(ctx context.Context, d *model.Domain) (bool, error)
+}
+
+func (m DomainServiceMock) ExistsName(name string) (bool, error) {
+	return m.FakeExistsName(name)
+}
+func (m DomainServiceMock) VerifyOwnership(ctx context.Context, d *model.Domain) (bool, error) {
+	return m.FakeVerifyOwnership(ctx, d)
+}
+
+// TXTResolverMock is fake resolver of DNS TXT records
+type TXTResolverMock struct {
+	FakeLookupTXT func(ctx context.Context, name string) ([]string, error)
+}
+
+func (m TXTResolverMock) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return m.FakeLookupTXT(ctx, name)
+}
+
+// DestinationScreenerMock is mock of DestinationScreener
+type DestinationScreenerMock struct {
+	FakeScreen func(ctx context.Context, destination string) (string, error)
+}
+
+func (m DestinationScreenerMock) Screen(ctx context.Context, destination string) (string, error) {
+	return m.FakeScreen(ctx, destination)
+}
+
+// RedirectChainServiceMock is mock of RedirectChainService
+type RedirectChainServiceMock struct {
+	FakeResolve func(destination string, self *model.AnonyURL) (string, error)
+}
+
+func (m RedirectChainServiceMock) Resolve(destination string, self *model.AnonyURL) (string, error) {
+	return m.FakeResolve(destination, self)
+}
+
+// VerifiedDomainFinderMock is mock of VerifiedDomainFinder
+type VerifiedDomainFinderMock struct {
+	FakeFindVerifiedByName func(name string) (*model.Domain, error)
+}
+
+func (m VerifiedDomainFinderMock) FindVerifiedByName(name string) (*model.Domain, error) {
+	return m.FakeFindVerifiedByName(name)
+}
+
+// LinkCheckerMock is mock of LinkChecker
+type LinkCheckerMock struct {
+	FakeCheck func(ctx context.Context, destination string) (*model.LinkHealth, error)
+}
+
+func (m LinkCheckerMock) Check(ctx context.Context, destination string) (*model.LinkHealth, error) {
+	return m.FakeCheck(ctx, destination)
+}
+
+// PreviewFetcherMock is mock of PreviewFetcher
+type PreviewFetcherMock struct {
+	FakeFetch func(ctx context.Context, destination string) (*model.LinkPreview, error)
+}
+
+func (m PreviewFetcherMock) Fetch(ctx context.Context, destination string) (*model.LinkPreview, error) {
+	return m.FakeFetch(ctx, destination)
+}
+
+// WebhookServiceMock is mock of WebhookService
+type WebhookServiceMock struct {
+	FakeEnqueue func(ctx context.Context, userID string, e *model.WebhookEvent) error
+}
+
+func (m WebhookServiceMock) Enqueue(ctx context.Context, userID string, e *model.WebhookEvent) error {
+	return m.FakeEnqueue(ctx, userID, e)
+}
+
+// WebhookSenderMock is mock of WebhookSender
+type WebhookSenderMock struct {
+	FakeSend     func(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery) error
+	FakeCheckURL func(ctx context.Context, url string) error
+}
+
+func (m WebhookSenderMock) Send(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery) error {
+	return m.FakeSend(ctx, w, d)
+}
+
+func (m WebhookSenderMock) CheckURL(ctx context.Context, url string) error {
+	return m.FakeCheckURL(ctx, url)
+}
+
+// AuditServiceMock is mock of AuditService
+type AuditServiceMock struct {
+	FakeRecord func(ctx context.Context, actorID, action, targetType, targetID string, before, after interface{}) error
+}
+
+func (m AuditServiceMock) Record(ctx context.Context, actorID, action, targetType, targetID string, before, after interface{}) error {
+	return m.FakeRecord(ctx, actorID, action, targetType, targetID, before, after)
+}
+
+// QuotaServiceMock is mock of QuotaService
+type QuotaServiceMock struct {
+	FakeCheck func(ctx context.Context, userID string, created, activated int64) error
+	FakeGet   func(ctx context.Context, userID string) (*model.Quota, *model.QuotaUsage, error)
+}
+
+func (m QuotaServiceMock) Check(ctx context.Context, userID string, created, activated int64) error {
+	return m.FakeCheck(ctx, userID, created, activated)
+}
+func (m QuotaServiceMock) Get(ctx context.Context, userID string) (*model.Quota, *model.QuotaUsage, error) {
+	return m.FakeGet(ctx, userID)
 }
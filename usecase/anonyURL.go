@@ -3,8 +3,9 @@ package usecase
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
-	"os"
+	"time"
 
 	"github.com/Tatsuemon/anony/domain/model"
 	"github.com/Tatsuemon/anony/domain/repository"
@@ -12,85 +13,263 @@ import (
 	"github.com/Tatsuemon/anony/infrastructure/datastore"
 )
 
+const (
+	anonyURLCodeLength     = 8
+	maxRetryCreateAnonyURL = 5
+)
+
+// ErrAnonyURLConflict is returned when the original and UTM are registered with different settings
+var ErrAnonyURLConflict = errors.New("anonyURL of the original and UTM is already registered with different settings")
+
 // AnonyURLUseCase is a usecase
 type AnonyURLUseCase interface {
-	CreateAnonyURL(ctx context.Context, userID string) (string, error)
+	CreateAnonyURL(ctx context.Context, domainID string) (string, error)
 	SaveAnonyURL(ctx context.Context, an *model.AnonyURL, userID string) (*model.AnonyURL, error)
-	UpdateAnonyURLStatus(ctx context.Context, original, userID string, status int64) (*model.AnonyURL, error)
+	SaveCampaign(ctx context.Context, ans []*model.AnonyURL, userID string) ([]*model.AnonyURL, error)
+	UpdateAnonyURLStatus(ctx context.Context, original string, utm model.UTM, userID string, status int64) (*model.AnonyURL, error)
 	ListAnonyURLs(ctx context.Context, userID string, q int64) ([]*model.AnonyURL, error)
-	GetOriginalByAnonyURL(ctx context.Context, anonyURL string) (string, error)
+	ListBrokenAnonyURLs(ctx context.Context, userID string) ([]*model.AnonyURL, error)
+	GetOriginalByAnonyURL(ctx context.Context, domainID, anonyURL string) (*model.AnonyURL, error)
+	SetVariants(ctx context.Context, original string, utm model.UTM, userID string, variants []*model.Variant) (*model.AnonyURL, error)
+	GetAnonyURL(ctx context.Context, original string, utm model.UTM, userID string) (*model.AnonyURL, error)
+	GetAnonyURLStats(ctx context.Context, original string, utm model.UTM, userID string) (*model.AnonyURL, error)
+	RecordClick(ctx context.Context, an *model.AnonyURL, variantID string) error
+	SetSchedule(ctx context.Context, original string, utm model.UTM, userID string, activeFrom, activeUntil *time.Time, fallback string) (*model.AnonyURL, error)
+	ResolveRedirectChain(ctx context.Context, destination string, self *model.AnonyURL) (string, error)
+	UpdateAnnotation(ctx context.Context, original string, utm model.UTM, userID string, title, notes string, metadata map[string]string) (*model.AnonyURL, error)
+	// GetQuota returns the user's quota and its current usage
+	GetQuota(ctx context.Context, userID string) (*model.Quota, *model.QuotaUsage, error)
 }
 
 type anonyURLUseCase struct {
 	repo        repository.AnonyURLRepository
+	variantRepo repository.VariantRepository
 	transaction datastore.Transaction
 	service     service.AnonyURLService
+	screener    service.DestinationScreener
+	chain       service.RedirectChainService
+	webhooks    service.WebhookService
+	events      EventPublisher
+	audit       service.AuditService
+	quota       service.QuotaService
 }
 
 // NewAnonyURLUseCase creates conversionURLUseCase
-func NewAnonyURLUseCase(r repository.AnonyURLRepository, t datastore.Transaction, s service.AnonyURLService) AnonyURLUseCase {
-	return &anonyURLUseCase{r, t, s}
+func NewAnonyURLUseCase(r repository.AnonyURLRepository, vr repository.VariantRepository, t datastore.Transaction, s service.AnonyURLService, sc service.DestinationScreener, ch service.RedirectChainService, ws service.WebhookService, ep EventPublisher, as service.AuditService, qs service.QuotaService) AnonyURLUseCase {
+	return &anonyURLUseCase{r, vr, t, s, sc, ch, ws, ep, as, qs}
 }
 
-func (u *anonyURLUseCase) CreateAnonyURL(ctx context.Context, userID string) (string, error) {
-	host := os.Getenv("SERVER_HOST")
-	path := userID[25:33] + "/"
+func (u *anonyURLUseCase) CreateAnonyURL(ctx context.Context, domainID string) (string, error) {
+	// 同じドメイン内で重複した場合は作り直す
+	for i := 0; i < maxRetryCreateAnonyURL; i++ {
+		code, err := generateAnonyURLCode()
+		if err != nil {
+			return "", err
+		}
+		exist, err := u.service.ExistAnonyURL(domainID, code)
+		if err != nil {
+			return "", err
+		}
+		if !exist {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("failed to create unique anonyURL")
+}
 
+// generateAnonyURLCode generates a random code of short URL
+func generateAnonyURLCode() (string, error) {
 	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
 	// 乱数を生成
-	b := make([]byte, 8)
+	b := make([]byte, anonyURLCodeLength)
 	if _, err := rand.Read(b); err != nil {
 		return "", fmt.Errorf("unexpected error")
 	}
+	code := ""
 	// letters からランダムに取り出して文字列を生成
 	for _, v := range b {
 		// index が letters の長さに収まるように調整
-		path += string(letters[int(v)%len(letters)])
+		code += string(letters[int(v)%len(letters)])
 	}
-	return host + "/" + path, nil
+	return code, nil
 }
 
 func (u *anonyURLUseCase) SaveAnonyURL(ctx context.Context, an *model.AnonyURL, userID string) (*model.AnonyURL, error) {
-	exist, err := u.service.ExistOriginalInUser(an.Original, userID)
+	var created bool
+	_, err := u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		var err error
+		created, err = u.saveAnonyURL(ctx, an, userID)
+		return nil, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	idExisted, err := u.service.ExistID(an.ID)
+	saved, err := u.repo.FindByID(an.ID)
 	if err != nil {
 		return nil, err
 	}
-	if idExisted {
-		return nil, fmt.Errorf("id is already existed")
-	}
+	u.events.Publish(ctx, savedEvent(saved, userID, created, time.Now()))
+	return saved, nil
+}
 
-	if err := an.ValidateAnonyURL(); err != nil {
-		return nil, err
+// SaveCampaign saves AnonyURLs of the channels in a campaign at once
+// 既に登録されているoriginalとUTMの組の場合は, 登録済みのAnonyURLが返る
+func (u *anonyURLUseCase) SaveCampaign(ctx context.Context, ans []*model.AnonyURL, userID string) ([]*model.AnonyURL, error) {
+	if len(ans) == 0 {
+		return nil, fmt.Errorf("channels are required")
 	}
-	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
-		if exist {
-			id, err := u.repo.GetIDByOriginalUser(an.Original, userID)
+	utms := map[model.UTM]struct{}{}
+	for _, an := range ans {
+		if err := an.UTM.ValidateCampaignChannel(); err != nil {
+			return nil, err
+		}
+		if _, ok := utms[an.UTM]; ok {
+			return nil, fmt.Errorf("channels are duplicated")
+		}
+		utms[an.UTM] = struct{}{}
+	}
+
+	created := make([]bool, len(ans))
+	_, err := u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		for i, an := range ans {
+			c, err := u.saveAnonyURL(ctx, an, userID)
 			if err != nil {
 				return nil, err
 			}
-			an.ID = id
-			return nil, u.repo.UpdateStatus(ctx, id, an.Status)
+			created[i] = c
 		}
-		return nil, u.repo.Save(ctx, an, userID)
+		return nil, nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.FindByID(an.ID)
+
+	res := make([]*model.AnonyURL, len(ans))
+	events := []model.Event{}
+	now := time.Now()
+	for i, an := range ans {
+		saved, err := u.repo.FindByID(an.ID)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = saved
+		events = append(events, savedEvent(saved, userID, created[i], now))
+	}
+	u.events.Publish(ctx, events...)
+	return res, nil
 }
 
-func (u *anonyURLUseCase) UpdateAnonyURLStatus(ctx context.Context, original, userID string, status int64) (*model.AnonyURL, error) {
+// savedEvent returns the event of the saved AnonyURL
+// 登録済みのoriginalとUTMの組の場合はステータスを更新したので, AnonyURLStatusChangedになる
+func savedEvent(an *model.AnonyURL, userID string, created bool, at time.Time) model.Event {
+	if created {
+		return model.AnonyURLCreated{AnonyURL: an, UserID: userID, At: at}
+	}
+	return model.AnonyURLStatusChanged{AnonyURL: an, UserID: userID, At: at}
+}
+
+// saveAnonyURL saves an AnonyURL, or updates the status if the original and UTM are already registered
+// 新しく保存した場合にtrueを返す
+func (u *anonyURLUseCase) saveAnonyURL(ctx context.Context, an *model.AnonyURL, userID string) (bool, error) {
+	// 表記の違うURLを同じリンクとして扱うため, 正規化してから重複を確認する
+	raw := an.Original
+	original, err := model.NormalizeURL(an.Original)
+	if err != nil {
+		return false, fmt.Errorf("original is invalid: %v", err)
+	}
+	an.Original = original
+	exist, err := u.service.ExistOriginalInUser(an.Original, an.UTM, userID)
+	if err != nil {
+		return false, err
+	}
+	// 正規化の導入前に同じ表記で登録されたAnonyURLも, 登録済みとして扱う
+	if !exist && raw != original {
+		exist, err = u.service.ExistOriginalInUser(raw, an.UTM, userID)
+		if err != nil {
+			return false, err
+		}
+	}
+	idExisted, err := u.service.ExistID(an.ID)
+	if err != nil {
+		return false, err
+	}
+	if idExisted {
+		return false, fmt.Errorf("id is already existed")
+	}
+
+	if err := an.ValidateAnonyURL(); err != nil {
+		return false, err
+	}
+	destinations := append([]string{an.Original, an.Fallback}, variantDestinations(an.Variants)...)
+	if err := service.ScreenDestinations(ctx, u.screener, destinations...); err != nil {
+		return false, err
+	}
+	if err := u.checkRedirectChain(an, destinations...); err != nil {
+		return false, err
+	}
+	if exist {
+		id, err := getIDByOriginalUser(u.repo, raw, an.UTM, userID)
+		if err != nil {
+			return false, err
+		}
+		an.ID = id
+		before, err := u.repo.FindByID(id)
+		if err != nil {
+			return false, err
+		}
+		if before == nil {
+			return false, fmt.Errorf("this anonyURL is not existed")
+		}
+		// 別のドメインや設定のリンクを, 指定どおりに作成したかのように返さない
+		if name := before.ConflictingSetting(*an); name != "" {
+			return false, fmt.Errorf("%w: %s", ErrAnonyURLConflict, name)
+		}
+		if err := u.quota.Check(ctx, userID, 0, activated(before.Status, an.Status)); err != nil {
+			return false, err
+		}
+		if err := u.repo.UpdateStatus(ctx, id, an.Status); err != nil {
+			return false, err
+		}
+		after := *before
+		after.Status = an.Status
+		if err := u.audit.Record(ctx, userID, model.AuditAnonyURLUpdateStatus, model.AuditTargetAnonyURL, id, before, &after); err != nil {
+			return false, err
+		}
+	} else {
+		if err := u.quota.Check(ctx, userID, 1, activated(0, an.Status)); err != nil {
+			return false, err
+		}
+		if err := u.repo.Save(ctx, an, userID); err != nil {
+			return false, err
+		}
+		if err := u.audit.Record(ctx, userID, model.AuditAnonyURLCreate, model.AuditTargetAnonyURL, an.ID, nil, an); err != nil {
+			return false, err
+		}
+		if err := u.webhooks.Enqueue(ctx, userID, model.NewWebhookEvent(model.WebhookEventAnonyURLCreated, an, time.Now())); err != nil {
+			return false, err
+		}
+	}
+	// Variantが指定されていない場合は, 登録済みのVariantをそのまま残す
+	if len(an.Variants) == 0 {
+		return !exist, nil
+	}
+	return !exist, u.variantRepo.ReplaceByAnonyURLID(ctx, an.ID, an.Variants)
+}
+
+func (u *anonyURLUseCase) UpdateAnonyURLStatus(ctx context.Context, original string, utm model.UTM, userID string, status int64) (*model.AnonyURL, error) {
 	if status < 1 || status > 2 {
 		return nil, fmt.Errorf("status is out of range")
 	}
+	// 有効にする場合は, 登録後にブロックされたリダイレクト先でないか確認する
+	if status == 1 {
+		if err := service.ScreenDestinations(ctx, u.screener, canonicalOriginal(original)); err != nil {
+			return nil, err
+		}
+	}
 	var id string
 	_, err := u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
-		aid, err := u.repo.GetIDByOriginalUser(original, userID)
+		aid, err := getIDByOriginalUser(u.repo, original, utm, userID)
 		id = aid
 		if err != nil {
 			return nil, err
@@ -98,12 +277,39 @@ func (u *anonyURLUseCase) UpdateAnonyURLStatus(ctx context.Context, original, us
 		if id == "" {
 			return nil, fmt.Errorf("this anonyURL is not existed")
 		}
-		return nil, u.repo.UpdateStatus(ctx, id, status)
+		before, err := u.repo.FindByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if before == nil {
+			return nil, fmt.Errorf("this anonyURL is not existed")
+		}
+		if err := u.quota.Check(ctx, userID, 0, activated(before.Status, status)); err != nil {
+			return nil, err
+		}
+		if err := u.repo.UpdateStatus(ctx, id, status); err != nil {
+			return nil, err
+		}
+		// トランザクション外で読んだ値なので, 更新後のステータスにする
+		after := *before
+		after.Status = status
+		if err := u.audit.Record(ctx, userID, model.AuditAnonyURLUpdateStatus, model.AuditTargetAnonyURL, id, before, &after); err != nil {
+			return nil, err
+		}
+		if status != 2 {
+			return nil, nil
+		}
+		return nil, u.webhooks.Enqueue(ctx, userID, model.NewWebhookEvent(model.WebhookEventAnonyURLDeactivated, &after, time.Now()))
 	})
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.FindByID(id)
+	an, err := u.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	u.events.Publish(ctx, model.AnonyURLStatusChanged{AnonyURL: an, UserID: userID, At: time.Now()})
+	return an, nil
 }
 
 func (u *anonyURLUseCase) ListAnonyURLs(ctx context.Context, userID string, q int64) ([]*model.AnonyURL, error) {
@@ -121,16 +327,256 @@ func (u *anonyURLUseCase) ListAnonyURLs(ctx context.Context, userID string, q in
 	}
 }
 
-func (u *anonyURLUseCase) GetOriginalByAnonyURL(ctx context.Context, anonyURL string) (string, error) {
-	an, err := u.repo.FindByAnonyURL(anonyURL)
+// ListBrokenAnonyURLs lists the user's AnonyURLs whose destination was broken at the last health check
+func (u *anonyURLUseCase) ListBrokenAnonyURLs(ctx context.Context, userID string) ([]*model.AnonyURL, error) {
+	return u.repo.FindBrokenByUserID(userID)
+}
+
+// GetOriginalByAnonyURL returns the active AnonyURL to redirect, or nil if it is not found
+// 有効期間外の場合はOriginalをFallbackに置き換えて返す
+func (u *anonyURLUseCase) GetOriginalByAnonyURL(ctx context.Context, domainID, anonyURL string) (*model.AnonyURL, error) {
+	an, err := u.repo.FindByAnonyURL(domainID, anonyURL)
+	if err != nil {
+		return nil, err
+	}
+	if an == nil {
+		return nil, nil
+	}
+	if !an.IsRedirectable() {
+		return nil, nil
+	}
+	// 有効期間外はFallbackへリダイレクトする. A/Bテストの振り分けは行わない
+	if !an.InSchedule(time.Now()) {
+		if an.Fallback == "" {
+			return nil, nil
+		}
+		an.Original = an.Fallback
+		return an, nil
+	}
+	an.Variants, err = u.variantRepo.FindByAnonyURLID(an.ID)
+	if err != nil {
+		return nil, err
+	}
+	return an, nil
+}
+
+// SetVariants replaces the destinations of A/B split, the click counts of variants are reset
+// variantsが空の場合はA/Bテストを終了する
+func (u *anonyURLUseCase) SetVariants(ctx context.Context, original string, utm model.UTM, userID string, variants []*model.Variant) (*model.AnonyURL, error) {
+	an, err := u.findOwnAnonyURL(original, utm, userID)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	an.Variants = variants
+	for _, v := range an.Variants {
+		v.AnonyURLID = an.ID
+	}
+	if err := an.ValidateVariants(); err != nil {
+		return nil, err
+	}
+	if err := service.ScreenDestinations(ctx, u.screener, variantDestinations(an.Variants)...); err != nil {
+		return nil, err
+	}
+	if err := u.checkRedirectChain(an, variantDestinations(an.Variants)...); err != nil {
+		return nil, err
+	}
+	before, err := u.variantRepo.FindByAnonyURLID(an.ID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.variantRepo.ReplaceByAnonyURLID(ctx, an.ID, an.Variants); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditAnonyURLSetVariants, model.AuditTargetAnonyURL, an.ID, before, an.Variants)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.events.Publish(ctx, model.AnonyURLUpdated{AnonyURL: an, UserID: userID, At: time.Now()})
+	return u.GetAnonyURLStats(ctx, original, utm, userID)
+}
+
+// GetAnonyURL returns the user's AnonyURL of the original and the UTM
+func (u *anonyURLUseCase) GetAnonyURL(ctx context.Context, original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+	return u.findOwnAnonyURL(original, utm, userID)
+}
+
+// GetAnonyURLStats returns the AnonyURL with the click counts of it and its variants
+func (u *anonyURLUseCase) GetAnonyURLStats(ctx context.Context, original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+	an, err := u.findOwnAnonyURL(original, utm, userID)
+	if err != nil {
+		return nil, err
+	}
+	an.Variants, err = u.variantRepo.FindByAnonyURLID(an.ID)
+	if err != nil {
+		return nil, err
+	}
+	return an, nil
+}
+
+// RecordClick counts a redirect of the AnonyURL, variantID is empty if no variant is used
+func (u *anonyURLUseCase) RecordClick(ctx context.Context, an *model.AnonyURL, variantID string) error {
+	var userID string
+	_, err := u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.IncrementClicks(ctx, an.ID); err != nil {
+			return nil, err
+		}
+		if variantID != "" {
+			if err := u.variantRepo.IncrementClicks(ctx, variantID); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if userID, err = u.repo.GetUserIDByID(an.ID); err != nil {
+			return nil, err
+		}
+		e := model.NewWebhookEvent(model.WebhookEventAnonyURLClicked, an, time.Now())
+		e.AnonyURL.Clicks = an.Clicks + 1
+		e.VariantID = variantID
+		return nil, u.webhooks.Enqueue(ctx, userID, e)
+	})
+	if err != nil {
+		return err
+	}
+	u.events.Publish(ctx, model.AnonyURLClicked{AnonyURL: an, UserID: userID, VariantID: variantID, At: time.Now()})
+	return nil
+}
+
+// SetSchedule sets the activation window and the fallback destination served outside it
+// activeFrom, activeUntilがnilの場合は制限しない
+func (u *anonyURLUseCase) SetSchedule(ctx context.Context, original string, utm model.UTM, userID string, activeFrom, activeUntil *time.Time, fallback string) (*model.AnonyURL, error) {
+	an, err := u.findOwnAnonyURL(original, utm, userID)
+	if err != nil {
+		return nil, err
+	}
+	before := *an
+	an.ActiveFrom = activeFrom
+	an.ActiveUntil = activeUntil
+	an.Fallback = fallback
+	if err := an.ValidateSchedule(); err != nil {
+		return nil, err
+	}
+	if err := service.ScreenDestinations(ctx, u.screener, an.Fallback); err != nil {
+		return nil, err
+	}
+	if err := u.checkRedirectChain(an, an.Fallback); err != nil {
+		return nil, err
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.UpdateSchedule(ctx, an.ID, an.ActiveFrom, an.ActiveUntil, an.Fallback); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditAnonyURLSetSchedule, model.AuditTargetAnonyURL, an.ID, &before, an)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.events.Publish(ctx, model.AnonyURLUpdated{AnonyURL: an, UserID: userID, At: time.Now()})
+	return u.repo.FindByID(an.ID)
+}
+
+// UpdateAnnotation replaces the title, the notes and the metadata given by the owner
+func (u *anonyURLUseCase) UpdateAnnotation(ctx context.Context, original string, utm model.UTM, userID string, title, notes string, metadata map[string]string) (*model.AnonyURL, error) {
+	an, err := u.findOwnAnonyURL(original, utm, userID)
+	if err != nil {
+		return nil, err
+	}
+	before := *an
+	an.SetAnnotation(title, notes, metadata)
+	if err := an.ValidateAnnotation(); err != nil {
+		return nil, err
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.UpdateAnnotation(ctx, an.ID, an.Title, an.Notes, an.Metadata); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditAnonyURLUpdateMetadata, model.AuditTargetAnonyURL, an.ID, &before, an)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.events.Publish(ctx, model.AnonyURLUpdated{AnonyURL: an, UserID: userID, At: time.Now()})
+	return u.repo.FindByID(an.ID)
+}
+
+// ResolveRedirectChain follows short URLs in the destination and returns the final destination
+// ループしている場合やMaxRedirectChainを超える場合はエラーを返す
+func (u *anonyURLUseCase) ResolveRedirectChain(ctx context.Context, destination string, self *model.AnonyURL) (string, error) {
+	return u.chain.Resolve(destination, self)
+}
+
+// checkRedirectChain rejects destinations which redirect back to the AnonyURL or chain too many short URLs
+func (u *anonyURLUseCase) checkRedirectChain(an *model.AnonyURL, destinations ...string) error {
+	for _, d := range destinations {
+		if d == "" {
+			continue
+		}
+		if _, err := u.chain.Resolve(d, an); err != nil {
+			return fmt.Errorf("%s: %w", d, err)
+		}
+	}
+	return nil
+}
+
+func (u *anonyURLUseCase) GetQuota(ctx context.Context, userID string) (*model.Quota, *model.QuotaUsage, error) {
+	return u.quota.Get(ctx, userID)
+}
+
+func (u *anonyURLUseCase) findOwnAnonyURL(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+	an, err := findByOriginalInUser(u.repo, original, utm, userID)
+	if err != nil {
+		return nil, err
 	}
 	if an == nil {
-		return "", nil
+		return nil, fmt.Errorf("this anonyURL is not existed")
 	}
-	if an.Status != 1 {
-		return "", nil
+	return an, nil
+}
+
+// canonicalOriginal returns the normalized original to look up AnonyURLs
+// 正規化できない場合は, 正規化の導入前に登録されたURLを探せるようにそのまま返す
+func canonicalOriginal(original string) string {
+	if n, err := model.NormalizeURL(original); err == nil {
+		return n
+	}
+	return original
+}
+
+// findByOriginalInUser finds the AnonyURL by the normalized original, or by the original as it is
+// 正規化の導入前に登録されたAnonyURLは, 正規化されていないoriginalで保存されている
+func findByOriginalInUser(r repository.AnonyURLRepository, original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+	n := canonicalOriginal(original)
+	an, err := r.FindByOriginalInUser(n, utm, userID)
+	if err != nil || an != nil || n == original {
+		return an, err
+	}
+	return r.FindByOriginalInUser(original, utm, userID)
+}
+
+// getIDByOriginalUser returns the ID of the AnonyURL in the same way as findByOriginalInUser
+func getIDByOriginalUser(r repository.AnonyURLRepository, original string, utm model.UTM, userID string) (string, error) {
+	n := canonicalOriginal(original)
+	id, err := r.GetIDByOriginalUser(n, utm, userID)
+	if err != nil || id != "" || n == original {
+		return id, err
+	}
+	return r.GetIDByOriginalUser(original, utm, userID)
+}
+
+func variantDestinations(vs []*model.Variant) []string {
+	res := make([]string, len(vs))
+	for i, v := range vs {
+		res[i] = v.Destination
+	}
+	return res
+}
+
+// activated returns 1 if the status change activates the AnonyURL
+// 作成時はbeforeを0とする
+func activated(before, after int64) int64 {
+	if after == 1 && before != 1 {
+		return 1
 	}
-	return an.Original, nil
+	return 0
 }
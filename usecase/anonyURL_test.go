@@ -2,19 +2,24 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Tatsuemon/anony/domain/model"
 	"github.com/Tatsuemon/anony/domain/service"
 	"github.com/Tatsuemon/anony/infrastructure/datastore"
+	"github.com/Tatsuemon/anony/infrastructure/screener"
 	"github.com/Tatsuemon/anony/testutils"
 )
 
 func TestNewAnonyURLUseCase(t *testing.T) {
 	db := testutils.GetTestDB().DB
 	transaction := datastore.NewTransaction(db)
+	events := NewEventBus(0)
 	tests := []struct {
 		name string
 		want AnonyURLUseCase
@@ -23,16 +28,29 @@ func TestNewAnonyURLUseCase(t *testing.T) {
 			name: "NORMAL: 正常にAnonyURLUseCaseが作成できる",
 			want: &anonyURLUseCase{
 				testutils.AnonyURLRepoMock{},
+				testutils.VariantRepoMock{},
 				transaction,
 				testutils.AnonyURLServiceMock{},
+				testutils.DestinationScreenerMock{},
+				testutils.RedirectChainServiceMock{},
+				testutils.WebhookServiceMock{},
+				events,
+				testutils.AuditServiceMock{},
+				testutils.QuotaServiceMock{},
 			},
 		},
 	}
 	for _, tt := range tests {
 		repo := testutils.AnonyURLRepoMock{}
+		variantRepo := testutils.VariantRepoMock{}
 		service := testutils.AnonyURLServiceMock{}
+		sc := testutils.DestinationScreenerMock{}
+		ch := testutils.RedirectChainServiceMock{}
+		ws := testutils.WebhookServiceMock{}
+		as := testutils.AuditServiceMock{}
+		qs := testutils.QuotaServiceMock{}
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewAnonyURLUseCase(repo, transaction, service); !reflect.DeepEqual(got, tt.want) {
+			if got := NewAnonyURLUseCase(repo, variantRepo, transaction, service, sc, ch, ws, events, as, qs); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewAnonyURLUseCase() = %v, want %v", got, tt.want)
 			}
 		})
@@ -42,46 +60,97 @@ func TestNewAnonyURLUseCase(t *testing.T) {
 func Test_anonyURLUseCase_CreateAnonyURL(t *testing.T) {
 	db := testutils.GetTestDB().DB
 	transaction := datastore.NewTransaction(db)
+	type serviceMocks struct {
+		FakeExistAnonyURL func(domainID, anonyURL string) (bool, error)
+	}
 	type args struct {
-		ctx    context.Context
-		userID string
+		ctx      context.Context
+		domainID string
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    string
-		wantErr bool
+		name         string
+		args         args
+		serviceMocks serviceMocks
+		wantErr      bool
 	}{
 		{
 			name: "NORMAL: 正常にAnonyURLを作成できる",
 			args: args{
-				ctx:    context.Background(),
-				userID: "abcdefghijklmnopqrstuvwxyz1234567890",
+				ctx: context.Background(),
+			},
+			serviceMocks: serviceMocks{
+				FakeExistAnonyURL: func(domainID, anonyURL string) (bool, error) {
+					return false, nil
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: 重複した場合は作り直す",
+			args: args{
+				ctx: context.Background(),
+			},
+			serviceMocks: serviceMocks{
+				FakeExistAnonyURL: func() func(domainID, anonyURL string) (bool, error) {
+					cnt := 0
+					return func(domainID, anonyURL string) (bool, error) {
+						cnt++
+						return cnt == 1, nil
+					}
+				}(),
 			},
-			want:    "http://localhost-test/z1234567/",
 			wantErr: false,
 		},
+		{
+			name: "ERROR: 重複し続ける場合",
+			args: args{
+				ctx: context.Background(),
+			},
+			serviceMocks: serviceMocks{
+				FakeExistAnonyURL: func(domainID, anonyURL string) (bool, error) {
+					return true, nil
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ERROR: service.ExistAnonyURLでErrorを返す",
+			args: args{
+				ctx: context.Background(),
+			},
+			serviceMocks: serviceMocks{
+				FakeExistAnonyURL: func(domainID, anonyURL string) (bool, error) {
+					return false, fmt.Errorf("error")
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			u := &anonyURLUseCase{
 				repo:        testutils.AnonyURLRepoMock{},
 				transaction: transaction,
-				service:     testutils.AnonyURLServiceMock{},
+				service: testutils.AnonyURLServiceMock{
+					FakeExistAnonyURL: tt.serviceMocks.FakeExistAnonyURL,
+				},
 			}
-			got, err := u.CreateAnonyURL(tt.args.ctx, tt.args.userID)
+			got, err := u.CreateAnonyURL(tt.args.ctx, tt.args.domainID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("anonyURLUseCase.CreateAnonyURL() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if err == nil {
-				if len(got) != 39 {
-					t.Errorf("anonyURLUseCase.CreateAnonyURL() len(got) = %v, want 39", len(got))
-				}
+			if err != nil {
+				return
+			}
+			// コードはランダムであるため, 長さと文字種を検証する
+			if len(got) != anonyURLCodeLength {
+				t.Errorf("anonyURLUseCase.CreateAnonyURL() len(got) = %v, want %v", len(got), anonyURLCodeLength)
 			}
-			// 最後の部分はランダムであるため, そこまでを検証する
-			if got[:31] != tt.want[:31] {
-				t.Errorf("anonyURLUseCase.CreateAnonyURL() = %v, want %v", got[:31], tt.want[:31])
+			for _, c := range got {
+				if !(('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')) {
+					t.Errorf("anonyURLUseCase.CreateAnonyURL() = %v, contains invalid character %q", got, c)
+				}
 			}
 		})
 	}
@@ -92,13 +161,13 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 	transaction := datastore.NewTransaction(db)
 	type repoMocks struct {
 		FakeFindByID            func(id string) (*model.AnonyURL, error)
-		FakeGetIDByOriginalUser func(original, userID string) (string, error)
+		FakeGetIDByOriginalUser func(original string, utm model.UTM, userID string) (string, error)
 		FakeSave                func(ctx context.Context, an *model.AnonyURL, userID string) error
 		FakeUpdateStatus        func(ctx context.Context, id string, status int64) error
 	}
 	type serviceMocks struct {
 		FakeExistID             func(id string) (bool, error)
-		FakeExistOriginalInUser func(original, userID string) (bool, error)
+		FakeExistOriginalInUser func(original string, utm model.UTM, userID string) (bool, error)
 	}
 	type args struct {
 		ctx    context.Context
@@ -120,7 +189,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -130,7 +199,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 					return &model.AnonyURL{
 						ID:       "id1",
 						Original: "http://localhost:8888/original1",
-						Short:    "http://localhost:8888/short1",
+						Short:    "short1",
 						Status:   1,
 					}, nil
 				},
@@ -142,18 +211,64 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return false, nil
 				},
 			},
 			want: &model.AnonyURL{
 				ID:       "id1",
 				Original: "http://localhost:8888/original1",
-				Short:    "http://localhost:8888/short1",
+				Short:    "short1",
 				Status:   1,
 			},
 			wantErr: false,
 		},
+		{
+			name: "ERROR: リダイレクトがループする場合",
+			args: args{
+				ctx: context.Background(),
+				an: &model.AnonyURL{
+					ID:       "id1",
+					Original: "http://loop.example/short1",
+					Short:    "short1",
+					Status:   1,
+				},
+				userID: "user_id",
+			},
+			serviceMocks: serviceMocks{
+				FakeExistID: func(id string) (bool, error) {
+					return false, nil
+				},
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
+					return false, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: リダイレクト先がブロックされている場合",
+			args: args{
+				ctx: context.Background(),
+				an: &model.AnonyURL{
+					ID:       "id1",
+					Original: "http://blocked.example/phishing",
+					Short:    "short1",
+					Status:   1,
+				},
+				userID: "user_id",
+			},
+			serviceMocks: serviceMocks{
+				FakeExistID: func(id string) (bool, error) {
+					return false, nil
+				},
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
+					return false, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "NORMAL: 既にある場合",
 			args: args{
@@ -161,7 +276,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -171,11 +286,11 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 					return &model.AnonyURL{
 						ID:       "id1",
 						Original: "http://localhost:8888/original1",
-						Short:    "http://localhost:8888/short1",
+						Short:    "short1",
 						Status:   1,
 					}, nil
 				},
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "id1", nil
 				},
 				FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
@@ -186,14 +301,14 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return true, nil
 				},
 			},
 			want: &model.AnonyURL{
 				ID:       "id1",
 				Original: "http://localhost:8888/original1",
-				Short:    "http://localhost:8888/short1",
+				Short:    "short1",
 				Status:   1,
 			},
 			wantErr: false,
@@ -205,14 +320,14 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
 			},
 			repoMocks: repoMocks{},
 			serviceMocks: serviceMocks{
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return false, fmt.Errorf("error")
 				},
 			},
@@ -226,7 +341,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -236,7 +351,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, fmt.Errorf("error")
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return false, nil
 				},
 			},
@@ -250,7 +365,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -260,7 +375,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return true, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return false, nil
 				},
 			},
@@ -274,7 +389,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -284,7 +399,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return false, nil
 				},
 			},
@@ -298,7 +413,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -308,7 +423,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 					return &model.AnonyURL{
 						ID:       "id1",
 						Original: "http://localhost:8888/original1",
-						Short:    "http://localhost:8888/short1",
+						Short:    "short1",
 						Status:   1,
 					}, nil
 				},
@@ -320,7 +435,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return false, nil
 				},
 			},
@@ -334,13 +449,13 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
 			},
 			repoMocks: repoMocks{
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "", fmt.Errorf("error")
 				},
 			},
@@ -348,7 +463,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return true, nil
 				},
 			},
@@ -362,7 +477,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -372,11 +487,11 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 					return &model.AnonyURL{
 						ID:       "id1",
 						Original: "http://localhost:8888/original1",
-						Short:    "http://localhost:8888/short1",
+						Short:    "short1",
 						Status:   1,
 					}, nil
 				},
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "id1", nil
 				},
 				FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
@@ -387,7 +502,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return true, nil
 				},
 			},
@@ -401,7 +516,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				userID: "user_id",
@@ -410,7 +525,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeFindByID: func(id string) (*model.AnonyURL, error) {
 					return nil, fmt.Errorf("error")
 				},
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "id1", nil
 				},
 				FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
@@ -421,7 +536,7 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				FakeExistID: func(id string) (bool, error) {
 					return false, nil
 				},
-				FakeExistOriginalInUser: func(original, userID string) (bool, error) {
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
 					return true, nil
 				},
 			},
@@ -445,6 +560,12 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 				repo:        repo,
 				transaction: transaction,
 				service:     service,
+				screener:    testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+				chain:       testutils.RedirectChainServiceMock{FakeResolve: resolveLoopExample},
+				webhooks:    testutils.WebhookServiceMock{FakeEnqueue: enqueueNothing},
+				events:      NewEventBus(0),
+				audit:       auditNothing,
+				quota:       quotaUnlimited,
 			}
 			got, err := u.SaveAnonyURL(tt.args.ctx, tt.args.an, tt.args.userID)
 			if (err != nil) != tt.wantErr {
@@ -458,12 +579,122 @@ func Test_anonyURLUseCase_SaveAnonyURL(t *testing.T) {
 	}
 }
 
+func Test_anonyURLUseCase_SaveCampaign(t *testing.T) {
+	db := testutils.GetTestDB().DB
+	transaction := datastore.NewTransaction(db)
+	channel := func(id, source string) *model.AnonyURL {
+		return &model.AnonyURL{
+			ID:       id,
+			Original: "http://localhost:8888/original1",
+			Short:    "short-" + id,
+			Status:   1,
+			UTM: model.UTM{
+				Source:   source,
+				Campaign: "campaign",
+			},
+		}
+	}
+	type args struct {
+		ctx    context.Context
+		ans    []*model.AnonyURL
+		userID string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []*model.AnonyURL
+		wantErr bool
+	}{
+		{
+			name: "NORMAL: チャネルごとに作成する",
+			args: args{
+				ctx:    context.Background(),
+				ans:    []*model.AnonyURL{channel("id1", "twitter"), channel("id2", "mail")},
+				userID: "user_id",
+			},
+			want:    []*model.AnonyURL{channel("id1", "twitter"), channel("id2", "mail")},
+			wantErr: false,
+		},
+		{
+			name: "ERROR: チャネルがない場合",
+			args: args{
+				ctx:    context.Background(),
+				ans:    []*model.AnonyURL{},
+				userID: "user_id",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: チャネルのsourceがない場合",
+			args: args{
+				ctx:    context.Background(),
+				ans:    []*model.AnonyURL{channel("id1", "twitter"), channel("id2", "")},
+				userID: "user_id",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: チャネルが重複している場合",
+			args: args{
+				ctx:    context.Background(),
+				ans:    []*model.AnonyURL{channel("id1", "twitter"), channel("id2", "twitter")},
+				userID: "user_id",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := map[string]*model.AnonyURL{}
+			repo := testutils.AnonyURLRepoMock{
+				FakeFindByID: func(id string) (*model.AnonyURL, error) {
+					return saved[id], nil
+				},
+				FakeSave: func(ctx context.Context, an *model.AnonyURL, userID string) error {
+					saved[an.ID] = an
+					return nil
+				},
+			}
+			service := testutils.AnonyURLServiceMock{
+				FakeExistID: func(id string) (bool, error) {
+					return false, nil
+				},
+				FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) {
+					return false, nil
+				},
+			}
+			u := &anonyURLUseCase{
+				repo:        repo,
+				transaction: transaction,
+				service:     service,
+				screener:    testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+				chain:       testutils.RedirectChainServiceMock{FakeResolve: resolveLoopExample},
+				webhooks:    testutils.WebhookServiceMock{FakeEnqueue: enqueueNothing},
+				events:      NewEventBus(0),
+				audit:       auditNothing,
+				quota:       quotaUnlimited,
+			}
+			got, err := u.SaveCampaign(tt.args.ctx, tt.args.ans, tt.args.userID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("anonyURLUseCase.SaveCampaign() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("anonyURLUseCase.SaveCampaign() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 	db := testutils.GetTestDB().DB
 	transaction := datastore.NewTransaction(db)
 	type repoMocks struct {
 		FakeFindByID            func(id string) (*model.AnonyURL, error)
-		FakeGetIDByOriginalUser func(original, userID string) (string, error)
+		FakeGetIDByOriginalUser func(original string, utm model.UTM, userID string) (string, error)
 		FakeUpdateStatus        func(ctx context.Context, id string, status int64) error
 	}
 	type args struct {
@@ -492,11 +723,11 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 					return &model.AnonyURL{
 						ID:       "id1",
 						Original: "http://localhost:8888/original1",
-						Short:    "http://localhost:8888/short1",
+						Short:    "short1",
 						Status:   1,
 					}, nil
 				},
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "id1", nil
 				},
 				FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
@@ -506,7 +737,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 			want: &model.AnonyURL{
 				ID:       "id1",
 				Original: "http://localhost:8888/original1",
-				Short:    "http://localhost:8888/short1",
+				Short:    "short1",
 				Status:   1,
 			},
 			wantErr: false,
@@ -544,7 +775,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 				status:   1,
 			},
 			repoMocks: repoMocks{
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "", fmt.Errorf("error")
 				},
 			},
@@ -560,7 +791,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 				status:   1,
 			},
 			repoMocks: repoMocks{
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "", nil
 				},
 			},
@@ -576,7 +807,10 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 				status:   1,
 			},
 			repoMocks: repoMocks{
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeFindByID: func(id string) (*model.AnonyURL, error) {
+					return &model.AnonyURL{ID: id, Status: 2}, nil
+				},
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "id1", nil
 				},
 				FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
@@ -598,7 +832,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 				FakeFindByID: func(id string) (*model.AnonyURL, error) {
 					return nil, fmt.Errorf("error")
 				},
-				FakeGetIDByOriginalUser: func(original, userID string) (string, error) {
+				FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) {
 					return "id1", nil
 				},
 				FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
@@ -621,8 +855,13 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus(t *testing.T) {
 				repo:        repo,
 				transaction: transaction,
 				service:     service,
+				screener:    testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+				webhooks:    testutils.WebhookServiceMock{FakeEnqueue: enqueueNothing},
+				events:      NewEventBus(0),
+				audit:       auditNothing,
+				quota:       quotaUnlimited,
 			}
-			got, err := u.UpdateAnonyURLStatus(tt.args.ctx, tt.args.original, tt.args.userID, tt.args.status)
+			got, err := u.UpdateAnonyURLStatus(tt.args.ctx, tt.args.original, model.UTM{}, tt.args.userID, tt.args.status)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("anonyURLUseCase.UpdateAnonyURLStatus() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -666,13 +905,13 @@ func Test_anonyURLUseCase_ListAnonyURLs(t *testing.T) {
 						{
 							ID:       "id1",
 							Original: "http://localhost:8888/original1",
-							Short:    "http://localhost:8888/short1",
+							Short:    "short1",
 							Status:   1,
 						},
 						{
 							ID:       "id2",
 							Original: "http://localhost:8888/original2",
-							Short:    "http://localhost:8888/short2",
+							Short:    "short2",
 							Status:   2,
 						},
 					}, nil
@@ -682,13 +921,13 @@ func Test_anonyURLUseCase_ListAnonyURLs(t *testing.T) {
 				{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				{
 					ID:       "id2",
 					Original: "http://localhost:8888/original2",
-					Short:    "http://localhost:8888/short2",
+					Short:    "short2",
 					Status:   2,
 				},
 			},
@@ -707,13 +946,13 @@ func Test_anonyURLUseCase_ListAnonyURLs(t *testing.T) {
 						{
 							ID:       "id1",
 							Original: "http://localhost:8888/original1",
-							Short:    "http://localhost:8888/short1",
+							Short:    "short1",
 							Status:   1,
 						},
 						{
 							ID:       "id2",
 							Original: "http://localhost:8888/original2",
-							Short:    "http://localhost:8888/short2",
+							Short:    "short2",
 							Status:   1,
 						},
 					}, nil
@@ -723,13 +962,13 @@ func Test_anonyURLUseCase_ListAnonyURLs(t *testing.T) {
 				{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   1,
 				},
 				{
 					ID:       "id2",
 					Original: "http://localhost:8888/original2",
-					Short:    "http://localhost:8888/short2",
+					Short:    "short2",
 					Status:   1,
 				},
 			},
@@ -748,13 +987,13 @@ func Test_anonyURLUseCase_ListAnonyURLs(t *testing.T) {
 						{
 							ID:       "id1",
 							Original: "http://localhost:8888/original1",
-							Short:    "http://localhost:8888/short1",
+							Short:    "short1",
 							Status:   2,
 						},
 						{
 							ID:       "id2",
 							Original: "http://localhost:8888/original2",
-							Short:    "http://localhost:8888/short2",
+							Short:    "short2",
 							Status:   2,
 						},
 					}, nil
@@ -764,13 +1003,13 @@ func Test_anonyURLUseCase_ListAnonyURLs(t *testing.T) {
 				{
 					ID:       "id1",
 					Original: "http://localhost:8888/original1",
-					Short:    "http://localhost:8888/short1",
+					Short:    "short1",
 					Status:   2,
 				},
 				{
 					ID:       "id2",
 					Original: "http://localhost:8888/original2",
-					Short:    "http://localhost:8888/short2",
+					Short:    "short2",
 					Status:   2,
 				},
 			},
@@ -861,83 +1100,207 @@ func Test_anonyURLUseCase_GetOriginalByAnonyURL(t *testing.T) {
 	db := testutils.GetTestDB().DB
 	transaction := datastore.NewTransaction(db)
 	type repoMocks struct {
-		FakeFindByAnonyURL func(anonyURL string) (*model.AnonyURL, error)
+		FakeFindByAnonyURL func(domainID, anonyURL string) (*model.AnonyURL, error)
+	}
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	type variantRepoMocks struct {
+		FakeFindByAnonyURLID func(anonyURLID string) ([]*model.Variant, error)
 	}
 	type args struct {
 		ctx      context.Context
+		domainID string
 		anonyURL string
 	}
 	tests := []struct {
-		name      string
-		args      args
-		repoMocks repoMocks
-		want      string
-		wantErr   bool
+		name             string
+		args             args
+		repoMocks        repoMocks
+		variantRepoMocks variantRepoMocks
+		want             *model.AnonyURL
+		wantErr          bool
 	}{
 		{
-			name: "NORMAL: Original URLを返す",
+			name: "NORMAL: AnonyURLを返す",
+			args: args{
+				ctx:      context.Background(),
+				anonyURL: "aaaabbbb",
+			},
+			repoMocks: repoMocks{
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+					return &model.AnonyURL{
+						ID:       "id",
+						Original: "http://localhost:8888/original",
+						Short:    "aaaabbbb",
+						Status:   1,
+					}, nil
+				},
+			},
+			variantRepoMocks: variantRepoMocks{
+				FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.Variant, error) {
+					return []*model.Variant{}, nil
+				},
+			},
+			want: &model.AnonyURL{
+				ID:       "id",
+				Original: "http://localhost:8888/original",
+				Short:    "aaaabbbb",
+				Status:   1,
+				Variants: []*model.Variant{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: Variantを含めて返す",
 			args: args{
 				ctx:      context.Background(),
-				anonyURL: "http://localhost:8888/aaaabbbb/ccccdddd",
+				anonyURL: "aaaabbbb",
 			},
 			repoMocks: repoMocks{
-				FakeFindByAnonyURL: func(anonyURL string) (*model.AnonyURL, error) {
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
 					return &model.AnonyURL{
 						ID:       "id",
 						Original: "http://localhost:8888/original",
-						Short:    "http://localhost:8888/aaaabbbb/ccccdddd",
+						Short:    "aaaabbbb",
 						Status:   1,
 					}, nil
 				},
 			},
-			want:    "http://localhost:8888/original",
+			variantRepoMocks: variantRepoMocks{
+				FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.Variant, error) {
+					return []*model.Variant{
+						{ID: "a", AnonyURLID: "id", Destination: "http://localhost:8888/a", Weight: 1},
+					}, nil
+				},
+			},
+			want: &model.AnonyURL{
+				ID:       "id",
+				Original: "http://localhost:8888/original",
+				Short:    "aaaabbbb",
+				Status:   1,
+				Variants: []*model.Variant{
+					{ID: "a", AnonyURLID: "id", Destination: "http://localhost:8888/a", Weight: 1},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: 有効期間外の場合はFallbackを返す",
+			args: args{
+				ctx:      context.Background(),
+				anonyURL: "aaaabbbb",
+			},
+			repoMocks: repoMocks{
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+					return &model.AnonyURL{
+						ID:          "id",
+						Original:    "http://localhost:8888/original",
+						Short:       "aaaabbbb",
+						Status:      1,
+						ActiveUntil: &past,
+						Fallback:    "http://localhost:8888/closed",
+					}, nil
+				},
+			},
+			want: &model.AnonyURL{
+				ID:          "id",
+				Original:    "http://localhost:8888/closed",
+				Short:       "aaaabbbb",
+				Status:      1,
+				ActiveUntil: &past,
+				Fallback:    "http://localhost:8888/closed",
+			},
 			wantErr: false,
 		},
 		{
-			name: "NORMAL: Statusが1以外の時は空文字を返す",
+			name: "NORMAL: 有効期間外でFallbackがない場合はnilを返す",
+			args: args{
+				ctx:      context.Background(),
+				anonyURL: "aaaabbbb",
+			},
+			repoMocks: repoMocks{
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+					return &model.AnonyURL{
+						ID:         "id",
+						Original:   "http://localhost:8888/original",
+						Short:      "aaaabbbb",
+						Status:     1,
+						ActiveFrom: &future,
+					}, nil
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "ERROR: variantRepo.FindByAnonyURLIDでErrorを返す場合",
+			args: args{
+				ctx:      context.Background(),
+				anonyURL: "aaaabbbb",
+			},
+			repoMocks: repoMocks{
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
+					return &model.AnonyURL{
+						ID:       "id",
+						Original: "http://localhost:8888/original",
+						Short:    "aaaabbbb",
+						Status:   1,
+					}, nil
+				},
+			},
+			variantRepoMocks: variantRepoMocks{
+				FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.Variant, error) {
+					return nil, fmt.Errorf("error")
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "NORMAL: Statusが1以外の時はnilを返す",
 			args: args{
 				ctx:      context.Background(),
-				anonyURL: "http://localhost:8888/aaaabbbb/ccccdddd",
+				anonyURL: "aaaabbbb",
 			},
 			repoMocks: repoMocks{
-				FakeFindByAnonyURL: func(anonyURL string) (*model.AnonyURL, error) {
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
 					return &model.AnonyURL{
 						ID:       "id",
 						Original: "http://localhost:8888/original",
-						Short:    "http://localhost:8888/aaaabbbb/ccccdddd",
+						Short:    "aaaabbbb",
 						Status:   0,
 					}, nil
 				},
 			},
-			want:    "",
+			want:    nil,
 			wantErr: false,
 		},
 		{
-			name: "NORMAL: AnonyURLが見つからなかった場合は空文字を返す",
+			name: "NORMAL: AnonyURLが見つからなかった場合はnilを返す",
 			args: args{
 				ctx:      context.Background(),
-				anonyURL: "http://localhost:8888/aaaabbbb/ccccdddd",
+				anonyURL: "aaaabbbb",
 			},
 			repoMocks: repoMocks{
-				FakeFindByAnonyURL: func(anonyURL string) (*model.AnonyURL, error) {
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
 					return nil, nil
 				},
 			},
-			want:    "",
+			want:    nil,
 			wantErr: false,
 		},
 		{
 			name: "ERROR: FindByAnonyURLでErrorを返す場合",
 			args: args{
 				ctx:      context.Background(),
-				anonyURL: "http://localhost:8888/aaaabbbb/ccccdddd",
+				anonyURL: "aaaabbbb",
 			},
 			repoMocks: repoMocks{
-				FakeFindByAnonyURL: func(anonyURL string) (*model.AnonyURL, error) {
+				FakeFindByAnonyURL: func(domainID, anonyURL string) (*model.AnonyURL, error) {
 					return nil, fmt.Errorf("error")
 				},
 			},
-			want:    "",
+			want:    nil,
 			wantErr: true,
 		},
 	}
@@ -947,30 +1310,395 @@ func Test_anonyURLUseCase_GetOriginalByAnonyURL(t *testing.T) {
 				FakeFindByAnonyURL: tt.repoMocks.FakeFindByAnonyURL,
 			}
 			service := testutils.AnonyURLServiceMock{}
+			variantRepo := testutils.VariantRepoMock{
+				FakeFindByAnonyURLID: tt.variantRepoMocks.FakeFindByAnonyURLID,
+			}
 			u := &anonyURLUseCase{
 				repo:        repo,
+				variantRepo: variantRepo,
 				transaction: transaction,
 				service:     service,
 			}
-			got, err := u.GetOriginalByAnonyURL(tt.args.ctx, tt.args.anonyURL)
+			got, err := u.GetOriginalByAnonyURL(tt.args.ctx, tt.args.domainID, tt.args.anonyURL)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("anonyURLUseCase.GetOriginalByAnonyURL() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if got != tt.want {
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("anonyURLUseCase.GetOriginalByAnonyURL() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func Test_anonyURLUseCase_RecordClick(t *testing.T) {
+	db := testutils.GetTestDB().DB
+	transaction := datastore.NewTransaction(db)
+	tests := []struct {
+		name        string
+		variantID   string
+		urlErr      error
+		wantURL     []string
+		wantVariant []string
+		wantErr     bool
+	}{
+		{
+			name:        "NORMAL: Variantがない場合はAnonyURLのみ数える",
+			variantID:   "",
+			wantURL:     []string{"url-id"},
+			wantVariant: nil,
+			wantErr:     false,
+		},
+		{
+			name:        "NORMAL: Variantがある場合は両方数える",
+			variantID:   "variant-id",
+			wantURL:     []string{"url-id"},
+			wantVariant: []string{"variant-id"},
+			wantErr:     false,
+		},
+		{
+			name:        "ERROR: repo.IncrementClicksがErrorを返す",
+			variantID:   "variant-id",
+			urlErr:      fmt.Errorf("error"),
+			wantURL:     []string{"url-id"},
+			wantVariant: nil,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotURL, gotVariant []string
+			var gotEvent *model.WebhookEvent
+			var published []model.Event
+			u := &anonyURLUseCase{
+				repo: testutils.AnonyURLRepoMock{
+					FakeIncrementClicks: func(ctx context.Context, id string) error {
+						gotURL = append(gotURL, id)
+						return tt.urlErr
+					},
+					FakeGetUserIDByID: func(id string) (string, error) {
+						return "user-id", nil
+					},
+				},
+				variantRepo: testutils.VariantRepoMock{
+					FakeIncrementClicks: func(ctx context.Context, id string) error {
+						gotVariant = append(gotVariant, id)
+						return nil
+					},
+				},
+				transaction: transaction,
+				webhooks: testutils.WebhookServiceMock{
+					FakeEnqueue: func(ctx context.Context, userID string, e *model.WebhookEvent) error {
+						gotEvent = e
+						return nil
+					},
+				},
+				events: eventRecorder(func(e model.Event) {
+					published = append(published, e)
+				}),
+			}
+			err := u.RecordClick(context.Background(), &model.AnonyURL{ID: "url-id", Clicks: 4}, tt.variantID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("anonyURLUseCase.RecordClick() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotURL, tt.wantURL) || !reflect.DeepEqual(gotVariant, tt.wantVariant) {
+				t.Errorf("anonyURLUseCase.RecordClick() url = %v, variant = %v, want %v, %v", gotURL, gotVariant, tt.wantURL, tt.wantVariant)
+			}
+			if err != nil {
+				return
+			}
+			// クリック後の回数と使われたVariantを通知する
+			if gotEvent == nil || gotEvent.Type != model.WebhookEventAnonyURLClicked || gotEvent.AnonyURL.Clicks != 5 || gotEvent.VariantID != tt.variantID {
+				t.Errorf("anonyURLUseCase.RecordClick() event = %+v", gotEvent)
+			}
+			if len(published) != 1 || published[0].(model.AnonyURLClicked).UserID != "user-id" || published[0].(model.AnonyURLClicked).VariantID != tt.variantID {
+				t.Errorf("anonyURLUseCase.RecordClick() published = %+v", published)
+			}
+		})
+	}
+}
+
+func Test_anonyURLUseCase_UpdateAnnotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		title    string
+		metadata map[string]string
+		want     *model.AnonyURL
+		wantErr  bool
+	}{
+		{
+			name:     "NORMAL: タイトル, メモ, メタデータを置き換える",
+			original: "https://example.com",
+			title:    " My link ",
+			metadata: map[string]string{"owner": "team-a"},
+			want:     &model.AnonyURL{ID: "id1", Title: "My link", Notes: "memo", Metadata: map[string]string{"owner": "team-a"}},
+			wantErr:  false,
+		},
+		{
+			name:     "ERROR: リンクが存在しない",
+			original: "https://unknown.example/",
+			wantErr:  true,
+		},
+		{
+			name:     "ERROR: タイトルが長すぎる",
+			original: "https://example.com/",
+			title:    strings.Repeat("a", model.MaxTitleLength+1),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *model.AnonyURL
+			var published []string
+			u := &anonyURLUseCase{
+				repo: testutils.AnonyURLRepoMock{
+					FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+						if original == "https://example.com/" {
+							return &model.AnonyURL{ID: "id1", Original: original}, nil
+						}
+						return nil, nil
+					},
+					FakeUpdateAnnotation: func(ctx context.Context, id string, title, notes string, metadata map[string]string) error {
+						updated = &model.AnonyURL{ID: id, Title: title, Notes: notes, Metadata: metadata}
+						return nil
+					},
+					FakeFindByID: func(id string) (*model.AnonyURL, error) {
+						return updated, nil
+					},
+				},
+				transaction: testutils.TransactionMock{},
+				audit:       auditNothing,
+				events: eventRecorder(func(e model.Event) {
+					published = append(published, e.EventName())
+				}),
+			}
+			got, err := u.UpdateAnnotation(context.Background(), tt.original, model.UTM{}, "user", tt.title, "memo", tt.metadata)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("anonyURLUseCase.UpdateAnnotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if updated != nil || len(published) != 0 {
+					t.Errorf("anonyURLUseCase.UpdateAnnotation() updated %+v, published %v", updated, published)
+				}
+				return
+			}
+			// リダイレクトサーバーのキャッシュを無効化できるように, 変更を発行する
+			if !reflect.DeepEqual(published, []string{model.EventAnonyURLUpdated}) {
+				t.Errorf("anonyURLUseCase.UpdateAnnotation() published = %v", published)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("anonyURLUseCase.UpdateAnnotation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_anonyURLUseCase_SaveAnonyURL_Quota(t *testing.T) {
+	tests := []struct {
+		name          string
+		exist         bool
+		status        int64
+		wantCreated   int64
+		wantActivated int64
+	}{
+		{name: "ERROR: 有効なリンクの作成", exist: false, status: 1, wantCreated: 1, wantActivated: 1},
+		{name: "ERROR: 無効なリンクの作成", exist: false, status: 2, wantCreated: 1, wantActivated: 0},
+		{name: "ERROR: 無効な登録済みのリンクの有効化", exist: true, status: 1, wantCreated: 0, wantActivated: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := false
+			u := &anonyURLUseCase{
+				repo: testutils.AnonyURLRepoMock{
+					FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) { return "id1", nil },
+					FakeFindByID: func(id string) (*model.AnonyURL, error) {
+						return &model.AnonyURL{ID: id, Status: 2}, nil
+					},
+					FakeSave: func(ctx context.Context, an *model.AnonyURL, userID string) error {
+						saved = true
+						return nil
+					},
+					FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
+						saved = true
+						return nil
+					},
+				},
+				transaction: testutils.TransactionMock{},
+				service: testutils.AnonyURLServiceMock{
+					FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) { return tt.exist, nil },
+					FakeExistID:             func(id string) (bool, error) { return false, nil },
+				},
+				screener: testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+				chain:    testutils.RedirectChainServiceMock{FakeResolve: resolveLoopExample},
+				quota: testutils.QuotaServiceMock{
+					FakeCheck: func(ctx context.Context, userID string, created, activated int64) error {
+						if created != tt.wantCreated || activated != tt.wantActivated {
+							t.Errorf("QuotaService.Check() created = %v, activated = %v, want %v, %v", created, activated, tt.wantCreated, tt.wantActivated)
+						}
+						return &service.QuotaExceededError{Kind: model.QuotaAnonyURLs, Max: 1}
+					},
+				},
+				events: NewEventBus(0),
+			}
+			an := &model.AnonyURL{ID: "id2", Original: "https://example.com/", Short: "abcdefg", Status: tt.status}
+			_, err := u.SaveAnonyURL(context.Background(), an, "user")
+			var exceeded *service.QuotaExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("anonyURLUseCase.SaveAnonyURL() error = %v, want QuotaExceededError", err)
+			}
+			if saved {
+				t.Errorf("anonyURLUseCase.SaveAnonyURL() saved the AnonyURL exceeding the quota")
+			}
+		})
+	}
+}
+
+func Test_anonyURLUseCase_SaveAnonyURL_Event(t *testing.T) {
+	tests := []struct {
+		name      string
+		exist     bool
+		wantNames []string
+	}{
+		{name: "NORMAL: 新しいリンクの作成", exist: false, wantNames: []string{model.EventAnonyURLCreated}},
+		// キャッシュを無効化できるように, 登録済みのリンクはステータスの変更として発行する
+		{name: "NORMAL: 登録済みのリンクの保存", exist: true, wantNames: []string{model.EventAnonyURLStatusChanged}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var published []model.Event
+			u := &anonyURLUseCase{
+				repo: testutils.AnonyURLRepoMock{
+					FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) { return "id1", nil },
+					FakeFindByID: func(id string) (*model.AnonyURL, error) {
+						return &model.AnonyURL{ID: id, Short: "abcdefg", Status: 2}, nil
+					},
+					FakeSave:         func(ctx context.Context, an *model.AnonyURL, userID string) error { return nil },
+					FakeUpdateStatus: func(ctx context.Context, id string, status int64) error { return nil },
+				},
+				transaction: testutils.TransactionMock{},
+				service: testutils.AnonyURLServiceMock{
+					FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) { return tt.exist, nil },
+					FakeExistID:             func(id string) (bool, error) { return false, nil },
+				},
+				screener: testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+				chain:    testutils.RedirectChainServiceMock{FakeResolve: resolveLoopExample},
+				webhooks: testutils.WebhookServiceMock{FakeEnqueue: enqueueNothing},
+				audit:    auditNothing,
+				quota:    quotaUnlimited,
+				events: eventRecorder(func(e model.Event) {
+					published = append(published, e)
+				}),
+			}
+			an := &model.AnonyURL{ID: "id2", Original: "https://example.com/", Short: "abcdefg", Status: 1}
+			if _, err := u.SaveAnonyURL(context.Background(), an, "user"); err != nil {
+				t.Fatalf("anonyURLUseCase.SaveAnonyURL() error = %v", err)
+			}
+			got := []string{}
+			for _, e := range published {
+				got = append(got, e.EventName())
+			}
+			if !reflect.DeepEqual(got, tt.wantNames) {
+				t.Errorf("anonyURLUseCase.SaveAnonyURL() published = %v, want %v", got, tt.wantNames)
+			}
+		})
+	}
+}
+
+func Test_anonyURLUseCase_SaveAnonyURL_Conflict(t *testing.T) {
+	tests := []struct {
+		name     string
+		domainID string
+		wantErr  error
+	}{
+		{name: "NORMAL: 登録済みのリンクと同じドメイン", domainID: "domain", wantErr: nil},
+		{name: "ERROR: 登録済みのリンクと別のドメイン", domainID: "", wantErr: ErrAnonyURLConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			u := &anonyURLUseCase{
+				repo: testutils.AnonyURLRepoMock{
+					FakeGetIDByOriginalUser: func(original string, utm model.UTM, userID string) (string, error) { return "id1", nil },
+					FakeFindByID: func(id string) (*model.AnonyURL, error) {
+						return &model.AnonyURL{ID: id, Short: "abcdefg", DomainID: "domain", Status: 2}, nil
+					},
+					FakeUpdateStatus: func(ctx context.Context, id string, status int64) error {
+						updated = true
+						return nil
+					},
+				},
+				transaction: testutils.TransactionMock{},
+				service: testutils.AnonyURLServiceMock{
+					FakeExistOriginalInUser: func(original string, utm model.UTM, userID string) (bool, error) { return true, nil },
+					FakeExistID:             func(id string) (bool, error) { return false, nil },
+				},
+				screener: testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+				chain:    testutils.RedirectChainServiceMock{FakeResolve: resolveLoopExample},
+				audit:    auditNothing,
+				quota:    quotaUnlimited,
+				events:   NewEventBus(0),
+			}
+			an := &model.AnonyURL{ID: "id2", Original: "https://example.com/", Short: "hijklmn", DomainID: tt.domainID, Status: 1}
+			_, err := u.SaveAnonyURL(context.Background(), an, "user")
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("anonyURLUseCase.SaveAnonyURL() error = %v, want %v", err, tt.wantErr)
+			}
+			if updated != (tt.wantErr == nil) {
+				t.Errorf("anonyURLUseCase.SaveAnonyURL() updated = %v", updated)
+			}
+		})
+	}
+}
+
+// screenBlockedExample blocks destinations on blocked.example
+func screenBlockedExample(ctx context.Context, destination string) (string, error) {
+	if strings.HasPrefix(destination, "http://blocked.example/") {
+		return "blocked.example is in the blocklist", nil
+	}
+	return "", nil
+}
+
+func enqueueNothing(ctx context.Context, userID string, e *model.WebhookEvent) error {
+	return nil
+}
+
+// auditNothing is an AuditService recording nothing
+var auditNothing = testutils.AuditServiceMock{
+	FakeRecord: func(ctx context.Context, actorID, action, targetType, targetID string, before, after interface{}) error {
+		return nil
+	},
+}
+
+// quotaUnlimited is a QuotaService with no limits
+var quotaUnlimited = testutils.QuotaServiceMock{
+	FakeCheck: func(ctx context.Context, userID string, created, activated int64) error {
+		return nil
+	},
+}
+
+// resolveLoopExample treats destinations on loop.example as redirect loops
+func resolveLoopExample(destination string, self *model.AnonyURL) (string, error) {
+	if strings.HasPrefix(destination, "http://loop.example/") {
+		return "", service.ErrRedirectLoop
+	}
+	return destination, nil
+}
+
 // Test With DB
 func SetAnonyURLUseCase() AnonyURLUseCase {
 	db := testutils.GetTestDB().DB
 	transaction := datastore.NewTransaction(db)
 	repository := datastore.NewAnonyURLRepository(db)
+	variantRepository := datastore.NewVariantRepository(db)
+	noDomains := testutils.VerifiedDomainFinderMock{FakeFindVerifiedByName: func(name string) (*model.Domain, error) { return nil, nil }}
+	chain := service.NewRedirectChainService(repository, noDomains, []string{"http://localhost-test"})
+	webhooks := service.NewWebhookService(datastore.NewWebhookRepository(db), datastore.NewWebhookDeliveryRepository(db))
+	audit := service.NewAuditService(datastore.NewAuditEventRepository(db))
+	quota := service.NewQuotaService(datastore.NewQuotaRepository(db), model.Quota{})
 	service := service.NewAnonyURLService(repository)
-	return NewAnonyURLUseCase(repository, transaction, service)
+	return NewAnonyURLUseCase(repository, variantRepository, transaction, service, screener.NewNopScreener(), chain, webhooks, NewEventBus(0), audit, quota)
 }
 
 func Test_anonyURLUseCase_SaveAnonyURL_DB(t *testing.T) {
@@ -994,16 +1722,17 @@ func Test_anonyURLUseCase_SaveAnonyURL_DB(t *testing.T) {
 				an: &model.AnonyURL{
 					ID:       "id",
 					Original: "http://localhost-test/original",
-					Short:    "http://localhost-test/short",
+					Short:    "short",
 					Status:   1,
 				},
 				userID: "id1",
 			},
 			want: &model.AnonyURL{
-				ID:       "id",
-				Original: "http://localhost-test/original",
-				Short:    "http://localhost-test/short",
-				Status:   1,
+				ID:           "id",
+				Original:     "http://localhost-test/original",
+				Short:        "short",
+				Status:       1,
+				RedirectMode: 302,
 			},
 			pluss:   true,
 			wantErr: false,
@@ -1014,17 +1743,18 @@ func Test_anonyURLUseCase_SaveAnonyURL_DB(t *testing.T) {
 				ctx: context.Background(),
 				an: &model.AnonyURL{
 					ID:       "id",
-					Original: "original1",
+					Original: "http://localhost-test/original1",
 					Short:    "short1",
 					Status:   1,
 				},
 				userID: "id1",
 			},
 			want: &model.AnonyURL{
-				ID:       "id1",
-				Original: "original1",
-				Short:    "short1",
-				Status:   1,
+				ID:           "id1",
+				Original:     "http://localhost-test/original1",
+				Short:        "short1",
+				Status:       1,
+				RedirectMode: 302,
 			},
 			pluss:   false,
 			wantErr: false,
@@ -1035,17 +1765,18 @@ func Test_anonyURLUseCase_SaveAnonyURL_DB(t *testing.T) {
 				ctx: context.Background(),
 				an: &model.AnonyURL{
 					ID:       "id",
-					Original: "original3",
+					Original: "http://localhost-test/original3",
 					Short:    "short3",
 					Status:   1,
 				},
 				userID: "id1",
 			},
 			want: &model.AnonyURL{
-				ID:       "id3",
-				Original: "original3",
-				Short:    "short3",
-				Status:   1,
+				ID:           "id3",
+				Original:     "http://localhost-test/original3",
+				Short:        "short3",
+				Status:       1,
+				RedirectMode: 302,
 			},
 			pluss:   false,
 			wantErr: false,
@@ -1056,7 +1787,7 @@ func Test_anonyURLUseCase_SaveAnonyURL_DB(t *testing.T) {
 				ctx: context.Background(),
 				an: &model.AnonyURL{
 					ID:       "id1",
-					Original: "original1",
+					Original: "http://localhost-test/original1",
 					Short:    "short3",
 					Status:   1,
 				},
@@ -1084,6 +1815,7 @@ func Test_anonyURLUseCase_SaveAnonyURL_DB(t *testing.T) {
 				t.Errorf("anonyURLUseCase.SaveAnonyURL() before count = %v, after count = %v", bCount, aCount)
 			}
 
+			withoutCreatedAt(got)
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("anonyURLUseCase.SaveAnonyURL() = %v, want %v", got, tt.want)
 			}
@@ -1111,15 +1843,16 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			name: "NORMAL: status1のものを2に更新する",
 			args: args{
 				ctx:      context.Background(),
-				original: "original1",
+				original: "http://localhost-test/original1",
 				userID:   "id1",
 				status:   2,
 			},
 			want: &model.AnonyURL{
-				ID:       "id1",
-				Original: "original1",
-				Short:    "short1",
-				Status:   2,
+				ID:           "id1",
+				Original:     "http://localhost-test/original1",
+				Short:        "short1",
+				Status:       2,
+				RedirectMode: 302,
 			},
 			wantErr: false,
 		},
@@ -1127,15 +1860,16 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			name: "NORMAL: status1のものを1に更新する",
 			args: args{
 				ctx:      context.Background(),
-				original: "original1",
+				original: "http://localhost-test/original1",
 				userID:   "id1",
 				status:   1,
 			},
 			want: &model.AnonyURL{
-				ID:       "id1",
-				Original: "original1",
-				Short:    "short1",
-				Status:   1,
+				ID:           "id1",
+				Original:     "http://localhost-test/original1",
+				Short:        "short1",
+				Status:       1,
+				RedirectMode: 302,
 			},
 			wantErr: false,
 		},
@@ -1143,15 +1877,16 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			name: "NORMAL: status2のものを1に更新する",
 			args: args{
 				ctx:      context.Background(),
-				original: "original3",
+				original: "http://localhost-test/original3",
 				userID:   "id1",
 				status:   1,
 			},
 			want: &model.AnonyURL{
-				ID:       "id3",
-				Original: "original3",
-				Short:    "short3",
-				Status:   1,
+				ID:           "id3",
+				Original:     "http://localhost-test/original3",
+				Short:        "short3",
+				Status:       1,
+				RedirectMode: 302,
 			},
 			wantErr: false,
 		},
@@ -1159,7 +1894,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			name: "ERROR: originalが存在しないものの場合",
 			args: args{
 				ctx:      context.Background(),
-				original: "original11",
+				original: "http://localhost-test/original11",
 				userID:   "id1",
 				status:   1,
 			},
@@ -1170,7 +1905,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			name: "ERROR: userIDが存在しないものの場合",
 			args: args{
 				ctx:      context.Background(),
-				original: "original1",
+				original: "http://localhost-test/original1",
 				userID:   "id11",
 				status:   1,
 			},
@@ -1181,7 +1916,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			name: "ERROR: statusが2よりも大きいものの場合",
 			args: args{
 				ctx:      context.Background(),
-				original: "original1",
+				original: "http://localhost-test/original1",
 				userID:   "id1",
 				status:   3,
 			},
@@ -1192,7 +1927,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			name: "ERROR: statusが1よりも小さいものの場合",
 			args: args{
 				ctx:      context.Background(),
-				original: "original1",
+				original: "http://localhost-test/original1",
 				userID:   "id1",
 				status:   0,
 			},
@@ -1206,7 +1941,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			testutils.ClearUserData()
 			testutils.InsertURLData()
 			bCount := testutils.CountURLData()
-			got, err := u.UpdateAnonyURLStatus(tt.args.ctx, tt.args.original, tt.args.userID, tt.args.status)
+			got, err := u.UpdateAnonyURLStatus(tt.args.ctx, tt.args.original, model.UTM{}, tt.args.userID, tt.args.status)
 			aCount := testutils.CountURLData()
 			if (err != nil) != tt.wantErr {
 				t.Errorf("anonyURLUseCase.UpdateAnonyURLStatus() error = %v, wantErr %v", err, tt.wantErr)
@@ -1215,6 +1950,7 @@ func Test_anonyURLUseCase_UpdateAnonyURLStatus_DB(t *testing.T) {
 			if aCount != bCount {
 				t.Errorf("anonyURLUseCase.UpdateAnonyURLStatus() before count = %v, after count = %v", bCount, aCount)
 			}
+			withoutCreatedAt(got)
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("anonyURLUseCase.UpdateAnonyURLStatus() = %v, want %v", got, tt.want)
 			}
@@ -1245,11 +1981,11 @@ func Test_anonyURLUseCase_ListAnonyURLs_DB(t *testing.T) {
 				q:      0,
 			},
 			want: []*model.AnonyURL{
-				{ID: "id1", Original: "original1", Short: "short1", Status: 1},
-				{ID: "id2", Original: "original2", Short: "short2", Status: 1},
-				{ID: "id3", Original: "original3", Short: "short3", Status: 2},
-				{ID: "id4", Original: "original4", Short: "short4", Status: 2},
-				{ID: "id5", Original: "original5", Short: "short5", Status: 2},
+				{ID: "id1", Original: "http://localhost-test/original1", Short: "short1", Status: 1, RedirectMode: 302},
+				{ID: "id2", Original: "http://localhost-test/original2", Short: "short2", Status: 1, RedirectMode: 302},
+				{ID: "id3", Original: "http://localhost-test/original3", Short: "short3", Status: 2, RedirectMode: 302},
+				{ID: "id4", Original: "http://localhost-test/original4", Short: "short4", Status: 2, RedirectMode: 302},
+				{ID: "id5", Original: "http://localhost-test/original5", Short: "short5", Status: 2, RedirectMode: 302},
 			},
 			wantErr: false,
 		},
@@ -1261,8 +1997,8 @@ func Test_anonyURLUseCase_ListAnonyURLs_DB(t *testing.T) {
 				q:      1,
 			},
 			want: []*model.AnonyURL{
-				{ID: "id1", Original: "original1", Short: "short1", Status: 1},
-				{ID: "id2", Original: "original2", Short: "short2", Status: 1},
+				{ID: "id1", Original: "http://localhost-test/original1", Short: "short1", Status: 1, RedirectMode: 302},
+				{ID: "id2", Original: "http://localhost-test/original2", Short: "short2", Status: 1, RedirectMode: 302},
 			},
 			wantErr: false,
 		},
@@ -1274,9 +2010,9 @@ func Test_anonyURLUseCase_ListAnonyURLs_DB(t *testing.T) {
 				q:      2,
 			},
 			want: []*model.AnonyURL{
-				{ID: "id3", Original: "original3", Short: "short3", Status: 2},
-				{ID: "id4", Original: "original4", Short: "short4", Status: 2},
-				{ID: "id5", Original: "original5", Short: "short5", Status: 2},
+				{ID: "id3", Original: "http://localhost-test/original3", Short: "short3", Status: 2, RedirectMode: 302},
+				{ID: "id4", Original: "http://localhost-test/original4", Short: "short4", Status: 2, RedirectMode: 302},
+				{ID: "id5", Original: "http://localhost-test/original5", Short: "short5", Status: 2, RedirectMode: 302},
 			},
 			wantErr: false,
 		},
@@ -1321,6 +2057,7 @@ func Test_anonyURLUseCase_ListAnonyURLs_DB(t *testing.T) {
 				t.Errorf("anonyURLUseCase.ListAnonyURLs() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			withoutCreatedAt(got...)
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("anonyURLUseCase.ListAnonyURLs() = %v, want %v", got, tt.want)
 			}
@@ -1334,30 +2071,38 @@ func Test_anonyURLUseCase_GetOriginalByAnonyURL_DB(t *testing.T) {
 	u := SetAnonyURLUseCase()
 	type args struct {
 		ctx      context.Context
+		domainID string
 		anonyURL string
 	}
 	tests := []struct {
 		name    string
 		args    args
-		want    string
+		want    *model.AnonyURL
 		wantErr bool
 	}{
 		{
-			name: "NORMAL: AnonyURLからOriginal URLを取得できる",
+			name: "NORMAL: AnonyURLを取得できる",
 			args: args{
 				ctx:      context.Background(),
 				anonyURL: "short1",
 			},
-			want:    "original1",
+			want: &model.AnonyURL{
+				ID:           "id1",
+				Original:     "http://localhost-test/original1",
+				Short:        "short1",
+				Status:       1,
+				RedirectMode: 302,
+				Variants:     []*model.Variant{},
+			},
 			wantErr: false,
 		},
 		{
-			name: "NORMAL: 存在しないURLの場合は空文字が返る",
+			name: "NORMAL: 存在しないURLの場合はnilが返る",
 			args: args{
 				ctx:      context.Background(),
 				anonyURL: "short11",
 			},
-			want:    "",
+			want:    nil,
 			wantErr: false,
 		},
 	}
@@ -1366,11 +2111,12 @@ func Test_anonyURLUseCase_GetOriginalByAnonyURL_DB(t *testing.T) {
 			testutils.ClearURLData()
 			testutils.ClearUserData()
 			testutils.InsertURLData()
-			got, err := u.GetOriginalByAnonyURL(tt.args.ctx, tt.args.anonyURL)
+			got, err := u.GetOriginalByAnonyURL(tt.args.ctx, tt.args.domainID, tt.args.anonyURL)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("anonyURLUseCase.GetOriginalByAnonyURL() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			withoutCreatedAt(got)
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("anonyURLUseCase.GetOriginalByAnonyURL() = %v, want %v", got, tt.want)
 			}
@@ -1379,3 +2125,55 @@ func Test_anonyURLUseCase_GetOriginalByAnonyURL_DB(t *testing.T) {
 		})
 	}
 }
+
+func Test_findByOriginalInUser(t *testing.T) {
+	registered := map[string]*model.AnonyURL{
+		"http://example.com/normalized": {ID: "id1", Original: "http://example.com/normalized"},
+		"HTTP://Example.com/legacy":     {ID: "id2", Original: "HTTP://Example.com/legacy"},
+	}
+	var looked []string
+	repo := testutils.AnonyURLRepoMock{
+		FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+			looked = append(looked, original)
+			return registered[original], nil
+		},
+	}
+	tests := []struct {
+		name       string
+		original   string
+		wantID     string
+		wantLooked []string
+	}{
+		{name: "NORMAL: 正規化したoriginalで見つかる場合", original: "HTTP://EXAMPLE.com/normalized", wantID: "id1", wantLooked: []string{"http://example.com/normalized"}},
+		{name: "NORMAL: 正規化の導入前に登録されたoriginal", original: "HTTP://Example.com/legacy", wantID: "id2", wantLooked: []string{"http://example.com/legacy", "HTTP://Example.com/legacy"}},
+		{name: "NORMAL: 正規化済みのoriginalは1回だけ探す", original: "http://example.com/unknown", wantLooked: []string{"http://example.com/unknown"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			looked = nil
+			got, err := findByOriginalInUser(repo, tt.original, model.UTM{}, "user_id")
+			if err != nil {
+				t.Fatalf("findByOriginalInUser() error = %v", err)
+			}
+			gotID := ""
+			if got != nil {
+				gotID = got.ID
+			}
+			if gotID != tt.wantID {
+				t.Errorf("findByOriginalInUser() ID = %v, want %v", gotID, tt.wantID)
+			}
+			if !reflect.DeepEqual(looked, tt.wantLooked) {
+				t.Errorf("findByOriginalInUser() looked up %v, want %v", looked, tt.wantLooked)
+			}
+		})
+	}
+}
+
+// withoutCreatedAt clears created_at set by the DB to compare with the expected AnonyURLs
+func withoutCreatedAt(ans ...*model.AnonyURL) {
+	for _, an := range ans {
+		if an != nil {
+			an.CreatedAt = time.Time{}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+)
+
+// ErrAuditPermissionDenied is returned when a non-admin user lists another user's audit events
+var ErrAuditPermissionDenied = errors.New("permission denied to list audit events of other users")
+
+// AuditUseCase is a usecase of the audit log.
+type AuditUseCase interface {
+	// ListAuditEvents lists audit events, non-admin users can list only their own events
+	ListAuditEvents(userID string, f model.AuditEventFilter) ([]*model.AuditEvent, error)
+	IsAdmin(userID string) bool
+}
+
+type auditUseCase struct {
+	repo   repository.AuditEventRepository
+	admins map[string]struct{}
+}
+
+// NewAuditUseCase creates auditUseCase.
+func NewAuditUseCase(r repository.AuditEventRepository, adminIDs []string) AuditUseCase {
+	admins := make(map[string]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = struct{}{}
+	}
+	return &auditUseCase{r, admins}
+}
+
+func (u *auditUseCase) ListAuditEvents(userID string, f model.AuditEventFilter) ([]*model.AuditEvent, error) {
+	if !u.IsAdmin(userID) {
+		// 管理者以外は自分の操作のみ
+		if f.ActorID != "" && f.ActorID != userID {
+			return nil, ErrAuditPermissionDenied
+		}
+		f.ActorID = userID
+	}
+	if err := f.Normalize(); err != nil {
+		return nil, err
+	}
+	return u.repo.Find(f)
+}
+
+func (u *auditUseCase) IsAdmin(userID string) bool {
+	_, ok := u.admins[userID]
+	return ok
+}
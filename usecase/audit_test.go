@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func Test_auditUseCase_ListAuditEvents(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      string
+		f           model.AuditEventFilter
+		wantActorID string
+		wantErr     error
+	}{
+		{name: "NORMAL: 管理者以外は自分の操作のみ", userID: "user", f: model.AuditEventFilter{}, wantActorID: "user"},
+		{name: "NORMAL: 管理者は全ての操作", userID: "admin", f: model.AuditEventFilter{}, wantActorID: ""},
+		{name: "NORMAL: 管理者は他のユーザーで絞り込める", userID: "admin", f: model.AuditEventFilter{ActorID: "user"}, wantActorID: "user"},
+		{name: "ERROR: 管理者以外は他のユーザーの操作を取得できない", userID: "user", f: model.AuditEventFilter{ActorID: "other"}, wantErr: ErrAuditPermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got model.AuditEventFilter
+			u := NewAuditUseCase(testutils.AuditEventRepoMock{
+				FakeFind: func(f model.AuditEventFilter) ([]*model.AuditEvent, error) {
+					got = f
+					return []*model.AuditEvent{}, nil
+				},
+			}, []string{"admin"})
+			_, err := u.ListAuditEvents(tt.userID, tt.f)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("auditUseCase.ListAuditEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.ActorID != tt.wantActorID || got.Limit != model.DefaultAuditEventsLimit {
+				t.Errorf("auditUseCase.ListAuditEvents() filter = %+v, want actor %v", got, tt.wantActorID)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/datastore"
+)
+
+// DomainUseCase is a usecase of domain.
+type DomainUseCase interface {
+	RegisterDomain(ctx context.Context, d *model.Domain) (*model.Domain, error)
+	VerifyDomain(ctx context.Context, name, userID string) (*model.Domain, error)
+	ListDomains(ctx context.Context, userID string) ([]*model.Domain, error)
+	GetUsableDomain(ctx context.Context, name, userID string) (*model.Domain, error)
+}
+
+type domainUseCase struct {
+	repo        repository.DomainRepository
+	transaction datastore.Transaction
+	service     service.DomainService
+	audit       service.AuditService
+}
+
+// NewDomainUseCase creates domainUseCase.
+func NewDomainUseCase(r repository.DomainRepository, t datastore.Transaction, s service.DomainService, as service.AuditService) DomainUseCase {
+	return &domainUseCase{r, t, s, as}
+}
+
+func (u *domainUseCase) RegisterDomain(ctx context.Context, d *model.Domain) (*model.Domain, error) {
+	if err := d.ValidateDomain(); err != nil {
+		return nil, err
+	}
+	exists, err := u.service.ExistsName(d.Name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("domain is already registered")
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.Save(ctx, d); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, d.UserID, model.AuditDomainRegister, model.AuditTargetDomain, d.ID, nil, d)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.FindByID(d.ID)
+}
+
+func (u *domainUseCase) VerifyDomain(ctx context.Context, name, userID string) (*model.Domain, error) {
+	d, err := u.findOwnDomain(name, userID)
+	if err != nil {
+		return nil, err
+	}
+	if d.Verified {
+		return d, nil
+	}
+	ok, err := u.service.VerifyOwnership(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("TXT record %s is not found", d.VerificationRecordName())
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.UpdateVerified(ctx, d.ID, true); err != nil {
+			return nil, err
+		}
+		after := *d
+		after.Verified = true
+		return nil, u.audit.Record(ctx, userID, model.AuditDomainVerify, model.AuditTargetDomain, d.ID, d, &after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.FindByID(d.ID)
+}
+
+func (u *domainUseCase) ListDomains(ctx context.Context, userID string) ([]*model.Domain, error) {
+	return u.repo.FindByUserID(userID)
+}
+
+// GetUsableDomain returns the domain which the user can create AnonyURLs on
+func (u *domainUseCase) GetUsableDomain(ctx context.Context, name, userID string) (*model.Domain, error) {
+	d, err := u.findOwnDomain(name, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !d.Verified {
+		return nil, fmt.Errorf("domain is not verified")
+	}
+	return d, nil
+}
+
+func (u *domainUseCase) findOwnDomain(name, userID string) (*model.Domain, error) {
+	d, err := u.repo.FindByName(name)
+	if err != nil {
+		return nil, err
+	}
+	// 他のユーザーのドメインは存在しないものとして扱う
+	if d == nil || d.UserID != userID {
+		return nil, fmt.Errorf("domain is not found")
+	}
+	return d, nil
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/datastore"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestNewDomainUseCase(t *testing.T) {
+	db := testutils.GetTestDB().DB
+	transaction := datastore.NewTransaction(db)
+	tests := []struct {
+		name string
+		want DomainUseCase
+	}{
+		{
+			name: "NORMAL: 正常にDomainUseCaseが作成できる",
+			want: &domainUseCase{
+				testutils.DomainRepoMock{},
+				transaction,
+				testutils.DomainServiceMock{},
+				testutils.AuditServiceMock{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewDomainUseCase(testutils.DomainRepoMock{}, transaction, testutils.DomainServiceMock{}, testutils.AuditServiceMock{}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewDomainUseCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_domainUseCase_RegisterDomain(t *testing.T) {
+	db := testutils.GetTestDB().DB
+	transaction := datastore.NewTransaction(db)
+	type repoMocks struct {
+		FakeFindByID func(id string) (*model.Domain, error)
+		FakeSave     func(ctx context.Context, d *model.Domain) error
+	}
+	type serviceMocks struct {
+		FakeExistsName func(name string) (bool, error)
+	}
+	domain := &model.Domain{ID: "id", Name: "go.example.com", UserID: "user-id", VerifyToken: "token"}
+	tests := []struct {
+		name         string
+		d            *model.Domain
+		repoMocks    repoMocks
+		serviceMocks serviceMocks
+		want         *model.Domain
+		wantErr      bool
+	}{
+		{
+			name: "NORMAL: 新規に登録できる",
+			d:    domain,
+			repoMocks: repoMocks{
+				FakeFindByID: func(id string) (*model.Domain, error) {
+					return domain, nil
+				},
+				FakeSave: func(ctx context.Context, d *model.Domain) error {
+					return nil
+				},
+			},
+			serviceMocks: serviceMocks{
+				FakeExistsName: func(name string) (bool, error) {
+					return false, nil
+				},
+			},
+			want:    domain,
+			wantErr: false,
+		},
+		{
+			name: "ERROR: ドメイン名が不正な場合",
+			d:    &model.Domain{ID: "id", Name: "https://go.example.com", UserID: "user-id", VerifyToken: "token"},
+			serviceMocks: serviceMocks{
+				FakeExistsName: func(name string) (bool, error) {
+					return false, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: 既に登録されている場合",
+			d:    domain,
+			serviceMocks: serviceMocks{
+				FakeExistsName: func(name string) (bool, error) {
+					return true, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: repo.SaveがErrorを返す場合",
+			d:    domain,
+			repoMocks: repoMocks{
+				FakeSave: func(ctx context.Context, d *model.Domain) error {
+					return fmt.Errorf("error")
+				},
+			},
+			serviceMocks: serviceMocks{
+				FakeExistsName: func(name string) (bool, error) {
+					return false, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &domainUseCase{
+				repo: testutils.DomainRepoMock{
+					FakeFindByID: tt.repoMocks.FakeFindByID,
+					FakeSave:     tt.repoMocks.FakeSave,
+				},
+				transaction: transaction,
+				service: testutils.DomainServiceMock{
+					FakeExistsName: tt.serviceMocks.FakeExistsName,
+				},
+				audit: auditNothing,
+			}
+			got, err := u.RegisterDomain(context.Background(), tt.d)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("domainUseCase.RegisterDomain() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("domainUseCase.RegisterDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_domainUseCase_VerifyDomain(t *testing.T) {
+	db := testutils.GetTestDB().DB
+	transaction := datastore.NewTransaction(db)
+	type repoMocks struct {
+		FakeFindByID       func(id string) (*model.Domain, error)
+		FakeFindByName     func(name string) (*model.Domain, error)
+		FakeUpdateVerified func(ctx context.Context, id string, verified bool) error
+	}
+	type serviceMocks struct {
+		FakeVerifyOwnership func(ctx context.Context, d *model.Domain) (bool, error)
+	}
+	unverified := &model.Domain{ID: "id", Name: "go.example.com", UserID: "user-id", VerifyToken: "token"}
+	verified := &model.Domain{ID: "id", Name: "go.example.com", UserID: "user-id", VerifyToken: "token", Verified: true}
+	tests := []struct {
+		name         string
+		userID       string
+		repoMocks    repoMocks
+		serviceMocks serviceMocks
+		want         *model.Domain
+		wantErr      bool
+	}{
+		{
+			name:   "NORMAL: TXTレコードが確認できれば確認済みになる",
+			userID: "user-id",
+			repoMocks: repoMocks{
+				FakeFindByID: func(id string) (*model.Domain, error) {
+					return verified, nil
+				},
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return unverified, nil
+				},
+				FakeUpdateVerified: func(ctx context.Context, id string, v bool) error {
+					return nil
+				},
+			},
+			serviceMocks: serviceMocks{
+				FakeVerifyOwnership: func(ctx context.Context, d *model.Domain) (bool, error) {
+					return true, nil
+				},
+			},
+			want:    verified,
+			wantErr: false,
+		},
+		{
+			name:   "NORMAL: 既に確認済みの場合はそのまま返す",
+			userID: "user-id",
+			repoMocks: repoMocks{
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return verified, nil
+				},
+			},
+			want:    verified,
+			wantErr: false,
+		},
+		{
+			name:   "ERROR: TXTレコードが確認できない場合",
+			userID: "user-id",
+			repoMocks: repoMocks{
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return unverified, nil
+				},
+			},
+			serviceMocks: serviceMocks{
+				FakeVerifyOwnership: func(ctx context.Context, d *model.Domain) (bool, error) {
+					return false, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "ERROR: 他のユーザーのドメインの場合",
+			userID: "other-user-id",
+			repoMocks: repoMocks{
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return unverified, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:   "ERROR: ドメインが存在しない場合",
+			userID: "user-id",
+			repoMocks: repoMocks{
+				FakeFindByName: func(name string) (*model.Domain, error) {
+					return nil, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &domainUseCase{
+				repo: testutils.DomainRepoMock{
+					FakeFindByID:       tt.repoMocks.FakeFindByID,
+					FakeFindByName:     tt.repoMocks.FakeFindByName,
+					FakeUpdateVerified: tt.repoMocks.FakeUpdateVerified,
+				},
+				transaction: transaction,
+				service: testutils.DomainServiceMock{
+					FakeVerifyOwnership: tt.serviceMocks.FakeVerifyOwnership,
+				},
+				audit: auditNothing,
+			}
+			got, err := u.VerifyDomain(context.Background(), "go.example.com", tt.userID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("domainUseCase.VerifyDomain() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("domainUseCase.VerifyDomain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
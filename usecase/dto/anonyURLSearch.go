@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/Tatsuemon/anony/domain/model"
+
+// AnonyURLSearchQuery is a query to search the user's AnonyURLs
+type AnonyURLSearchQuery struct {
+	// 小文字の検索語. 全ての語がいずれかの項目に含まれるリンクを返す
+	Terms  []string
+	Limit  int
+	Offset int
+}
+
+// AnonyURLSearchResult is an AnonyURL matched to the query with the relevance
+type AnonyURLSearchResult struct {
+	AnonyURL *model.AnonyURL
+	Score    float64
+}
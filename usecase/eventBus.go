@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// EventPublisher publishes domain events to the subscribers
+type EventPublisher interface {
+	// Publish is called after the transaction is committed
+	// 購読者のエラーは発行元に返さない. 変更は既に確定している
+	Publish(ctx context.Context, events ...model.Event)
+}
+
+// EventHandler handles a domain event
+type EventHandler func(ctx context.Context, e model.Event) error
+
+type queuedEvent struct {
+	handler EventHandler
+	event   model.Event
+}
+
+// EventBus is an in-process EventPublisher
+// 購読者はcmdで起動時に登録する. プロセスをまたいで配信はしない
+type EventBus struct {
+	mu    sync.RWMutex
+	sync  map[string][]EventHandler
+	async map[string][]EventHandler
+	queue chan queuedEvent
+}
+
+// NewEventBus creates an EventBus queueing up to queueSize events for the asynchronous handlers
+func NewEventBus(queueSize int) *EventBus {
+	return &EventBus{
+		sync:  map[string][]EventHandler{},
+		async: map[string][]EventHandler{},
+		queue: make(chan queuedEvent, queueSize),
+	}
+}
+
+// Subscribe registers the handler called in Publish in order of registration
+// 発行元のリクエストを待たせるので, 時間のかかる処理はSubscribeAsyncで登録する
+func (b *EventBus) Subscribe(name string, h EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sync[name] = append(b.sync[name], h)
+}
+
+// SubscribeAsync registers the handler called in Run
+// キューが一杯の場合, イベントは捨てられる
+func (b *EventBus) SubscribeAsync(name string, h EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.async[name] = append(b.async[name], h)
+}
+
+// Publish calls the synchronous handlers and queues the events for the asynchronous ones
+// 購読者のいないイベントは何もしないため, 発行元は購読者の有無を気にせず発行する
+func (b *EventBus) Publish(ctx context.Context, events ...model.Event) {
+	for _, e := range events {
+		b.mu.RLock()
+		syncHandlers, asyncHandlers := b.sync[e.EventName()], b.async[e.EventName()]
+		b.mu.RUnlock()
+		for _, h := range syncHandlers {
+			if err := h(ctx, e); err != nil {
+				log.Printf("failed to handle %s: %s", e.EventName(), err)
+			}
+		}
+		for _, h := range asyncHandlers {
+			select {
+			case b.queue <- queuedEvent{h, e}:
+			default:
+				log.Printf("event queue is full, %s is dropped", e.EventName())
+			}
+		}
+	}
+}
+
+// Run calls the asynchronous handlers until ctx is done
+// 発行元のctxは終わっている場合があるので, ハンドラにはRunのctxを渡す
+func (b *EventBus) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case v := <-b.queue:
+			if err := v.handler(ctx, v.event); err != nil {
+				log.Printf("failed to handle %s: %s", v.event.EventName(), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+)
+
+// eventRecorder is an EventPublisher calling the function for each event
+type eventRecorder func(e model.Event)
+
+func (r eventRecorder) Publish(ctx context.Context, events ...model.Event) {
+	for _, e := range events {
+		r(e)
+	}
+}
+
+func TestEventBus_Publish(t *testing.T) {
+	var got []string
+	record := func(name string, err error) EventHandler {
+		return func(ctx context.Context, e model.Event) error {
+			got = append(got, name+":"+e.EventName())
+			return err
+		}
+	}
+	b := NewEventBus(0)
+	b.Subscribe(model.EventAnonyURLCreated, record("first", fmt.Errorf("error")))
+	b.Subscribe(model.EventAnonyURLCreated, record("second", nil))
+	b.Subscribe(model.EventUserCreated, record("user", nil))
+
+	b.Publish(context.Background(), model.AnonyURLCreated{At: time.Now()}, model.AnonyURLClicked{At: time.Now()})
+
+	// 登録した順に呼び, エラーを返すハンドラがあっても続ける. 購読者のいないAnonyURLClickedは何もしない
+	want := []string{"first:AnonyURLCreated", "second:AnonyURLCreated"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EventBus.Publish() handled = %v, want %v", got, want)
+	}
+}
+
+func TestEventBus_SubscribeAsync(t *testing.T) {
+	handled := make(chan model.Event, 1)
+	b := NewEventBus(1)
+	b.SubscribeAsync(model.EventUserDeleted, func(ctx context.Context, e model.Event) error {
+		handled <- e
+		return nil
+	})
+	e := model.UserDeleted{UserID: "user", At: time.Now()}
+	b.Publish(context.Background(), e)
+	// キューが一杯の場合は捨てる
+	b.Publish(context.Background(), model.UserDeleted{UserID: "dropped", At: time.Now()})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go b.Run(ctx)
+	select {
+	case got := <-handled:
+		if got != e {
+			t.Errorf("EventBus.Run() handled %v, want %v", got, e)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("EventBus.Run() did not handle the event")
+	}
+	select {
+	case got := <-handled:
+		t.Errorf("EventBus.Run() handled the dropped event %v", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
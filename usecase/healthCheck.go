@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+)
+
+const healthCheckPageSize = 500
+
+// HealthCheckUseCase is a usecase of checking destinations of registered AnonyURLs.
+type HealthCheckUseCase interface {
+	// CheckAnonyURLs checks all active AnonyURLs and returns the number of broken ones
+	CheckAnonyURLs(ctx context.Context) (int, error)
+	// HandleEvent checks the AnonyURL created or activated in the event without waiting for CheckAnonyURLs
+	HandleEvent(ctx context.Context, e model.Event) error
+}
+
+type healthCheckUseCase struct {
+	repo        repository.AnonyURLRepository
+	checker     service.LinkChecker
+	concurrency int
+}
+
+// NewHealthCheckUseCase creates healthCheckUseCase checking at most concurrency destinations at once.
+func NewHealthCheckUseCase(r repository.AnonyURLRepository, c service.LinkChecker, concurrency int) HealthCheckUseCase {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &healthCheckUseCase{r, c, concurrency}
+}
+
+// CheckAnonyURLs records the health of the originals of AnonyURLs
+// 無効なリンクとブロックされたリンクはリダイレクトしないので確認しない
+func (u *healthCheckUseCase) CheckAnonyURLs(ctx context.Context) (int, error) {
+	var (
+		mu       sync.Mutex
+		broken   int
+		firstErr error
+	)
+	sem := make(chan struct{}, u.concurrency)
+	var wg sync.WaitGroup
+	check := func(an *model.AnonyURL) {
+		defer func() {
+			<-sem
+			wg.Done()
+		}()
+		h, err := u.checkAnonyURL(ctx, an)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		if h.IsBroken() {
+			broken++
+		}
+	}
+
+	for afterID := ""; ; {
+		ans, err := u.repo.FindPage(afterID, healthCheckPageSize)
+		if err != nil {
+			wg.Wait()
+			return broken, err
+		}
+		for _, an := range ans {
+			if !an.IsRedirectable() {
+				continue
+			}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				wg.Wait()
+				return broken, ctx.Err()
+			}
+			wg.Add(1)
+			go check(an)
+		}
+		if len(ans) < healthCheckPageSize {
+			break
+		}
+		afterID = ans[len(ans)-1].ID
+	}
+	wg.Wait()
+	return broken, firstErr
+}
+
+// HandleEvent checks the destination of the AnonyURL created or activated in the event
+// usecase.EventBusでAnonyURLCreatedとAnonyURLStatusChangedを非同期で購読する
+func (u *healthCheckUseCase) HandleEvent(ctx context.Context, e model.Event) error {
+	var an *model.AnonyURL
+	switch e := e.(type) {
+	case model.AnonyURLCreated:
+		an = e.AnonyURL
+	case model.AnonyURLStatusChanged:
+		an = e.AnonyURL
+	}
+	if an == nil || !an.IsRedirectable() {
+		return nil
+	}
+	_, err := u.checkAnonyURL(ctx, an)
+	return err
+}
+
+// checkAnonyURL checks the original of the AnonyURL and records the result
+func (u *healthCheckUseCase) checkAnonyURL(ctx context.Context, an *model.AnonyURL) (*model.LinkHealth, error) {
+	h, err := u.checker.Check(ctx, an.Original)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.repo.UpdateHealth(ctx, an.ID, h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
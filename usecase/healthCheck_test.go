@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+// checkByPath returns 404 for destinations under /broken, or 200
+func checkByPath(ctx context.Context, destination string) (*model.LinkHealth, error) {
+	h := &model.LinkHealth{StatusCode: 200, Latency: time.Millisecond, CheckedAt: time.Now()}
+	if destination == "https://example.com/broken" {
+		h.StatusCode = 404
+	}
+	return h, nil
+}
+
+func Test_healthCheckUseCase_CheckAnonyURLs(t *testing.T) {
+	tests := []struct {
+		name        string
+		ans         []*model.AnonyURL
+		findErr     error
+		checkErr    error
+		updateErr   error
+		want        int
+		wantUpdated map[string]int64
+		wantErr     bool
+	}{
+		{
+			name: "NORMAL: 有効なリンクを確認して結果を記録する",
+			ans: []*model.AnonyURL{
+				{ID: "id1", Original: "https://example.com/", Status: 1},
+				{ID: "id2", Original: "https://example.com/broken", Status: 1},
+				{ID: "id3", Original: "https://example.com/broken", Status: 2},
+				{ID: "id4", Original: "https://example.com/broken", Status: 1, BlockedReason: "blocked"},
+			},
+			want:        1,
+			wantUpdated: map[string]int64{"id1": 200, "id2": 404},
+			wantErr:     false,
+		},
+		{
+			name:        "ERROR: anonyURLRepo.FindPageがERRORを返す",
+			findErr:     fmt.Errorf("error"),
+			want:        0,
+			wantUpdated: map[string]int64{},
+			wantErr:     true,
+		},
+		{
+			name:        "ERROR: linkChecker.CheckがERRORを返す",
+			ans:         []*model.AnonyURL{{ID: "id1", Original: "https://example.com/", Status: 1}},
+			checkErr:    context.Canceled,
+			want:        0,
+			wantUpdated: map[string]int64{},
+			wantErr:     true,
+		},
+		{
+			name:        "ERROR: anonyURLRepo.UpdateHealthがERRORを返す",
+			ans:         []*model.AnonyURL{{ID: "id1", Original: "https://example.com/", Status: 1}},
+			updateErr:   fmt.Errorf("error"),
+			want:        0,
+			wantUpdated: map[string]int64{"id1": 200},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			updated := map[string]int64{}
+			u := NewHealthCheckUseCase(
+				testutils.AnonyURLRepoMock{
+					FakeFindPage: func(afterID string, limit int) ([]*model.AnonyURL, error) {
+						if afterID != "" {
+							return []*model.AnonyURL{}, nil
+						}
+						return tt.ans, tt.findErr
+					},
+					FakeUpdateHealth: func(ctx context.Context, id string, h *model.LinkHealth) error {
+						mu.Lock()
+						defer mu.Unlock()
+						updated[id] = h.StatusCode
+						return tt.updateErr
+					},
+				},
+				testutils.LinkCheckerMock{
+					FakeCheck: func(ctx context.Context, destination string) (*model.LinkHealth, error) {
+						if tt.checkErr != nil {
+							return nil, tt.checkErr
+						}
+						return checkByPath(ctx, destination)
+					},
+				},
+				2,
+			)
+			got, err := u.CheckAnonyURLs(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("healthCheckUseCase.CheckAnonyURLs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("healthCheckUseCase.CheckAnonyURLs() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(updated, tt.wantUpdated) {
+				t.Errorf("healthCheckUseCase.CheckAnonyURLs() updated = %v, want %v", updated, tt.wantUpdated)
+			}
+		})
+	}
+}
+
+func Test_healthCheckUseCase_CheckAnonyURLs_Concurrency(t *testing.T) {
+	ans := make([]*model.AnonyURL, healthCheckPageSize+10)
+	for i := range ans {
+		ans[i] = &model.AnonyURL{ID: fmt.Sprintf("id%04d", i), Original: "https://example.com/", Status: 1}
+	}
+	var running, maxRunning int32
+	var mu sync.Mutex
+	updated := []string{}
+	u := NewHealthCheckUseCase(
+		testutils.AnonyURLRepoMock{
+			FakeFindPage: func(afterID string, limit int) ([]*model.AnonyURL, error) {
+				i := sort.Search(len(ans), func(i int) bool { return ans[i].ID > afterID })
+				end := i + limit
+				if end > len(ans) {
+					end = len(ans)
+				}
+				return ans[i:end], nil
+			},
+			FakeUpdateHealth: func(ctx context.Context, id string, h *model.LinkHealth) error {
+				mu.Lock()
+				defer mu.Unlock()
+				updated = append(updated, id)
+				return nil
+			},
+		},
+		testutils.LinkCheckerMock{
+			FakeCheck: func(ctx context.Context, destination string) (*model.LinkHealth, error) {
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				time.Sleep(100 * time.Microsecond)
+				return checkByPath(ctx, destination)
+			},
+		},
+		4,
+	)
+	if _, err := u.CheckAnonyURLs(context.Background()); err != nil {
+		t.Fatalf("healthCheckUseCase.CheckAnonyURLs() error = %v", err)
+	}
+	if len(updated) != len(ans) {
+		t.Errorf("healthCheckUseCase.CheckAnonyURLs() updated %d, want %d", len(updated), len(ans))
+	}
+	if maxRunning > 4 {
+		t.Errorf("healthCheckUseCase.CheckAnonyURLs() checked %d at once, want <= 4", maxRunning)
+	}
+}
+
+func Test_healthCheckUseCase_HandleEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		event       model.Event
+		checkErr    error
+		wantUpdated map[string]int64
+		wantErr     bool
+	}{
+		{
+			name:        "NORMAL: 作成したリンクを確認する",
+			event:       model.AnonyURLCreated{AnonyURL: &model.AnonyURL{ID: "id1", Original: "https://example.com/broken", Status: 1}},
+			wantUpdated: map[string]int64{"id1": 404},
+			wantErr:     false,
+		},
+		{
+			name:        "NORMAL: 有効にしたリンクを確認する",
+			event:       model.AnonyURLStatusChanged{AnonyURL: &model.AnonyURL{ID: "id1", Original: "https://example.com/", Status: 1}},
+			wantUpdated: map[string]int64{"id1": 200},
+			wantErr:     false,
+		},
+		{
+			name:        "NORMAL: 無効にしたリンクは確認しない",
+			event:       model.AnonyURLStatusChanged{AnonyURL: &model.AnonyURL{ID: "id1", Original: "https://example.com/", Status: 2}},
+			wantUpdated: map[string]int64{},
+			wantErr:     false,
+		},
+		{
+			name:        "NORMAL: 他のイベントは確認しない",
+			event:       model.StatusChangeEvent{AnonyURLID: "id1", Active: true},
+			wantUpdated: map[string]int64{},
+			wantErr:     false,
+		},
+		{
+			name:        "ERROR: linkChecker.CheckがERRORを返す",
+			event:       model.AnonyURLCreated{AnonyURL: &model.AnonyURL{ID: "id1", Original: "https://example.com/", Status: 1}},
+			checkErr:    context.Canceled,
+			wantUpdated: map[string]int64{},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := map[string]int64{}
+			u := NewHealthCheckUseCase(
+				testutils.AnonyURLRepoMock{
+					FakeUpdateHealth: func(ctx context.Context, id string, h *model.LinkHealth) error {
+						updated[id] = h.StatusCode
+						return nil
+					},
+				},
+				testutils.LinkCheckerMock{
+					FakeCheck: func(ctx context.Context, destination string) (*model.LinkHealth, error) {
+						if tt.checkErr != nil {
+							return nil, tt.checkErr
+						}
+						return checkByPath(ctx, destination)
+					},
+				},
+				1,
+			)
+			if err := u.HandleEvent(context.Background(), tt.event); (err != nil) != tt.wantErr {
+				t.Errorf("healthCheckUseCase.HandleEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(updated, tt.wantUpdated) {
+				t.Errorf("healthCheckUseCase.HandleEvent() updated = %v, want %v", updated, tt.wantUpdated)
+			}
+		})
+	}
+}
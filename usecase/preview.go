@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+)
+
+// previewFetchTimeout is the timeout of fetching previews in the background
+const previewFetchTimeout = time.Minute
+
+// ErrPreviewUnavailable is returned when the preview of the destination can not be fetched
+var ErrPreviewUnavailable = errors.New("failed to fetch the preview")
+
+// PreviewUseCase is a usecase of previews of destination pages.
+type PreviewUseCase interface {
+	// FetchPreviewsAsync fetches and stores the previews of the AnonyURLs in the background
+	FetchPreviewsAsync(ans ...*model.AnonyURL)
+	// RefreshPreview fetches the preview of the user's AnonyURL again
+	RefreshPreview(ctx context.Context, original string, utm model.UTM, userID string) (*model.AnonyURL, error)
+}
+
+type previewUseCase struct {
+	repo    repository.AnonyURLRepository
+	fetcher service.PreviewFetcher
+}
+
+// NewPreviewUseCase creates previewUseCase.
+func NewPreviewUseCase(r repository.AnonyURLRepository, f service.PreviewFetcher) PreviewUseCase {
+	return &previewUseCase{r, f}
+}
+
+func (u *previewUseCase) FetchPreviewsAsync(ans ...*model.AnonyURL) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), previewFetchTimeout)
+		defer cancel()
+		if err := u.fetchPreviews(ctx, ans); err != nil {
+			log.Printf("failed to fetch previews: %s", err)
+		}
+	}()
+}
+
+// fetchPreviews fetches the previews in order and returns the first error
+// キャンペーンのリンクはoriginalが同じなので, originalごとに1度だけ取得する
+func (u *previewUseCase) fetchPreviews(ctx context.Context, ans []*model.AnonyURL) error {
+	fetched := map[string]*model.LinkPreview{}
+	var firstErr error
+	for _, an := range ans {
+		p, ok := fetched[an.Original]
+		if !ok {
+			var err error
+			p, err = u.fetcher.Fetch(ctx, an.Original)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: %w", an.Original, err)
+				}
+				continue
+			}
+			fetched[an.Original] = p
+		}
+		if err := u.repo.UpdatePreview(ctx, an.ID, p); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (u *previewUseCase) RefreshPreview(ctx context.Context, original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+	an, err := findByOriginalInUser(u.repo, original, utm, userID)
+	if err != nil {
+		return nil, err
+	}
+	if an == nil {
+		return nil, fmt.Errorf("this anonyURL is not existed")
+	}
+	p, err := u.fetcher.Fetch(ctx, an.Original)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrPreviewUnavailable, err)
+	}
+	if err := u.repo.UpdatePreview(ctx, an.ID, p); err != nil {
+		return nil, err
+	}
+	an.Preview = p
+	return an, nil
+}
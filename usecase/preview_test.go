@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func Test_previewUseCase_fetchPreviews(t *testing.T) {
+	fetchedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		ans         []*model.AnonyURL
+		wantFetched []string
+		wantUpdated map[string]string
+		wantErr     bool
+	}{
+		{
+			name: "NORMAL: originalごとに1度だけ取得する",
+			ans: []*model.AnonyURL{
+				{ID: "id1", Original: "https://example.com/"},
+				{ID: "id2", Original: "https://example.com/"},
+				{ID: "id3", Original: "https://example.org/"},
+			},
+			wantFetched: []string{"https://example.com/", "https://example.org/"},
+			wantUpdated: map[string]string{"id1": "https://example.com/", "id2": "https://example.com/", "id3": "https://example.org/"},
+			wantErr:     false,
+		},
+		{
+			name: "ERROR: 取得に失敗したリンク以外は保存する",
+			ans: []*model.AnonyURL{
+				{ID: "id1", Original: "https://broken.example/"},
+				{ID: "id2", Original: "https://example.com/"},
+			},
+			wantFetched: []string{"https://broken.example/", "https://example.com/"},
+			wantUpdated: map[string]string{"id2": "https://example.com/"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetched := []string{}
+			updated := map[string]string{}
+			u := &previewUseCase{
+				repo: testutils.AnonyURLRepoMock{
+					FakeUpdatePreview: func(ctx context.Context, id string, p *model.LinkPreview) error {
+						updated[id] = p.Title
+						return nil
+					},
+				},
+				fetcher: testutils.PreviewFetcherMock{
+					FakeFetch: func(ctx context.Context, destination string) (*model.LinkPreview, error) {
+						fetched = append(fetched, destination)
+						if destination == "https://broken.example/" {
+							return nil, fmt.Errorf("error")
+						}
+						return &model.LinkPreview{Title: destination, FetchedAt: fetchedAt}, nil
+					},
+				},
+			}
+			err := u.fetchPreviews(context.Background(), tt.ans)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("previewUseCase.fetchPreviews() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(fetched, tt.wantFetched) {
+				t.Errorf("previewUseCase.fetchPreviews() fetched = %v, want %v", fetched, tt.wantFetched)
+			}
+			if !reflect.DeepEqual(updated, tt.wantUpdated) {
+				t.Errorf("previewUseCase.fetchPreviews() updated = %v, want %v", updated, tt.wantUpdated)
+			}
+		})
+	}
+}
+
+func Test_previewUseCase_RefreshPreview(t *testing.T) {
+	preview := &model.LinkPreview{Title: "Example", FetchedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		name            string
+		an              *model.AnonyURL
+		fetchErr        error
+		want            *model.AnonyURL
+		wantErr         bool
+		wantUnavailable bool
+	}{
+		{
+			name: "NORMAL: プレビューを取得し直す",
+			an:   &model.AnonyURL{ID: "id1", Original: "https://example.com/"},
+			want: &model.AnonyURL{ID: "id1", Original: "https://example.com/", Preview: preview},
+		},
+		{
+			name:    "ERROR: リンクが存在しない場合",
+			an:      nil,
+			wantErr: true,
+		},
+		{
+			name:            "ERROR: 取得に失敗した場合",
+			an:              &model.AnonyURL{ID: "id1", Original: "https://example.com/"},
+			fetchErr:        fmt.Errorf("status 500"),
+			wantErr:         true,
+			wantUnavailable: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewPreviewUseCase(
+				testutils.AnonyURLRepoMock{
+					FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+						return tt.an, nil
+					},
+					FakeUpdatePreview: func(ctx context.Context, id string, p *model.LinkPreview) error {
+						return nil
+					},
+				},
+				testutils.PreviewFetcherMock{
+					FakeFetch: func(ctx context.Context, destination string) (*model.LinkPreview, error) {
+						if tt.fetchErr != nil {
+							return nil, tt.fetchErr
+						}
+						return preview, nil
+					},
+				},
+			)
+			got, err := u.RefreshPreview(context.Background(), "https://example.com/", model.UTM{}, "user_id")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("previewUseCase.RefreshPreview() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if errors.Is(err, ErrPreviewUnavailable) != tt.wantUnavailable {
+				t.Errorf("previewUseCase.RefreshPreview() error = %v, wantUnavailable %v", err, tt.wantUnavailable)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("previewUseCase.RefreshPreview() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package queryservice
+
+import (
+	"github.com/Tatsuemon/anony/usecase/dto"
+)
+
+// 検索の項目ごとの重み. 実装間で順位が揃うように共通で使う
+const (
+	SearchWeightShort    = 3.0
+	SearchWeightTitle    = 2.0
+	SearchWeightTag      = 2.0
+	SearchWeightOriginal = 1.0
+	SearchWeightNotes    = 1.0
+)
+
+// AnonyURLSearcher searches AnonyURLs by the original, the short code, the titles, the notes and the tags
+// タイトルは所有者が付けたものとプレビューの両方を対象にする
+// 結果はScoreの降順, 同じ場合は作成日時の新しい順に並べる
+type AnonyURLSearcher interface {
+	SearchAnonyURLs(userID string, q dto.AnonyURLSearchQuery) ([]*dto.AnonyURLSearchResult, error)
+}
@@ -0,0 +1,17 @@
+package queryservice
+
+import (
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/usecase/dto"
+)
+
+// TaggedAnonyURLAccessor reads AnonyURLs through the tags
+// tagGroupsは各グループのいずれかのタグが付いたリンクを, 全てのグループについて満たすものに絞り込む
+type TaggedAnonyURLAccessor interface {
+	// FindAnonyURLsByTags finds the user's AnonyURLs in the tag groups. statusが0の場合は全て
+	FindAnonyURLsByTags(userID string, status int64, tagGroups [][]string) ([]*model.AnonyURL, error)
+	// CountAnonyURLByUserInTags counts the user's AnonyURLs in the tag groups
+	CountAnonyURLByUserInTags(userID string, tagGroups [][]string) (*dto.AnonyURLCountByUser, error)
+	// FindTagIDsByUserID returns the ids of the tags on each AnonyURL of the user keyed by AnonyURL id
+	FindTagIDsByUserID(userID string) (map[string][]string, error)
+}
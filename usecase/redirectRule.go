@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/datastore"
+)
+
+// RedirectRuleUseCase is a usecase of redirect rule.
+type RedirectRuleUseCase interface {
+	ListRedirectRules(ctx context.Context, original string, utm model.UTM, userID string) ([]*model.RedirectRule, error)
+	SetRedirectRules(ctx context.Context, original string, utm model.UTM, userID string, rules []*model.RedirectRule) ([]*model.RedirectRule, error)
+	MatchRedirectRule(ctx context.Context, anonyURLID string, c model.RuleContext) (*model.RedirectRule, error)
+}
+
+type redirectRuleUseCase struct {
+	repo         repository.RedirectRuleRepository
+	anonyURLRepo repository.AnonyURLRepository
+	transaction  datastore.Transaction
+	screener     service.DestinationScreener
+	chain        service.RedirectChainService
+	audit        service.AuditService
+}
+
+// NewRedirectRuleUseCase creates redirectRuleUseCase.
+func NewRedirectRuleUseCase(r repository.RedirectRuleRepository, ar repository.AnonyURLRepository, t datastore.Transaction, sc service.DestinationScreener, ch service.RedirectChainService, as service.AuditService) RedirectRuleUseCase {
+	return &redirectRuleUseCase{r, ar, t, sc, ch, as}
+}
+
+func (u *redirectRuleUseCase) ListRedirectRules(ctx context.Context, original string, utm model.UTM, userID string) ([]*model.RedirectRule, error) {
+	an, err := u.findOwnAnonyURL(original, utm, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.FindByAnonyURLID(an.ID)
+}
+
+// SetRedirectRules replaces the rules of the AnonyURL, rules are evaluated in the order of the slice
+func (u *redirectRuleUseCase) SetRedirectRules(ctx context.Context, original string, utm model.UTM, userID string, rules []*model.RedirectRule) ([]*model.RedirectRule, error) {
+	if len(rules) > model.MaxRedirectRules {
+		return nil, fmt.Errorf("the number of rules must be %d or less", model.MaxRedirectRules)
+	}
+	an, err := u.findOwnAnonyURL(original, utm, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range rules {
+		v.AnonyURLID = an.ID
+		v.Priority = int64(i)
+		if err := v.ValidateRedirectRule(); err != nil {
+			return nil, fmt.Errorf("rules[%d]: %w", i, err)
+		}
+		if err := service.ScreenDestinations(ctx, u.screener, v.Destination); err != nil {
+			return nil, fmt.Errorf("rules[%d]: %w", i, err)
+		}
+		if _, err := u.chain.Resolve(v.Destination, an); err != nil {
+			return nil, fmt.Errorf("rules[%d]: %w", i, err)
+		}
+	}
+	before, err := u.repo.FindByAnonyURLID(an.ID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.ReplaceByAnonyURLID(ctx, an.ID, rules); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditAnonyURLSetRedirectRules, model.AuditTargetAnonyURL, an.ID, before, rules)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.FindByAnonyURLID(an.ID)
+}
+
+// MatchRedirectRule returns the rule matching the request, or nil if no rule matches
+func (u *redirectRuleUseCase) MatchRedirectRule(ctx context.Context, anonyURLID string, c model.RuleContext) (*model.RedirectRule, error) {
+	rules, err := u.repo.FindByAnonyURLID(anonyURLID)
+	if err != nil {
+		return nil, err
+	}
+	return model.MatchRedirectRule(rules, c), nil
+}
+
+func (u *redirectRuleUseCase) findOwnAnonyURL(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+	an, err := findByOriginalInUser(u.anonyURLRepo, original, utm, userID)
+	if err != nil {
+		return nil, err
+	}
+	if an == nil {
+		return nil, fmt.Errorf("this anonyURL is not existed")
+	}
+	return an, nil
+}
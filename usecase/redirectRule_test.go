@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/datastore"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func Test_redirectRuleUseCase_SetRedirectRules(t *testing.T) {
+	db := testutils.GetTestDB().DB
+	transaction := datastore.NewTransaction(db)
+	an := &model.AnonyURL{ID: "url-id", Original: "https://example.com", Short: "short", Status: 1}
+	type anonyURLRepoMocks struct {
+		FakeFindByOriginalInUser func(original string, utm model.UTM, userID string) (*model.AnonyURL, error)
+	}
+	type args struct {
+		rules []*model.RedirectRule
+	}
+	tests := []struct {
+		name              string
+		args              args
+		anonyURLRepoMocks anonyURLRepoMocks
+		want              []*model.RedirectRule
+		wantErr           bool
+	}{
+		{
+			name: "NORMAL: 順番どおりにPriorityを設定して保存する",
+			args: args{
+				rules: []*model.RedirectRule{
+					{ID: "ios", Platform: model.PlatformIOS, Destination: "https://apps.apple.com/app"},
+					{ID: "android", Platform: model.PlatformAndroid, Destination: "https://play.google.com/store/apps"},
+				},
+			},
+			anonyURLRepoMocks: anonyURLRepoMocks{
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+					return an, nil
+				},
+			},
+			want: []*model.RedirectRule{
+				{ID: "ios", AnonyURLID: "url-id", Priority: 0, Platform: model.PlatformIOS, Destination: "https://apps.apple.com/app"},
+				{ID: "android", AnonyURLID: "url-id", Priority: 1, Platform: model.PlatformAndroid, Destination: "https://play.google.com/store/apps"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: 空の場合は全て削除する",
+			args: args{
+				rules: []*model.RedirectRule{},
+			},
+			anonyURLRepoMocks: anonyURLRepoMocks{
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+					return an, nil
+				},
+			},
+			want:    []*model.RedirectRule{},
+			wantErr: false,
+		},
+		{
+			name: "ERROR: AnonyURLが存在しない場合",
+			args: args{
+				rules: []*model.RedirectRule{},
+			},
+			anonyURLRepoMocks: anonyURLRepoMocks{
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+					return nil, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: anonyURLRepo.FindByOriginalInUserがERRORを返す",
+			args: args{
+				rules: []*model.RedirectRule{},
+			},
+			anonyURLRepoMocks: anonyURLRepoMocks{
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+					return nil, fmt.Errorf("error")
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: 不正なルールがある場合",
+			args: args{
+				rules: []*model.RedirectRule{
+					{ID: "ios", Platform: "unknown", Destination: "https://apps.apple.com/app"},
+				},
+			},
+			anonyURLRepoMocks: anonyURLRepoMocks{
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+					return an, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: リダイレクト先がブロックされている場合",
+			args: args{
+				rules: []*model.RedirectRule{
+					{ID: "ios", Platform: model.PlatformIOS, Destination: "http://blocked.example/app"},
+				},
+			},
+			anonyURLRepoMocks: anonyURLRepoMocks{
+				FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+					return an, nil
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ERROR: ルールが多すぎる場合",
+			args: args{
+				rules: make([]*model.RedirectRule, model.MaxRedirectRules+1),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved []*model.RedirectRule
+			u := &redirectRuleUseCase{
+				repo: testutils.RedirectRuleRepoMock{
+					FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.RedirectRule, error) {
+						return saved, nil
+					},
+					FakeReplaceByAnonyURLID: func(ctx context.Context, anonyURLID string, rules []*model.RedirectRule) error {
+						saved = rules
+						return nil
+					},
+				},
+				anonyURLRepo: testutils.AnonyURLRepoMock{
+					FakeFindByOriginalInUser: tt.anonyURLRepoMocks.FakeFindByOriginalInUser,
+				},
+				transaction: transaction,
+				screener:    testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+				chain:       testutils.RedirectChainServiceMock{FakeResolve: resolveLoopExample},
+				audit:       auditNothing,
+			}
+			got, err := u.SetRedirectRules(context.Background(), "https://example.com", model.UTM{}, "user-id", tt.args.rules)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("redirectRuleUseCase.SetRedirectRules() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("redirectRuleUseCase.SetRedirectRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_redirectRuleUseCase_MatchRedirectRule(t *testing.T) {
+	rules := []*model.RedirectRule{
+		{ID: "ios", AnonyURLID: "url-id", Priority: 0, Platform: model.PlatformIOS, Destination: "https://apps.apple.com/app"},
+		{ID: "android", AnonyURLID: "url-id", Priority: 1, Platform: model.PlatformAndroid, Destination: "https://play.google.com/store/apps"},
+	}
+	tests := []struct {
+		name                 string
+		FakeFindByAnonyURLID func(anonyURLID string) ([]*model.RedirectRule, error)
+		c                    model.RuleContext
+		want                 *model.RedirectRule
+		wantErr              bool
+	}{
+		{
+			name: "NORMAL: 一致するルールを返す",
+			FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.RedirectRule, error) {
+				return rules, nil
+			},
+			c:       model.RuleContext{Platform: model.PlatformAndroid, Now: time.Now()},
+			want:    rules[1],
+			wantErr: false,
+		},
+		{
+			name: "NORMAL: 一致するルールがない場合はnilを返す",
+			FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.RedirectRule, error) {
+				return rules, nil
+			},
+			c:       model.RuleContext{Platform: model.PlatformWindows, Now: time.Now()},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "ERROR: repo.FindByAnonyURLIDがERRORを返す",
+			FakeFindByAnonyURLID: func(anonyURLID string) ([]*model.RedirectRule, error) {
+				return nil, fmt.Errorf("error")
+			},
+			c:       model.RuleContext{Platform: model.PlatformIOS, Now: time.Now()},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &redirectRuleUseCase{
+				repo: testutils.RedirectRuleRepoMock{
+					FakeFindByAnonyURLID: tt.FakeFindByAnonyURLID,
+				},
+			}
+			got, err := u.MatchRedirectRule(context.Background(), "url-id", tt.c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("redirectRuleUseCase.MatchRedirectRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("redirectRuleUseCase.MatchRedirectRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+)
+
+// Scheduler publishes status-change events when scheduled AnonyURLs reach active_from or active_until
+type Scheduler struct {
+	repo     repository.AnonyURLRepository
+	interval time.Duration
+	events   EventPublisher
+	last     time.Time
+}
+
+// NewScheduler creates a Scheduler checking the boundaries every interval
+// 作成前に過ぎた境界のイベントは発行しない
+func NewScheduler(r repository.AnonyURLRepository, interval time.Duration, ep EventPublisher) *Scheduler {
+	return &Scheduler{repo: r, interval: interval, events: ep, last: time.Now()}
+}
+
+// Run checks the boundaries until ctx is done
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.Tick(ctx, now); err != nil {
+				log.Printf("failed to check schedules: %s", err)
+			}
+		}
+	}
+}
+
+// Tick publishes events of the boundaries between the last tick and now in order of time
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) error {
+	ans, err := s.repo.FindByScheduleBoundary(s.last, now)
+	if err != nil {
+		// 失敗した場合は次のTickで同じ区間を確認する
+		return err
+	}
+	events := []model.StatusChangeEvent{}
+	for _, v := range ans {
+		events = append(events, v.ScheduleEvents(s.last, now)...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].At.Before(events[j].At)
+	})
+	for _, e := range events {
+		s.events.Publish(ctx, e)
+	}
+	s.last = now
+	return nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func TestScheduler_Tick(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start.Add(time.Minute)
+	launch := start.Add(10 * time.Second)
+	end := start.Add(50 * time.Second)
+	later := now.Add(time.Hour)
+	tests := []struct {
+		name                       string
+		FakeFindByScheduleBoundary func(from, to time.Time) ([]*model.AnonyURL, error)
+		want                       []model.StatusChangeEvent
+		wantLast                   time.Time
+		wantErr                    bool
+	}{
+		{
+			name: "NORMAL: 区間内の境界のイベントを時刻順に発行する",
+			FakeFindByScheduleBoundary: func(from, to time.Time) ([]*model.AnonyURL, error) {
+				return []*model.AnonyURL{
+					{ID: "ending", ActiveUntil: &end},
+					{ID: "launching", ActiveFrom: &launch, ActiveUntil: &later},
+				}, nil
+			},
+			want: []model.StatusChangeEvent{
+				{AnonyURLID: "launching", Active: true, At: launch},
+				{AnonyURLID: "ending", Active: false, At: end},
+			},
+			wantLast: now,
+			wantErr:  false,
+		},
+		{
+			name: "NORMAL: 境界がない場合",
+			FakeFindByScheduleBoundary: func(from, to time.Time) ([]*model.AnonyURL, error) {
+				return []*model.AnonyURL{}, nil
+			},
+			want:     nil,
+			wantLast: now,
+			wantErr:  false,
+		},
+		{
+			name: "ERROR: repo.FindByScheduleBoundaryがErrorを返す場合は区間を進めない",
+			FakeFindByScheduleBoundary: func(from, to time.Time) ([]*model.AnonyURL, error) {
+				return nil, fmt.Errorf("error")
+			},
+			want:     nil,
+			wantLast: start,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []model.StatusChangeEvent
+			s := NewScheduler(testutils.AnonyURLRepoMock{
+				FakeFindByScheduleBoundary: tt.FakeFindByScheduleBoundary,
+			}, time.Minute, eventRecorder(func(e model.Event) {
+				got = append(got, e.(model.StatusChangeEvent))
+			}))
+			s.last = start
+			if err := s.Tick(context.Background(), now); (err != nil) != tt.wantErr {
+				t.Errorf("Scheduler.Tick() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scheduler.Tick() events = %v, want %v", got, tt.want)
+			}
+			if !s.last.Equal(tt.wantLast) {
+				t.Errorf("Scheduler.Tick() last = %v, want %v", s.last, tt.wantLast)
+			}
+		})
+	}
+}
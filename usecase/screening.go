@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+)
+
+const (
+	screeningPageSize = 500
+	// blocked_reasonのカラム長
+	maxBlockedReasonLength = 255
+)
+
+// ScreeningUseCase is a usecase of screening registered AnonyURLs.
+type ScreeningUseCase interface {
+	// FlagBlockedAnonyURLs screens all AnonyURLs and returns the number of AnonyURLs whose flag is changed
+	FlagBlockedAnonyURLs(ctx context.Context) (int, error)
+}
+
+type screeningUseCase struct {
+	repo     repository.AnonyURLRepository
+	screener service.DestinationScreener
+}
+
+// NewScreeningUseCase creates screeningUseCase.
+func NewScreeningUseCase(r repository.AnonyURLRepository, sc service.DestinationScreener) ScreeningUseCase {
+	return &screeningUseCase{r, sc}
+}
+
+// FlagBlockedAnonyURLs flags AnonyURLs whose original or fallback becomes blocked
+// ブロックリストから外れた場合はフラグを解除する
+func (u *screeningUseCase) FlagBlockedAnonyURLs(ctx context.Context) (int, error) {
+	changed := 0
+	for afterID := ""; ; {
+		ans, err := u.repo.FindPage(afterID, screeningPageSize)
+		if err != nil {
+			return changed, err
+		}
+		for _, an := range ans {
+			reason, err := u.screen(ctx, an)
+			if err != nil {
+				return changed, err
+			}
+			if reason == an.BlockedReason {
+				continue
+			}
+			if err := u.repo.UpdateBlockedReason(ctx, an.ID, reason); err != nil {
+				return changed, err
+			}
+			changed++
+		}
+		if len(ans) < screeningPageSize {
+			return changed, nil
+		}
+		afterID = ans[len(ans)-1].ID
+	}
+}
+
+func (u *screeningUseCase) screen(ctx context.Context, an *model.AnonyURL) (string, error) {
+	for _, d := range []string{an.Original, an.Fallback} {
+		if d == "" {
+			continue
+		}
+		reason, err := u.screener.Screen(ctx, d)
+		if err != nil {
+			return "", err
+		}
+		if reason != "" {
+			r := []rune(reason)
+			if len(r) > maxBlockedReasonLength {
+				r = r[:maxBlockedReasonLength]
+			}
+			return string(r), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func Test_screeningUseCase_FlagBlockedAnonyURLs(t *testing.T) {
+	tests := []struct {
+		name        string
+		ans         []*model.AnonyURL
+		findErr     error
+		want        int
+		wantUpdated map[string]string
+		wantErr     bool
+	}{
+		{
+			name: "NORMAL: ブロックされたリンクにフラグを付ける",
+			ans: []*model.AnonyURL{
+				{ID: "id1", Original: "https://example.com/"},
+				{ID: "id2", Original: "http://blocked.example/phishing"},
+			},
+			want:        1,
+			wantUpdated: map[string]string{"id2": "blocked.example is in the blocklist"},
+			wantErr:     false,
+		},
+		{
+			name: "NORMAL: Fallbackがブロックされた場合もフラグを付ける",
+			ans: []*model.AnonyURL{
+				{ID: "id1", Original: "https://example.com/", Fallback: "http://blocked.example/"},
+			},
+			want:        1,
+			wantUpdated: map[string]string{"id1": "blocked.example is in the blocklist"},
+			wantErr:     false,
+		},
+		{
+			name: "NORMAL: ブロックリストから外れた場合はフラグを解除する",
+			ans: []*model.AnonyURL{
+				{ID: "id1", Original: "https://example.com/", BlockedReason: "old reason"},
+				{ID: "id2", Original: "http://blocked.example/", BlockedReason: "blocked.example is in the blocklist"},
+			},
+			want:        1,
+			wantUpdated: map[string]string{"id1": ""},
+			wantErr:     false,
+		},
+		{
+			name:        "ERROR: anonyURLRepo.FindPageがERRORを返す",
+			findErr:     fmt.Errorf("error"),
+			want:        0,
+			wantUpdated: map[string]string{},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := map[string]string{}
+			u := &screeningUseCase{
+				repo: testutils.AnonyURLRepoMock{
+					FakeFindPage: func(afterID string, limit int) ([]*model.AnonyURL, error) {
+						if afterID != "" {
+							return []*model.AnonyURL{}, nil
+						}
+						return tt.ans, tt.findErr
+					},
+					FakeUpdateBlockedReason: func(ctx context.Context, id string, reason string) error {
+						updated[id] = reason
+						return nil
+					},
+				},
+				screener: testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+			}
+			got, err := u.FlagBlockedAnonyURLs(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("screeningUseCase.FlagBlockedAnonyURLs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("screeningUseCase.FlagBlockedAnonyURLs() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(updated, tt.wantUpdated) {
+				t.Errorf("screeningUseCase.FlagBlockedAnonyURLs() updated = %v, want %v", updated, tt.wantUpdated)
+			}
+		})
+	}
+}
+
+func Test_screeningUseCase_FlagBlockedAnonyURLs_Paging(t *testing.T) {
+	pages := map[string][]*model.AnonyURL{}
+	first := make([]*model.AnonyURL, screeningPageSize)
+	for i := range first {
+		first[i] = &model.AnonyURL{ID: fmt.Sprintf("id%04d", i), Original: "https://example.com/"}
+	}
+	pages[""] = first
+	pages[first[len(first)-1].ID] = []*model.AnonyURL{{ID: "id9999", Original: "http://blocked.example/"}}
+
+	updated := []string{}
+	u := &screeningUseCase{
+		repo: testutils.AnonyURLRepoMock{
+			FakeFindPage: func(afterID string, limit int) ([]*model.AnonyURL, error) {
+				return pages[afterID], nil
+			},
+			FakeUpdateBlockedReason: func(ctx context.Context, id string, reason string) error {
+				updated = append(updated, id)
+				return nil
+			},
+		},
+		screener: testutils.DestinationScreenerMock{FakeScreen: screenBlockedExample},
+	}
+	got, err := u.FlagBlockedAnonyURLs(context.Background())
+	if err != nil {
+		t.Fatalf("screeningUseCase.FlagBlockedAnonyURLs() error = %v", err)
+	}
+	if got != 1 || !reflect.DeepEqual(updated, []string{"id9999"}) {
+		t.Errorf("screeningUseCase.FlagBlockedAnonyURLs() = %v, updated = %v", got, updated)
+	}
+}
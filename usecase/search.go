@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Tatsuemon/anony/usecase/dto"
+	"github.com/Tatsuemon/anony/usecase/queryservice"
+)
+
+// 検索語と件数の制限. ngramの単位が2文字のため1文字の語は使わない
+const (
+	minSearchTermLength = 2
+	maxSearchTerms      = 8
+	defaultSearchLimit  = 50
+	maxSearchLimit      = 200
+)
+
+// SearchUseCase is a usecase of searching AnonyURLs.
+type SearchUseCase interface {
+	// SearchAnonyURLs searches the user's AnonyURLs having all the words in the text
+	SearchAnonyURLs(ctx context.Context, userID, text string, limit, offset int) ([]*dto.AnonyURLSearchResult, error)
+}
+
+type searchUseCase struct {
+	searcher queryservice.AnonyURLSearcher
+}
+
+// NewSearchUseCase creates searchUseCase.
+func NewSearchUseCase(s queryservice.AnonyURLSearcher) SearchUseCase {
+	return &searchUseCase{s}
+}
+
+func (u *searchUseCase) SearchAnonyURLs(ctx context.Context, userID, text string, limit, offset int) ([]*dto.AnonyURLSearchResult, error) {
+	terms := searchTerms(text)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("query must contain a word of at least %d characters", minSearchTermLength)
+	}
+	if limit < 0 || offset < 0 {
+		return nil, fmt.Errorf("limit and offset must not be negative")
+	}
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	return u.searcher.SearchAnonyURLs(userID, dto.AnonyURLSearchQuery{Terms: terms, Limit: limit, Offset: offset})
+}
+
+// searchTerms splits the text into lowercase words without duplicates
+func searchTerms(text string) []string {
+	terms := []string{}
+	seen := map[string]struct{}{}
+	for _, w := range strings.Fields(strings.ToLower(text)) {
+		if utf8.RuneCountInString(w) < minSearchTermLength {
+			continue
+		}
+		if _, ok := seen[w]; ok {
+			continue
+		}
+		seen[w] = struct{}{}
+		terms = append(terms, w)
+		if len(terms) == maxSearchTerms {
+			break
+		}
+	}
+	return terms
+}
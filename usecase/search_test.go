@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/memory"
+)
+
+func Test_searchTerms(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "NORMAL: 小文字にして空白で区切る", text: " Go  Blog\tgo ", want: []string{"go", "blog"}},
+		{name: "NORMAL: 1文字の語は使わない", text: "a 検索 b", want: []string{"検索"}},
+		{name: "NORMAL: 語が無い", text: "a b", want: []string{}},
+		{name: "NORMAL: 8語まで", text: "aa bb cc dd ee ff gg hh ii", want: []string{"aa", "bb", "cc", "dd", "ee", "ff", "gg", "hh"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := searchTerms(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("searchTerms() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_searchUseCase_SearchAnonyURLs(t *testing.T) {
+	s := memory.NewAnonyURLSearcher()
+	for i, original := range []string{"https://go.dev/", "https://go.dev/blog/", "https://example.com/"} {
+		s.Put("user", &model.AnonyURL{ID: string(rune('a' + i)), Original: original, Short: "code"})
+	}
+	tests := []struct {
+		name    string
+		text    string
+		limit   int
+		offset  int
+		want    []string
+		wantErr bool
+	}{
+		{name: "NORMAL: 全ての語を含むリンクを返す", text: "GO blog", want: []string{"b"}},
+		{name: "NORMAL: LimitとOffset", text: "go.dev", limit: 1, offset: 1, want: []string{"b"}},
+		{name: "ERROR: 検索語が無い", text: " x ", wantErr: true},
+		{name: "ERROR: Offsetが負", text: "go", offset: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewSearchUseCase(s)
+			res, err := u.SearchAnonyURLs(context.Background(), "user", tt.text, tt.limit, tt.offset)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("searchUseCase.SearchAnonyURLs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := []string{}
+			for _, r := range res {
+				got = append(got, r.AnonyURL.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("searchUseCase.SearchAnonyURLs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/datastore"
+	"github.com/Tatsuemon/anony/usecase/dto"
+	"github.com/Tatsuemon/anony/usecase/queryservice"
+)
+
+// 一度にタグ付けできるリンクとタグの数
+const (
+	maxTagBulkAnonyURLs = 500
+	maxTagBulkTags      = 20
+)
+
+// ErrTagNotFound is returned when the tag does not exist or belongs to another user
+var ErrTagNotFound = errors.New("tag is not found")
+
+// AnonyURLKey identifies the user's AnonyURL by the original and the UTM
+type AnonyURLKey struct {
+	Original string
+	UTM      model.UTM
+}
+
+// TagUseCase is a usecase of tag.
+type TagUseCase interface {
+	CreateTag(ctx context.Context, t *model.Tag) (*model.Tag, error)
+	RenameTag(ctx context.Context, id, name, userID string) (*model.Tag, error)
+	DeleteTag(ctx context.Context, id, userID string) error
+	ListTags(ctx context.Context, userID string) (model.Tags, error)
+	TagAnonyURLs(ctx context.Context, tagIDs []string, keys []AnonyURLKey, userID string) (int, error)
+	UntagAnonyURLs(ctx context.Context, tagIDs []string, keys []AnonyURLKey, userID string) (int, error)
+	// ListAnonyURLsInTags lists the user's AnonyURLs having all the tags or their descendants
+	ListAnonyURLsInTags(ctx context.Context, userID string, status int64, tagIDs []string) ([]*model.AnonyURL, error)
+	CountAnonyURLsInTags(ctx context.Context, userID string, tagIDs []string) (*dto.AnonyURLCountByUser, error)
+	// AttachTags sets the tags on each AnonyURL of the user
+	AttachTags(ctx context.Context, userID string, ans []*model.AnonyURL) error
+}
+
+type tagUseCase struct {
+	repo         repository.TagRepository
+	anonyURLRepo repository.AnonyURLRepository
+	accessor     queryservice.TaggedAnonyURLAccessor
+	transaction  datastore.Transaction
+	audit        service.AuditService
+}
+
+// NewTagUseCase creates tagUseCase.
+func NewTagUseCase(r repository.TagRepository, ar repository.AnonyURLRepository, a queryservice.TaggedAnonyURLAccessor, t datastore.Transaction, as service.AuditService) TagUseCase {
+	return &tagUseCase{r, ar, a, t, as}
+}
+
+// auditTagIDs is the value of audit events of tagging AnonyURLs
+type auditTagIDs struct {
+	TagIDs []string `json:"tag_ids"`
+}
+
+func (u *tagUseCase) CreateTag(ctx context.Context, t *model.Tag) (*model.Tag, error) {
+	if err := t.ValidateTag(); err != nil {
+		return nil, err
+	}
+	tags, err := u.repo.FindByUserID(t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if t.ParentID != "" {
+		if tags.Find(t.ParentID) == nil {
+			return nil, fmt.Errorf("parent %s: %w", t.ParentID, ErrTagNotFound)
+		}
+		if tags.Depth(t.ParentID) >= model.MaxTagDepth {
+			return nil, fmt.Errorf("tags can be nested up to %d levels", model.MaxTagDepth)
+		}
+	}
+	if tags.HasSibling(t.Name, t.ParentID, t.ID) {
+		return nil, fmt.Errorf("tag %q already exists", t.Name)
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, t.UserID, model.AuditTagCreate, model.AuditTargetTag, t.ID, nil, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.findWithPath(t.ID, t.UserID)
+}
+
+func (u *tagUseCase) RenameTag(ctx context.Context, id, name, userID string) (*model.Tag, error) {
+	tags, err := u.repo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	t := tags.Find(id)
+	if t == nil {
+		return nil, ErrTagNotFound
+	}
+	renamed := model.NewTag(t.ID, t.UserID, name, t.ParentID)
+	if err := renamed.ValidateTag(); err != nil {
+		return nil, err
+	}
+	if tags.HasSibling(renamed.Name, renamed.ParentID, renamed.ID) {
+		return nil, fmt.Errorf("tag %q already exists", renamed.Name)
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.UpdateName(ctx, renamed.ID, renamed.Name); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditTagRename, model.AuditTargetTag, renamed.ID, t, renamed)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.findWithPath(renamed.ID, userID)
+}
+
+// DeleteTag deletes the tag and its descendants. リンク自体は削除しない
+func (u *tagUseCase) DeleteTag(ctx context.Context, id, userID string) error {
+	t, err := u.repo.FindByID(id)
+	if err != nil {
+		return err
+	}
+	// 他のユーザーのタグは存在しないものとして扱う
+	if t == nil || t.UserID != userID {
+		return ErrTagNotFound
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditTagDelete, model.AuditTargetTag, id, t, nil)
+	})
+	return err
+}
+
+func (u *tagUseCase) ListTags(ctx context.Context, userID string) (model.Tags, error) {
+	tags, err := u.repo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	tags.FillPaths()
+	return tags, nil
+}
+
+func (u *tagUseCase) TagAnonyURLs(ctx context.Context, tagIDs []string, keys []AnonyURLKey, userID string) (int, error) {
+	ids, err := u.resolveBulk(tagIDs, keys, userID)
+	if err != nil {
+		return 0, err
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.AddAnonyURLs(ctx, tagIDs, ids); err != nil {
+			return nil, err
+		}
+		// リンクごとに記録する
+		for _, id := range ids {
+			if err := u.audit.Record(ctx, userID, model.AuditAnonyURLTag, model.AuditTargetAnonyURL, id, nil, auditTagIDs{tagIDs}); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+func (u *tagUseCase) UntagAnonyURLs(ctx context.Context, tagIDs []string, keys []AnonyURLKey, userID string) (int, error) {
+	ids, err := u.resolveBulk(tagIDs, keys, userID)
+	if err != nil {
+		return 0, err
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.RemoveAnonyURLs(ctx, tagIDs, ids); err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if err := u.audit.Record(ctx, userID, model.AuditAnonyURLUntag, model.AuditTargetAnonyURL, id, auditTagIDs{tagIDs}, nil); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+func (u *tagUseCase) ListAnonyURLsInTags(ctx context.Context, userID string, status int64, tagIDs []string) ([]*model.AnonyURL, error) {
+	if status < 0 || status > 2 {
+		return nil, fmt.Errorf("out of range")
+	}
+	groups, err := u.tagGroups(tagIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.accessor.FindAnonyURLsByTags(userID, status, groups)
+}
+
+func (u *tagUseCase) CountAnonyURLsInTags(ctx context.Context, userID string, tagIDs []string) (*dto.AnonyURLCountByUser, error) {
+	groups, err := u.tagGroups(tagIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.accessor.CountAnonyURLByUserInTags(userID, groups)
+}
+
+func (u *tagUseCase) AttachTags(ctx context.Context, userID string, ans []*model.AnonyURL) error {
+	if len(ans) == 0 {
+		return nil
+	}
+	tags, err := u.repo.FindByUserID(userID)
+	if err != nil {
+		return err
+	}
+	tags.FillPaths()
+	tagIDs, err := u.accessor.FindTagIDsByUserID(userID)
+	if err != nil {
+		return err
+	}
+	for _, an := range ans {
+		an.Tags = make([]*model.Tag, 0, len(tagIDs[an.ID]))
+		for _, id := range tagIDs[an.ID] {
+			if t := tags.Find(id); t != nil {
+				an.Tags = append(an.Tags, t)
+			}
+		}
+	}
+	return nil
+}
+
+// findWithPath returns the user's tag with the path
+func (u *tagUseCase) findWithPath(id, userID string) (*model.Tag, error) {
+	tags, err := u.repo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	tags.FillPaths()
+	t := tags.Find(id)
+	if t == nil {
+		return nil, ErrTagNotFound
+	}
+	return t, nil
+}
+
+// tagGroups returns each tag with its descendants to match links tagged in the folder
+func (u *tagUseCase) tagGroups(tagIDs []string, userID string) ([][]string, error) {
+	if len(tagIDs) == 0 {
+		return nil, fmt.Errorf("tag_ids are required")
+	}
+	tags, err := u.repo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([][]string, len(tagIDs))
+	for i, id := range tagIDs {
+		if tags.Find(id) == nil {
+			return nil, fmt.Errorf("%s: %w", id, ErrTagNotFound)
+		}
+		groups[i] = tags.Descendants(id)
+	}
+	return groups, nil
+}
+
+// resolveBulk checks the user's tags and returns the ids of the user's AnonyURLs
+func (u *tagUseCase) resolveBulk(tagIDs []string, keys []AnonyURLKey, userID string) ([]string, error) {
+	if len(tagIDs) == 0 || len(keys) == 0 {
+		return nil, fmt.Errorf("tag_ids and links are required")
+	}
+	if len(tagIDs) > maxTagBulkTags || len(keys) > maxTagBulkAnonyURLs {
+		return nil, fmt.Errorf("up to %d tags and %d links can be changed at once", maxTagBulkTags, maxTagBulkAnonyURLs)
+	}
+	tags, err := u.repo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range tagIDs {
+		if tags.Find(id) == nil {
+			return nil, fmt.Errorf("%s: %w", id, ErrTagNotFound)
+		}
+	}
+
+	ids := make([]string, 0, len(keys))
+	seen := map[string]struct{}{}
+	for _, k := range keys {
+		an, err := findByOriginalInUser(u.anonyURLRepo, k.Original, k.UTM, userID)
+		if err != nil {
+			return nil, err
+		}
+		if an == nil {
+			return nil, fmt.Errorf("anonyURL of %s is not existed", k.Original)
+		}
+		if _, ok := seen[an.ID]; ok {
+			continue
+		}
+		seen[an.ID] = struct{}{}
+		ids = append(ids, an.ID)
+	}
+	return ids, nil
+}
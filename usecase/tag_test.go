@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+// work ── clients ── acme
+// home
+var testTags = model.Tags{
+	{ID: "work", UserID: "user", Name: "Work"},
+	{ID: "clients", UserID: "user", Name: "Clients", ParentID: "work"},
+	{ID: "acme", UserID: "user", Name: "ACME", ParentID: "clients"},
+	{ID: "home", UserID: "user", Name: "Home"},
+}
+
+func tagRepoMock(saved *[]*model.Tag) testutils.TagRepoMock {
+	return testutils.TagRepoMock{
+		FakeFindByUserID: func(userID string) (model.Tags, error) {
+			// FillPathsで書き換えられるのでコピーを返す
+			res := model.Tags{}
+			for _, t := range append(testTags, *saved...) {
+				if t.UserID == userID {
+					tmp := *t
+					res = append(res, &tmp)
+				}
+			}
+			return res, nil
+		},
+		FakeFindByID: func(id string) (*model.Tag, error) {
+			for _, t := range *saved {
+				if t.ID == id {
+					return t, nil
+				}
+			}
+			return testTags.Find(id), nil
+		},
+		FakeSave: func(ctx context.Context, t *model.Tag) error {
+			*saved = append(*saved, t)
+			return nil
+		},
+	}
+}
+
+func Test_tagUseCase_CreateTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      *model.Tag
+		wantPath string
+		wantErr  bool
+	}{
+		{name: "NORMAL: 最上位のタグを作成する", tag: model.NewTag("new", "user", "Private", ""), wantPath: "Private", wantErr: false},
+		{name: "NORMAL: 子タグを作成する", tag: model.NewTag("new", "user", "Partners", "work"), wantPath: "Work/Partners", wantErr: false},
+		{name: "NORMAL: 別の親の下では同じ名前を使える", tag: model.NewTag("new", "user", "Clients", ""), wantPath: "Clients", wantErr: false},
+		{name: "ERROR: 同じ親の下に同じ名前がある", tag: model.NewTag("new", "user", "clients", "work"), wantErr: true},
+		{name: "ERROR: 親タグが存在しない", tag: model.NewTag("new", "user", "Partners", "unknown"), wantErr: true},
+		{name: "ERROR: 他のユーザーの親タグ", tag: model.NewTag("new", "other", "Partners", "work"), wantErr: true},
+		{name: "ERROR: 名前が不正", tag: model.NewTag("new", "user", "a/b", ""), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := []*model.Tag{}
+			u := &tagUseCase{repo: tagRepoMock(&saved), transaction: testutils.TransactionMock{}, audit: auditNothing}
+			got, err := u.CreateTag(context.Background(), tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("tagUseCase.CreateTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(saved) != 0 {
+					t.Errorf("tagUseCase.CreateTag() saved %v", saved)
+				}
+				return
+			}
+			if got.ID != tt.tag.ID || got.Name != tt.tag.Name || got.ParentID != tt.tag.ParentID || got.Path != tt.wantPath {
+				t.Errorf("tagUseCase.CreateTag() = %+v, want %+v with path %v", got, tt.tag, tt.wantPath)
+			}
+		})
+	}
+}
+
+func Test_tagUseCase_CreateTag_Depth(t *testing.T) {
+	tags := model.Tags{}
+	parent := ""
+	for i := 0; i < model.MaxTagDepth; i++ {
+		id := string(rune('a' + i))
+		tags = append(tags, &model.Tag{ID: id, UserID: "user", Name: id, ParentID: parent})
+		parent = id
+	}
+	u := &tagUseCase{
+		repo: testutils.TagRepoMock{
+			FakeFindByUserID: func(userID string) (model.Tags, error) { return tags, nil },
+		},
+		transaction: testutils.TransactionMock{},
+		audit:       auditNothing,
+	}
+	if _, err := u.CreateTag(context.Background(), model.NewTag("new", "user", "deep", parent)); err == nil {
+		t.Errorf("tagUseCase.CreateTag() error = nil, want error")
+	}
+}
+
+func Test_tagUseCase_TagAnonyURLs(t *testing.T) {
+	ans := map[string]*model.AnonyURL{
+		"https://example.com/": {ID: "id1", Original: "https://example.com/"},
+		"https://example.org/": {ID: "id2", Original: "https://example.org/"},
+	}
+	tests := []struct {
+		name    string
+		tagIDs  []string
+		keys    []AnonyURLKey
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "NORMAL: 同じリンクは1度だけタグ付けする",
+			tagIDs: []string{"work", "home"},
+			keys: []AnonyURLKey{
+				{Original: "https://example.com/"},
+				{Original: "https://EXAMPLE.com"},
+				{Original: "https://example.org/"},
+			},
+			want: []string{"id1", "id2"},
+		},
+		{
+			name:    "ERROR: 他のユーザーのタグ",
+			tagIDs:  []string{"others"},
+			keys:    []AnonyURLKey{{Original: "https://example.com/"}},
+			wantErr: ErrTagNotFound,
+		},
+		{
+			name:    "ERROR: リンクが存在しない",
+			tagIDs:  []string{"work"},
+			keys:    []AnonyURLKey{{Original: "https://unknown.example/"}},
+			wantErr: errors.New("anonyURL of https://unknown.example/ is not existed"),
+		},
+		{
+			name:    "ERROR: リンクが空",
+			tagIDs:  []string{"work"},
+			wantErr: errors.New("tag_ids and links are required"),
+		},
+		{
+			name:    "ERROR: 上限を超える",
+			tagIDs:  []string{"work"},
+			keys:    make([]AnonyURLKey, maxTagBulkAnonyURLs+1),
+			wantErr: errors.New("up to 20 tags and 500 links can be changed at once"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added []string
+			saved := []*model.Tag{}
+			repo := tagRepoMock(&saved)
+			repo.FakeAddAnonyURLs = func(ctx context.Context, tagIDs []string, anonyURLIDs []string) error {
+				added = anonyURLIDs
+				return nil
+			}
+			u := &tagUseCase{
+				repo: repo,
+				anonyURLRepo: testutils.AnonyURLRepoMock{
+					FakeFindByOriginalInUser: func(original string, utm model.UTM, userID string) (*model.AnonyURL, error) {
+						return ans[original], nil
+					},
+				},
+				transaction: testutils.TransactionMock{},
+				audit:       auditNothing,
+			}
+			got, err := u.TagAnonyURLs(context.Background(), tt.tagIDs, tt.keys, "user")
+			if tt.wantErr != nil {
+				if err == nil || (!errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error()) {
+					t.Errorf("tagUseCase.TagAnonyURLs() error = %v, want %v", err, tt.wantErr)
+				}
+				if added != nil {
+					t.Errorf("tagUseCase.TagAnonyURLs() added %v", added)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("tagUseCase.TagAnonyURLs() error = %v", err)
+			}
+			if got != len(tt.want) || !reflect.DeepEqual(added, tt.want) {
+				t.Errorf("tagUseCase.TagAnonyURLs() = %v, %v, want %v", got, added, tt.want)
+			}
+		})
+	}
+}
+
+func Test_tagUseCase_ListAnonyURLsInTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		tagIDs     []string
+		wantGroups [][]string
+		wantErr    bool
+	}{
+		{
+			name:       "NORMAL: 親タグは子孫のタグを含む",
+			tagIDs:     []string{"work", "home"},
+			wantGroups: [][]string{{"work", "clients", "acme"}, {"home"}},
+		},
+		{name: "ERROR: 存在しないタグ", tagIDs: []string{"unknown"}, wantErr: true},
+		{name: "ERROR: タグが空", tagIDs: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotGroups [][]string
+			saved := []*model.Tag{}
+			u := &tagUseCase{
+				repo: tagRepoMock(&saved),
+				accessor: testutils.TaggedAnonyURLAccessorMock{
+					FakeFindAnonyURLsByTags: func(userID string, status int64, tagGroups [][]string) ([]*model.AnonyURL, error) {
+						gotGroups = tagGroups
+						return []*model.AnonyURL{}, nil
+					},
+				},
+			}
+			_, err := u.ListAnonyURLsInTags(context.Background(), "user", 1, tt.tagIDs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("tagUseCase.ListAnonyURLsInTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(gotGroups, tt.wantGroups) {
+				t.Errorf("tagUseCase.ListAnonyURLsInTags() groups = %v, want %v", gotGroups, tt.wantGroups)
+			}
+		})
+	}
+}
+
+func Test_tagUseCase_AttachTags(t *testing.T) {
+	saved := []*model.Tag{}
+	u := &tagUseCase{
+		repo: tagRepoMock(&saved),
+		accessor: testutils.TaggedAnonyURLAccessorMock{
+			FakeFindTagIDsByUserID: func(userID string) (map[string][]string, error) {
+				return map[string][]string{"id1": {"acme", "home"}}, nil
+			},
+		},
+	}
+	ans := []*model.AnonyURL{{ID: "id1"}, {ID: "id2"}}
+	if err := u.AttachTags(context.Background(), "user", ans); err != nil {
+		t.Fatalf("tagUseCase.AttachTags() error = %v", err)
+	}
+	var got []string
+	for _, tag := range ans[0].Tags {
+		got = append(got, tag.Path)
+	}
+	if want := []string{"Work/Clients/ACME", "Home"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("tagUseCase.AttachTags() = %v, want %v", got, want)
+	}
+	if len(ans[1].Tags) != 0 || ans[1].Tags == nil {
+		t.Errorf("tagUseCase.AttachTags() = %v, want empty", ans[1].Tags)
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Tatsuemon/anony/domain/model"
 	"github.com/Tatsuemon/anony/domain/repository"
@@ -24,11 +25,13 @@ type userUseCase struct {
 	repo        repository.UserRepository
 	transaction datastore.Transaction
 	service     service.UserService
+	events      EventPublisher
+	audit       service.AuditService
 }
 
 // NewUserUseCase creates userUseCase.
-func NewUserUseCase(r repository.UserRepository, t datastore.Transaction, s service.UserService) UserUseCase {
-	return &userUseCase{r, t, s}
+func NewUserUseCase(r repository.UserRepository, t datastore.Transaction, s service.UserService, ep EventPublisher, as service.AuditService) UserUseCase {
+	return &userUseCase{r, t, s, ep, as}
 }
 
 func (u *userUseCase) CreateUser(ctx context.Context, user *model.User) (*model.User, error) {
@@ -44,12 +47,21 @@ func (u *userUseCase) CreateUser(ctx context.Context, user *model.User) (*model.
 	}
 
 	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
-		return nil, u.repo.Save(ctx, user)
+		if err := u.repo.Save(ctx, user); err != nil {
+			return nil, err
+		}
+		// 作成したユーザー自身を操作したユーザーとして記録する
+		return nil, u.audit.Record(ctx, user.ID, model.AuditUserCreate, model.AuditTargetUser, user.ID, nil, user)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return u.repo.FindByID(user.ID)
+	saved, err := u.repo.FindByID(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	u.events.Publish(ctx, model.UserCreated{User: saved, At: time.Now()})
+	return saved, nil
 }
 
 // true: 重複するものは存在しない
@@ -75,10 +87,14 @@ func (u *userUseCase) UpdateUser(ctx context.Context, user *model.User) (*model.
 		return nil, err
 	}
 	_, err := u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
-		if _, err := u.repo.FindByID(user.ID); err != nil {
+		before, err := u.repo.FindByID(user.ID)
+		if err != nil {
 			return nil, err
 		}
-		return nil, u.repo.Update(ctx, user)
+		if err := u.repo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, user.ID, model.AuditUserUpdate, model.AuditTargetUser, user.ID, before, user)
 	})
 	if err != nil {
 		return nil, err
@@ -87,6 +103,7 @@ func (u *userUseCase) UpdateUser(ctx context.Context, user *model.User) (*model.
 }
 
 func (u *userUseCase) DeleteUser(ctx context.Context, id string) error {
+	deleted := false
 	_, err := u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
 		user, err := u.repo.FindByID(id)
 		if err != nil {
@@ -95,7 +112,17 @@ func (u *userUseCase) DeleteUser(ctx context.Context, id string) error {
 		if user == nil {
 			return nil, nil
 		}
-		return nil, u.repo.Delete(ctx, user)
+		deleted = true
+		if err := u.repo.Delete(ctx, user); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, id, model.AuditUserDelete, model.AuditTargetUser, id, user, nil)
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	if deleted {
+		u.events.Publish(ctx, model.UserDeleted{UserID: id, At: time.Now()})
+	}
+	return nil
 }
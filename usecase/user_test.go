@@ -15,6 +15,7 @@ import (
 func TestNewUserUseCase(t *testing.T) {
 	db := testutils.GetTestDB().DB
 	transaction := datastore.NewTransaction(db)
+	events := NewEventBus(0)
 	tests := []struct {
 		name string
 		want UserUseCase
@@ -25,6 +26,8 @@ func TestNewUserUseCase(t *testing.T) {
 				testutils.UserRepoMock{},
 				transaction,
 				testutils.UserServiceMock{},
+				events,
+				testutils.AuditServiceMock{},
 			},
 		},
 	}
@@ -32,7 +35,7 @@ func TestNewUserUseCase(t *testing.T) {
 		repo := testutils.UserRepoMock{}
 		service := testutils.UserServiceMock{}
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewUserUseCase(repo, transaction, service); !reflect.DeepEqual(got, tt.want) {
+			if got := NewUserUseCase(repo, transaction, service, events, testutils.AuditServiceMock{}); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewUserUseCase() = %v, want %v", got, tt.want)
 			}
 		})
@@ -222,6 +225,8 @@ func Test_userUseCase_CreateUser(t *testing.T) {
 				repo:        repo,
 				transaction: transaction,
 				service:     service,
+				events:      NewEventBus(0),
+				audit:       auditNothing,
 			}
 			got, err := u.CreateUser(tt.args.ctx, tt.args.user)
 			if (err != nil) != tt.wantErr {
@@ -569,6 +574,7 @@ func Test_userUseCase_UpdateUser(t *testing.T) {
 				repo:        repo,
 				transaction: transaction,
 				service:     service,
+				audit:       auditNothing,
 			}
 			got, err := u.UpdateUser(tt.args.ctx, tt.args.user)
 			if (err != nil) != tt.wantErr {
@@ -669,6 +675,8 @@ func Test_userUseCase_DeleteUser(t *testing.T) {
 				repo:        repo,
 				transaction: transaction,
 				service:     service,
+				events:      NewEventBus(0),
+				audit:       auditNothing,
 			}
 			if err := u.DeleteUser(tt.args.ctx, tt.args.id); (err != nil) != tt.wantErr {
 				t.Errorf("userUseCase.DeleteUser() error = %v, wantErr %v", err, tt.wantErr)
@@ -682,8 +690,9 @@ func SetUserUseCase() UserUseCase {
 	db := testutils.GetTestDB().DB
 	transaction := datastore.NewTransaction(db)
 	repository := datastore.NewUserRepository(db)
+	audit := service.NewAuditService(datastore.NewAuditEventRepository(db))
 	service := service.NewUserService(repository)
-	return NewUserUseCase(repository, transaction, service)
+	return NewUserUseCase(repository, transaction, service, NewEventBus(0), audit)
 }
 
 func Test_userUseCase_CreateUser_DB(t *testing.T) {
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/datastore"
+)
+
+// ErrWebhookNotFound is returned when the webhook does not exist or belongs to another user
+var ErrWebhookNotFound = errors.New("webhook is not found")
+
+// WebhookUseCase is a usecase of webhook.
+type WebhookUseCase interface {
+	CreateWebhook(ctx context.Context, w *model.Webhook) (*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, id, userID string) error
+	ListWebhooks(ctx context.Context, userID string) ([]*model.Webhook, error)
+	// ListDeadDeliveries lists the deliveries of the webhook given up after the retries
+	ListDeadDeliveries(ctx context.Context, webhookID, userID string) ([]*model.WebhookDelivery, error)
+	// RedeliverWebhookDelivery queues the delivery given up again
+	RedeliverWebhookDelivery(ctx context.Context, id, userID string) (*model.WebhookDelivery, error)
+	// NotifyStatusChange writes anony_url.expired to the outbox when the AnonyURL reaches active_until
+	NotifyStatusChange(ctx context.Context, e model.StatusChangeEvent) error
+}
+
+type webhookUseCase struct {
+	repo         repository.WebhookRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	anonyURLRepo repository.AnonyURLRepository
+	service      service.WebhookService
+	sender       service.WebhookSender
+	transaction  datastore.Transaction
+	audit        service.AuditService
+}
+
+// NewWebhookUseCase creates webhookUseCase.
+func NewWebhookUseCase(r repository.WebhookRepository, dr repository.WebhookDeliveryRepository, ar repository.AnonyURLRepository, s service.WebhookService, ws service.WebhookSender, t datastore.Transaction, as service.AuditService) WebhookUseCase {
+	return &webhookUseCase{r, dr, ar, s, ws, t, as}
+}
+
+func (u *webhookUseCase) CreateWebhook(ctx context.Context, w *model.Webhook) (*model.Webhook, error) {
+	if err := w.ValidateWebhook(); err != nil {
+		return nil, err
+	}
+	if err := u.sender.CheckURL(ctx, w.URL); err != nil {
+		return nil, fmt.Errorf("url is not allowed: %w", err)
+	}
+	ws, err := u.repo.FindByUserID(w.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ws) >= model.MaxWebhooksPerUser {
+		return nil, fmt.Errorf("up to %d webhooks can be created", model.MaxWebhooksPerUser)
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.Save(ctx, w); err != nil {
+			return nil, err
+		}
+		// secretはJSONに含めないので記録されない
+		return nil, u.audit.Record(ctx, w.UserID, model.AuditWebhookCreate, model.AuditTargetWebhook, w.ID, nil, w)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.repo.FindByID(w.ID)
+}
+
+// DeleteWebhook deletes the webhook. 送信待ちのイベントも削除する
+func (u *webhookUseCase) DeleteWebhook(ctx context.Context, id, userID string) error {
+	w, err := u.findOwnWebhook(id, userID)
+	if err != nil {
+		return err
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.repo.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditWebhookDelete, model.AuditTargetWebhook, id, w, nil)
+	})
+	return err
+}
+
+func (u *webhookUseCase) ListWebhooks(ctx context.Context, userID string) ([]*model.Webhook, error) {
+	return u.repo.FindByUserID(userID)
+}
+
+func (u *webhookUseCase) ListDeadDeliveries(ctx context.Context, webhookID, userID string) ([]*model.WebhookDelivery, error) {
+	if _, err := u.findOwnWebhook(webhookID, userID); err != nil {
+		return nil, err
+	}
+	return u.deliveryRepo.FindDeadByWebhookID(webhookID)
+}
+
+// RedeliverWebhookDelivery resets the attempts and sends the delivery at the next dispatch
+func (u *webhookUseCase) RedeliverWebhookDelivery(ctx context.Context, id, userID string) (*model.WebhookDelivery, error) {
+	d, err := u.deliveryRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, fmt.Errorf("delivery %s: %w", id, ErrWebhookNotFound)
+	}
+	if _, err := u.findOwnWebhook(d.WebhookID, userID); err != nil {
+		return nil, err
+	}
+	if d.Status != model.WebhookDeliveryDead {
+		return nil, fmt.Errorf("only deliveries given up can be redelivered")
+	}
+	before := *d
+	d.Status = model.WebhookDeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = time.Now()
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		if err := u.deliveryRepo.UpdateAttempt(ctx, d); err != nil {
+			return nil, err
+		}
+		return nil, u.audit.Record(ctx, userID, model.AuditWebhookRedeliver, model.AuditTargetWebhook, d.WebhookID, auditDelivery(&before), auditDelivery(d))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (u *webhookUseCase) NotifyStatusChange(ctx context.Context, e model.StatusChangeEvent) error {
+	// 有効期間の開始は通知しない
+	if e.Active {
+		return nil
+	}
+	an, err := u.anonyURLRepo.FindByID(e.AnonyURLID)
+	if err != nil {
+		return err
+	}
+	userID, err := u.anonyURLRepo.GetUserIDByID(e.AnonyURLID)
+	if err != nil {
+		return err
+	}
+	// 通知までに削除された場合
+	if an == nil || userID == "" {
+		return nil
+	}
+	_, err = u.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		return nil, u.service.Enqueue(ctx, userID, model.NewWebhookEvent(model.WebhookEventAnonyURLExpired, an, e.At))
+	})
+	return err
+}
+
+// auditDelivery is the value of the delivery in audit events. ペイロードは大きいので含めない
+func auditDelivery(d *model.WebhookDelivery) interface{} {
+	return struct {
+		ID            string    `json:"id"`
+		Status        int64     `json:"status"`
+		Attempts      int64     `json:"attempts"`
+		NextAttemptAt time.Time `json:"next_attempt_at"`
+		LastError     string    `json:"last_error"`
+	}{d.ID, d.Status, d.Attempts, d.NextAttemptAt, d.LastError}
+}
+
+// findOwnWebhook returns ErrWebhookNotFound for webhooks of other users
+func (u *webhookUseCase) findOwnWebhook(id, userID string) (*model.Webhook, error) {
+	w, err := u.repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil || w.UserID != userID {
+		return nil, ErrWebhookNotFound
+	}
+	return w, nil
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/domain/repository"
+	"github.com/Tatsuemon/anony/domain/service"
+	"github.com/Tatsuemon/anony/infrastructure/datastore"
+)
+
+// 1つのWebhookへ1回の送信で取り出すoutboxの件数
+const webhookDispatchBatchSize = 100
+
+// webhookClaimDuration is how long a process keeps the webhook to send to
+// 1つのWebhookへのバッチを送りきれる長さにする. 送信中にプロセスが止まった場合は, 期限の後に他のプロセスが送る
+const webhookClaimDuration = 30 * time.Minute
+
+// WebhookDispatcher sends the deliveries in the outbox to webhooks
+// 失敗した送信はmodel.WebhookRetryDelayの間隔で再試行し, 上限に達したものは送信しない
+// 送信するWebhookをDBで確保するため, 複数のプロセスで動かしても同じ送信を同時に送らない
+// 同じWebhookへは書き込んだ順に送り, 再試行を待つ送信があればその後のものも待たせる
+type WebhookDispatcher struct {
+	repo         repository.WebhookRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	transaction  datastore.Transaction
+	sender       service.WebhookSender
+	interval     time.Duration
+	concurrency  int
+}
+
+// NewWebhookDispatcher creates a WebhookDispatcher checking the outbox every interval
+// concurrencyは同時に送信するWebhookの数. 同じWebhookへは1件ずつ順に送る
+func NewWebhookDispatcher(r repository.WebhookRepository, dr repository.WebhookDeliveryRepository, t datastore.Transaction, s service.WebhookSender, interval time.Duration, concurrency int) *WebhookDispatcher {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &WebhookDispatcher{repo: r, deliveryRepo: dr, transaction: t, sender: s, interval: interval, concurrency: concurrency}
+}
+
+// Run dispatches the deliveries until ctx is done
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := d.Dispatch(ctx, now); err != nil {
+				log.Printf("failed to dispatch webhooks: %s", err)
+			}
+		}
+	}
+}
+
+// Dispatch sends the deliveries due at now and returns the number of delivered ones
+// 1回に確保するWebhookはconcurrencyまで. 送りきれない場合は, 次のDispatchで続きを送る
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, now time.Time) (int, error) {
+	v, err := d.transaction.DoInTx(ctx, func(ctx context.Context) (interface{}, error) {
+		return d.deliveryRepo.ClaimDueWebhooks(ctx, now, now.Add(webhookClaimDuration), d.concurrency)
+	})
+	if err != nil {
+		return 0, err
+	}
+	ids := v.([]string)
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		delivered int
+		firstErr  error
+	)
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			n, err := d.dispatchWebhook(ctx, id, now)
+			// 確保の期限を待たずに, 次のDispatchで送れるようにする
+			if releaseErr := d.deliveryRepo.ReleaseWebhook(ctx, id, now); err == nil {
+				err = releaseErr
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			delivered += n
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}(id)
+	}
+	wg.Wait()
+	return delivered, firstErr
+}
+
+// dispatchWebhook sends the pending deliveries to the webhook in order
+// 失敗した場合や再試行を待つ送信がある場合は, 同じWebhookへの残りを次のDispatchに回す
+func (d *WebhookDispatcher) dispatchWebhook(ctx context.Context, id string, now time.Time) (int, error) {
+	w, err := d.repo.FindByID(id)
+	if err != nil {
+		return 0, err
+	}
+	if w == nil {
+		// Webhookの削除と同時に確保した場合. 送信待ちのイベントはCASCADEで削除される
+		return 0, nil
+	}
+	vs, err := d.deliveryRepo.FindPendingByWebhookID(id, webhookDispatchBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	delivered := 0
+	for _, v := range vs {
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+		if v.NextAttemptAt.After(now) {
+			return delivered, nil
+		}
+		sendErr := d.sender.Send(ctx, w, v)
+		if sendErr != nil {
+			v.Fail(sendErr.Error(), now)
+			if v.Status == model.WebhookDeliveryDead {
+				log.Printf("webhook delivery %s to %s is given up: %s", v.ID, w.URL, sendErr)
+			}
+		} else {
+			v.Succeed(now)
+			delivered++
+		}
+		if err := d.deliveryRepo.UpdateAttempt(ctx, v); err != nil {
+			return delivered, err
+		}
+		// 上限に達した送信は諦め, 後のものを送る
+		if sendErr != nil && v.Status != model.WebhookDeliveryDead {
+			return delivered, nil
+		}
+	}
+	return delivered, nil
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/infrastructure/webhook"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+// claimedDeliveryRepoMock returns the deliveries of the webhooks claimed in order of the deliveries
+func claimedDeliveryRepoMock(ds []*model.WebhookDelivery) testutils.WebhookDeliveryRepoMock {
+	return testutils.WebhookDeliveryRepoMock{
+		FakeClaimDueWebhooks: func(ctx context.Context, now, until time.Time, limit int) ([]string, error) {
+			ids := []string{}
+			seen := map[string]bool{}
+			for _, d := range ds {
+				if !seen[d.WebhookID] {
+					seen[d.WebhookID] = true
+					ids = append(ids, d.WebhookID)
+				}
+			}
+			return ids, nil
+		},
+		FakeReleaseWebhook: func(ctx context.Context, webhookID string, now time.Time) error {
+			return nil
+		},
+		FakeFindPendingByWebhookID: func(webhookID string, limit int) ([]*model.WebhookDelivery, error) {
+			res := []*model.WebhookDelivery{}
+			for _, d := range ds {
+				if d.WebhookID == webhookID && d.Status == model.WebhookDeliveryPending {
+					res = append(res, d)
+				}
+			}
+			return res, nil
+		},
+	}
+}
+
+func TestWebhookDispatcher_Dispatch(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		status        int
+		attempts      int64
+		wantDelivered int
+		wantStatus    int64
+		wantAttempts  int64
+		wantNext      time.Time
+	}{
+		{name: "NORMAL: 2xxを返すと送信済みになる", status: http.StatusOK, attempts: 0, wantDelivered: 1, wantStatus: model.WebhookDeliveryDelivered, wantAttempts: 1, wantNext: now},
+		{name: "NORMAL: 失敗すると間隔を空けて再試行する", status: http.StatusInternalServerError, attempts: 0, wantDelivered: 0, wantStatus: model.WebhookDeliveryPending, wantAttempts: 1, wantNext: now.Add(model.WebhookRetryBaseDelay)},
+		{name: "NORMAL: 失敗が続くと間隔を倍にする", status: http.StatusInternalServerError, attempts: 2, wantDelivered: 0, wantStatus: model.WebhookDeliveryPending, wantAttempts: 3, wantNext: now.Add(4 * model.WebhookRetryBaseDelay)},
+		{name: "NORMAL: 上限に達すると送信を諦める", status: http.StatusInternalServerError, attempts: model.MaxWebhookAttempts - 1, wantDelivered: 0, wantStatus: model.WebhookDeliveryDead, wantAttempts: model.MaxWebhookAttempts, wantNext: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received []string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = append(received, r.Header.Get(webhook.HeaderDelivery))
+				w.WriteHeader(tt.status)
+			}))
+			defer ts.Close()
+
+			d := &model.WebhookDelivery{ID: "delivery", WebhookID: "webhook", EventType: model.WebhookEventAnonyURLClicked, Payload: []byte(`{}`), Attempts: tt.attempts, NextAttemptAt: now}
+			var updated []*model.WebhookDelivery
+			repo := claimedDeliveryRepoMock([]*model.WebhookDelivery{d})
+			repo.FakeUpdateAttempt = func(ctx context.Context, d *model.WebhookDelivery) error {
+				updated = append(updated, d)
+				return nil
+			}
+			dispatcher := NewWebhookDispatcher(
+				webhookRepoMock(&model.Webhook{ID: "webhook", UserID: "user", URL: ts.URL, Secret: "secret"}),
+				repo,
+				testutils.TransactionMock{},
+				webhook.NewSender(webhook.Config{Timeout: time.Second, AllowPrivate: true}),
+				time.Minute,
+				1,
+			)
+			got, err := dispatcher.Dispatch(context.Background(), now)
+			if err != nil {
+				t.Fatalf("WebhookDispatcher.Dispatch() error = %v", err)
+			}
+			if got != tt.wantDelivered || len(received) != 1 || len(updated) != 1 {
+				t.Fatalf("WebhookDispatcher.Dispatch() = %v, received %v, updated %v", got, received, updated)
+			}
+			if d.Status != tt.wantStatus || d.Attempts != tt.wantAttempts || !d.NextAttemptAt.Equal(tt.wantNext) {
+				t.Errorf("WebhookDispatcher.Dispatch() delivery = %+v", d)
+			}
+		})
+	}
+}
+
+func TestWebhookDispatcher_Dispatch_DeletedWebhook(t *testing.T) {
+	sent := false
+	dispatcher := NewWebhookDispatcher(
+		webhookRepoMock(),
+		claimedDeliveryRepoMock([]*model.WebhookDelivery{{ID: "delivery", WebhookID: "deleted"}}),
+		testutils.TransactionMock{},
+		testutils.WebhookSenderMock{
+			FakeSend: func(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery) error {
+				sent = true
+				return nil
+			},
+		},
+		time.Minute,
+		1,
+	)
+	if _, err := dispatcher.Dispatch(context.Background(), time.Now()); err != nil || sent {
+		t.Errorf("WebhookDispatcher.Dispatch() error = %v, sent %v", err, sent)
+	}
+}
+
+func TestWebhookDispatcher_Dispatch_Concurrent(t *testing.T) {
+	now := time.Now()
+	ds := []*model.WebhookDelivery{
+		{ID: "a1", WebhookID: "a"}, {ID: "b1", WebhookID: "b"}, {ID: "a2", WebhookID: "a"},
+		{ID: "b2", WebhookID: "b"}, {ID: "a3", WebhookID: "a"},
+	}
+	var mu sync.Mutex
+	sent := map[string][]string{}
+	updated := 0
+	// 両方のWebhookへの送信が始まるまで待つので, 1つずつ送ると進まない
+	started := map[string]bool{}
+	both := make(chan struct{})
+	repo := claimedDeliveryRepoMock(ds)
+	repo.FakeUpdateAttempt = func(ctx context.Context, d *model.WebhookDelivery) error {
+		mu.Lock()
+		defer mu.Unlock()
+		updated++
+		return nil
+	}
+	dispatcher := NewWebhookDispatcher(
+		webhookRepoMock(&model.Webhook{ID: "a", URL: "https://a.example/hook"}, &model.Webhook{ID: "b", URL: "https://b.example/hook"}),
+		repo,
+		testutils.TransactionMock{},
+		testutils.WebhookSenderMock{
+			FakeSend: func(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery) error {
+				mu.Lock()
+				sent[w.ID] = append(sent[w.ID], d.ID)
+				if !started[w.ID] {
+					started[w.ID] = true
+					if len(started) == 2 {
+						close(both)
+					}
+				}
+				mu.Unlock()
+				select {
+				case <-both:
+				case <-time.After(time.Second):
+					return errors.New("webhooks are not sent concurrently")
+				}
+				// aへの2件目の失敗で, aの残りは次のDispatchに回す
+				if d.ID == "a2" {
+					return errors.New("timeout")
+				}
+				return nil
+			},
+		},
+		time.Minute,
+		2,
+	)
+	got, err := dispatcher.Dispatch(context.Background(), now)
+	if err != nil {
+		t.Fatalf("WebhookDispatcher.Dispatch() error = %v", err)
+	}
+	if got != 3 || updated != 4 {
+		t.Errorf("WebhookDispatcher.Dispatch() = %v, updated %v, want 3, 4", got, updated)
+	}
+	if !reflect.DeepEqual(sent["a"], []string{"a1", "a2"}) || !reflect.DeepEqual(sent["b"], []string{"b1", "b2"}) {
+		t.Errorf("WebhookDispatcher.Dispatch() sent %v", sent)
+	}
+	if ds[4].Attempts != 0 {
+		t.Errorf("WebhookDispatcher.Dispatch() attempted %v after the failure", ds[4].ID)
+	}
+}
+
+func TestWebhookDispatcher_Dispatch_Order(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		ds       []*model.WebhookDelivery
+		wantSent []string
+	}{
+		{
+			name: "NORMAL: 再試行を待つ送信があると, 後のものも送らない",
+			ds: []*model.WebhookDelivery{
+				{ID: "d1", WebhookID: "webhook", Attempts: 1, NextAttemptAt: now.Add(model.WebhookRetryBaseDelay)},
+				{ID: "d2", WebhookID: "webhook", NextAttemptAt: now},
+			},
+			wantSent: []string{},
+		},
+		{
+			name: "NORMAL: 再試行の日時を過ぎると, 書き込んだ順に送る",
+			ds: []*model.WebhookDelivery{
+				{ID: "d1", WebhookID: "webhook", Attempts: 1, NextAttemptAt: now},
+				{ID: "d2", WebhookID: "webhook", NextAttemptAt: now.Add(-time.Minute)},
+			},
+			wantSent: []string{"d1", "d2"},
+		},
+		{
+			name: "NORMAL: 上限に達した送信は諦め, 後のものを送る",
+			ds: []*model.WebhookDelivery{
+				{ID: "dead", WebhookID: "webhook", Attempts: model.MaxWebhookAttempts - 1, NextAttemptAt: now},
+				{ID: "d2", WebhookID: "webhook", NextAttemptAt: now},
+			},
+			wantSent: []string{"dead", "d2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := []string{}
+			var claimed [2]time.Time
+			var released []string
+			repo := claimedDeliveryRepoMock(tt.ds)
+			repo.FakeClaimDueWebhooks = func(ctx context.Context, now, until time.Time, limit int) ([]string, error) {
+				claimed = [2]time.Time{now, until}
+				return []string{"webhook"}, nil
+			}
+			repo.FakeReleaseWebhook = func(ctx context.Context, webhookID string, now time.Time) error {
+				released = append(released, webhookID)
+				return nil
+			}
+			repo.FakeUpdateAttempt = func(ctx context.Context, d *model.WebhookDelivery) error { return nil }
+			dispatcher := NewWebhookDispatcher(
+				webhookRepoMock(&model.Webhook{ID: "webhook", URL: "https://example.com/hook"}),
+				repo,
+				testutils.TransactionMock{},
+				testutils.WebhookSenderMock{
+					FakeSend: func(ctx context.Context, w *model.Webhook, d *model.WebhookDelivery) error {
+						sent = append(sent, d.ID)
+						if d.ID == "dead" {
+							return errors.New("timeout")
+						}
+						return nil
+					},
+				},
+				time.Minute,
+				1,
+			)
+			if _, err := dispatcher.Dispatch(context.Background(), now); err != nil {
+				t.Fatalf("WebhookDispatcher.Dispatch() error = %v", err)
+			}
+			if !reflect.DeepEqual(sent, tt.wantSent) {
+				t.Errorf("WebhookDispatcher.Dispatch() sent %v, want %v", sent, tt.wantSent)
+			}
+			// 確保は期限付きで, 送信を終えたら解放する
+			if !claimed[0].Equal(now) || !claimed[1].Equal(now.Add(webhookClaimDuration)) || !reflect.DeepEqual(released, []string{"webhook"}) {
+				t.Errorf("WebhookDispatcher.Dispatch() claimed %v, released %v", claimed, released)
+			}
+		})
+	}
+}
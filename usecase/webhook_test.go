@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tatsuemon/anony/domain/model"
+	"github.com/Tatsuemon/anony/testutils"
+)
+
+func webhookRepoMock(ws ...*model.Webhook) testutils.WebhookRepoMock {
+	return testutils.WebhookRepoMock{
+		FakeFindByID: func(id string) (*model.Webhook, error) {
+			for _, w := range ws {
+				if w.ID == id {
+					return w, nil
+				}
+			}
+			return nil, nil
+		},
+		FakeFindByUserID: func(userID string) ([]*model.Webhook, error) {
+			res := []*model.Webhook{}
+			for _, w := range ws {
+				if w.UserID == userID {
+					res = append(res, w)
+				}
+			}
+			return res, nil
+		},
+		FakeDelete: func(ctx context.Context, id string) error { return nil },
+	}
+}
+
+func Test_webhookUseCase_CreateWebhook(t *testing.T) {
+	full := []*model.Webhook{}
+	for i := 0; i < model.MaxWebhooksPerUser; i++ {
+		full = append(full, &model.Webhook{ID: fmt.Sprint(i), UserID: "full"})
+	}
+	tests := []struct {
+		name    string
+		webhook *model.Webhook
+		wantErr bool
+	}{
+		{name: "NORMAL: Webhookを作成する", webhook: model.NewWebhook("new", "user", "https://example.com/hook", "secret", []string{model.WebhookEventAnonyURLClicked}), wantErr: false},
+		{name: "ERROR: URLが不正", webhook: model.NewWebhook("new", "user", "ftp://example.com/hook", "secret", model.WebhookEventTypes), wantErr: true},
+		{name: "ERROR: イベントの種類が不正", webhook: model.NewWebhook("new", "user", "https://example.com/hook", "secret", []string{"unknown"}), wantErr: true},
+		{name: "ERROR: 内部のネットワークのURL", webhook: model.NewWebhook("new", "user", "http://169.254.169.254/hook", "secret", model.WebhookEventTypes), wantErr: true},
+		{name: "ERROR: 作成できる数の上限", webhook: model.NewWebhook("new", "full", "https://example.com/hook", "secret", model.WebhookEventTypes), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *model.Webhook
+			repo := webhookRepoMock(full...)
+			repo.FakeSave = func(ctx context.Context, w *model.Webhook) error {
+				saved = w
+				return nil
+			}
+			repo.FakeFindByID = func(id string) (*model.Webhook, error) { return saved, nil }
+			sender := testutils.WebhookSenderMock{
+				FakeCheckURL: func(ctx context.Context, url string) error {
+					if strings.Contains(url, "169.254.169.254") {
+						return errors.New("destination is not a public address")
+					}
+					return nil
+				},
+			}
+			u := &webhookUseCase{repo: repo, sender: sender, transaction: testutils.TransactionMock{}, audit: auditNothing}
+			got, err := u.CreateWebhook(context.Background(), tt.webhook)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("webhookUseCase.CreateWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if saved != nil {
+					t.Errorf("webhookUseCase.CreateWebhook() saved %v", saved)
+				}
+				return
+			}
+			if got != tt.webhook {
+				t.Errorf("webhookUseCase.CreateWebhook() = %v, want %v", got, tt.webhook)
+			}
+		})
+	}
+}
+
+func Test_webhookUseCase_DeleteWebhook(t *testing.T) {
+	repo := webhookRepoMock(&model.Webhook{ID: "webhook", UserID: "user"})
+	u := &webhookUseCase{repo: repo, transaction: testutils.TransactionMock{}, audit: auditNothing}
+	if err := u.DeleteWebhook(context.Background(), "webhook", "other"); !errors.Is(err, ErrWebhookNotFound) {
+		t.Errorf("webhookUseCase.DeleteWebhook() error = %v, want %v", err, ErrWebhookNotFound)
+	}
+	if err := u.DeleteWebhook(context.Background(), "webhook", "user"); err != nil {
+		t.Errorf("webhookUseCase.DeleteWebhook() error = %v", err)
+	}
+}
+
+func Test_webhookUseCase_RedeliverWebhookDelivery(t *testing.T) {
+	tests := []struct {
+		name     string
+		delivery *model.WebhookDelivery
+		userID   string
+		wantErr  bool
+	}{
+		{name: "NORMAL: 送信を諦めたイベントを再送する", delivery: &model.WebhookDelivery{ID: "d", WebhookID: "webhook", Status: model.WebhookDeliveryDead, Attempts: model.MaxWebhookAttempts, LastError: "timeout"}, userID: "user", wantErr: false},
+		{name: "ERROR: 送信待ちのイベント", delivery: &model.WebhookDelivery{ID: "d", WebhookID: "webhook", Status: model.WebhookDeliveryPending}, userID: "user", wantErr: true},
+		{name: "ERROR: 他のユーザーのWebhook", delivery: &model.WebhookDelivery{ID: "d", WebhookID: "webhook", Status: model.WebhookDeliveryDead}, userID: "other", wantErr: true},
+		{name: "ERROR: 存在しない", delivery: nil, userID: "user", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *model.WebhookDelivery
+			u := &webhookUseCase{
+				repo: webhookRepoMock(&model.Webhook{ID: "webhook", UserID: "user"}),
+				deliveryRepo: testutils.WebhookDeliveryRepoMock{
+					FakeFindByID: func(id string) (*model.WebhookDelivery, error) { return tt.delivery, nil },
+					FakeUpdateAttempt: func(ctx context.Context, d *model.WebhookDelivery) error {
+						updated = d
+						return nil
+					},
+				},
+				transaction: testutils.TransactionMock{},
+				audit:       auditNothing,
+			}
+			_, err := u.RedeliverWebhookDelivery(context.Background(), "d", tt.userID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("webhookUseCase.RedeliverWebhookDelivery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if updated != nil {
+					t.Errorf("webhookUseCase.RedeliverWebhookDelivery() updated %v", updated)
+				}
+				return
+			}
+			if updated.Status != model.WebhookDeliveryPending || updated.Attempts != 0 || updated.NextAttemptAt.IsZero() {
+				t.Errorf("webhookUseCase.RedeliverWebhookDelivery() updated %+v", updated)
+			}
+		})
+	}
+}
+
+func Test_webhookUseCase_NotifyStatusChange(t *testing.T) {
+	at := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		event     model.StatusChangeEvent
+		anonyURL  *model.AnonyURL
+		wantEvent bool
+	}{
+		{name: "NORMAL: 有効期間の終了を通知する", event: model.StatusChangeEvent{AnonyURLID: "id", Active: false, At: at}, anonyURL: &model.AnonyURL{ID: "id", Status: 2}, wantEvent: true},
+		{name: "NORMAL: 有効期間の開始は通知しない", event: model.StatusChangeEvent{AnonyURLID: "id", Active: true, At: at}, anonyURL: &model.AnonyURL{ID: "id", Status: 1}, wantEvent: false},
+		{name: "NORMAL: 削除されたAnonyURLは通知しない", event: model.StatusChangeEvent{AnonyURLID: "id", Active: false, At: at}, anonyURL: nil, wantEvent: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *model.WebhookEvent
+			u := &webhookUseCase{
+				anonyURLRepo: testutils.AnonyURLRepoMock{
+					FakeFindByID: func(id string) (*model.AnonyURL, error) { return tt.anonyURL, nil },
+					FakeGetUserIDByID: func(id string) (string, error) {
+						if tt.anonyURL == nil {
+							return "", nil
+						}
+						return "user", nil
+					},
+				},
+				service: testutils.WebhookServiceMock{
+					FakeEnqueue: func(ctx context.Context, userID string, e *model.WebhookEvent) error {
+						got = e
+						return nil
+					},
+				},
+				transaction: testutils.TransactionMock{},
+			}
+			if err := u.NotifyStatusChange(context.Background(), tt.event); err != nil {
+				t.Fatalf("webhookUseCase.NotifyStatusChange() error = %v", err)
+			}
+			if (got != nil) != tt.wantEvent {
+				t.Fatalf("webhookUseCase.NotifyStatusChange() event = %v, want %v", got, tt.wantEvent)
+			}
+			if got != nil && (got.Type != model.WebhookEventAnonyURLExpired || !got.CreatedAt.Equal(at)) {
+				t.Errorf("webhookUseCase.NotifyStatusChange() event = %+v", got)
+			}
+		})
+	}
+}